@@ -32,6 +32,13 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_DenomAuthorityMetadata_FullMethodName     = "/cosmos.bank.v1beta1.Query/DenomAuthorityMetadata"
+	Query_DenomsFromCreator_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomsFromCreator"
+	Query_DenomMetadataHistory_FullMethodName       = "/cosmos.bank.v1beta1.Query/DenomMetadataHistory"
+	Query_Holds_FullMethodName                      = "/cosmos.bank.v1beta1.Query/Holds"
+	Query_Outflows_FullMethodName                   = "/cosmos.bank.v1beta1.Query/Outflows"
+	Query_SupplyAt_FullMethodName                   = "/cosmos.bank.v1beta1.Query/SupplyAt"
+	Query_DenomHolderCount_FullMethodName           = "/cosmos.bank.v1beta1.Query/DenomHolderCount"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +99,25 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// DenomAuthorityMetadata queries the admin of a factory denom.
+	DenomAuthorityMetadata(ctx context.Context, in *QueryDenomAuthorityMetadataRequest, opts ...grpc.CallOption) (*QueryDenomAuthorityMetadataResponse, error)
+	// DenomsFromCreator queries the factory denoms created by an account.
+	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
+	// DenomMetadataHistory queries the changes of the metadata of a denom made
+	// with MsgSetDenomMetadata or MsgSetFactoryDenomMetadata, oldest first.
+	DenomMetadataHistory(ctx context.Context, in *QueryDenomMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryDenomMetadataHistoryResponse, error)
+	// Holds queries the coins held by modules in the balance of an account, with
+	// the reason of each hold.
+	Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error)
+	// Outflows queries the amounts sent by an account over its current outflow
+	// quota periods, see Params.outflow_quotas.
+	Outflows(ctx context.Context, in *QueryOutflowsRequest, opts ...grpc.CallOption) (*QueryOutflowsResponse, error)
+	// SupplyAt queries the latest supply snapshot recorded at or before a height,
+	// see Params.supply_snapshot_interval.
+	SupplyAt(ctx context.Context, in *QuerySupplyAtRequest, opts ...grpc.CallOption) (*QuerySupplyAtResponse, error)
+	// DenomHolderCount queries the number of accounts holding a denom, currently
+	// or in the latest supply snapshot recorded at or before a height.
+	DenomHolderCount(ctx context.Context, in *QueryDenomHolderCountRequest, opts ...grpc.CallOption) (*QueryDenomHolderCountResponse, error)
 }
 
 type queryClient struct {
@@ -232,6 +258,76 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) DenomAuthorityMetadata(ctx context.Context, in *QueryDenomAuthorityMetadataRequest, opts ...grpc.CallOption) (*QueryDenomAuthorityMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomAuthorityMetadataResponse)
+	err := c.cc.Invoke(ctx, Query_DenomAuthorityMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomsFromCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_DenomsFromCreator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMetadataHistory(ctx context.Context, in *QueryDenomMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryDenomMetadataHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomMetadataHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_DenomMetadataHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHoldsResponse)
+	err := c.cc.Invoke(ctx, Query_Holds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Outflows(ctx context.Context, in *QueryOutflowsRequest, opts ...grpc.CallOption) (*QueryOutflowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOutflowsResponse)
+	err := c.cc.Invoke(ctx, Query_Outflows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyAt(ctx context.Context, in *QuerySupplyAtRequest, opts ...grpc.CallOption) (*QuerySupplyAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySupplyAtResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomHolderCount(ctx context.Context, in *QueryDenomHolderCountRequest, opts ...grpc.CallOption) (*QueryDenomHolderCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomHolderCountResponse)
+	err := c.cc.Invoke(ctx, Query_DenomHolderCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -290,6 +386,25 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// DenomAuthorityMetadata queries the admin of a factory denom.
+	DenomAuthorityMetadata(context.Context, *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error)
+	// DenomsFromCreator queries the factory denoms created by an account.
+	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
+	// DenomMetadataHistory queries the changes of the metadata of a denom made
+	// with MsgSetDenomMetadata or MsgSetFactoryDenomMetadata, oldest first.
+	DenomMetadataHistory(context.Context, *QueryDenomMetadataHistoryRequest) (*QueryDenomMetadataHistoryResponse, error)
+	// Holds queries the coins held by modules in the balance of an account, with
+	// the reason of each hold.
+	Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error)
+	// Outflows queries the amounts sent by an account over its current outflow
+	// quota periods, see Params.outflow_quotas.
+	Outflows(context.Context, *QueryOutflowsRequest) (*QueryOutflowsResponse, error)
+	// SupplyAt queries the latest supply snapshot recorded at or before a height,
+	// see Params.supply_snapshot_interval.
+	SupplyAt(context.Context, *QuerySupplyAtRequest) (*QuerySupplyAtResponse, error)
+	// DenomHolderCount queries the number of accounts holding a denom, currently
+	// or in the latest supply snapshot recorded at or before a height.
+	DenomHolderCount(context.Context, *QueryDenomHolderCountRequest) (*QueryDenomHolderCountResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -339,6 +454,27 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) DenomAuthorityMetadata(context.Context, *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAuthorityMetadata not implemented")
+}
+func (UnimplementedQueryServer) DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromCreator not implemented")
+}
+func (UnimplementedQueryServer) DenomMetadataHistory(context.Context, *QueryDenomMetadataHistoryRequest) (*QueryDenomMetadataHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMetadataHistory not implemented")
+}
+func (UnimplementedQueryServer) Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holds not implemented")
+}
+func (UnimplementedQueryServer) Outflows(context.Context, *QueryOutflowsRequest) (*QueryOutflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Outflows not implemented")
+}
+func (UnimplementedQueryServer) SupplyAt(context.Context, *QuerySupplyAtRequest) (*QuerySupplyAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyAt not implemented")
+}
+func (UnimplementedQueryServer) DenomHolderCount(context.Context, *QueryDenomHolderCountRequest) (*QueryDenomHolderCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomHolderCount not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -594,6 +730,132 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomAuthorityMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAuthorityMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAuthorityMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomAuthorityMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAuthorityMetadata(ctx, req.(*QueryDenomAuthorityMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsFromCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsFromCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomsFromCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsFromCreator(ctx, req.(*QueryDenomsFromCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMetadataHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMetadataHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMetadataHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomMetadataHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMetadataHistory(ctx, req.(*QueryDenomMetadataHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Holds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Holds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holds(ctx, req.(*QueryHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Outflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Outflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Outflows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Outflows(ctx, req.(*QueryOutflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyAt(ctx, req.(*QuerySupplyAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomHolderCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomHolderCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomHolderCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomHolderCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomHolderCount(ctx, req.(*QueryDenomHolderCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -653,6 +915,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "DenomAuthorityMetadata",
+			Handler:    _Query_DenomAuthorityMetadata_Handler,
+		},
+		{
+			MethodName: "DenomsFromCreator",
+			Handler:    _Query_DenomsFromCreator_Handler,
+		},
+		{
+			MethodName: "DenomMetadataHistory",
+			Handler:    _Query_DenomMetadataHistory_Handler,
+		},
+		{
+			MethodName: "Holds",
+			Handler:    _Query_Holds_Handler,
+		},
+		{
+			MethodName: "Outflows",
+			Handler:    _Query_Outflows_Handler,
+		},
+		{
+			MethodName: "SupplyAt",
+			Handler:    _Query_SupplyAt_Handler,
+		},
+		{
+			MethodName: "DenomHolderCount",
+			Handler:    _Query_DenomHolderCount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -33,7 +33,8 @@ type MsgClient interface {
 	// AuthorizeCircuitBreaker allows a super-admin to grant (or revoke) another
 	// account's circuit breaker permissions.
 	AuthorizeCircuitBreaker(ctx context.Context, in *MsgAuthorizeCircuitBreaker, opts ...grpc.CallOption) (*MsgAuthorizeCircuitBreakerResponse, error)
-	// TripCircuitBreaker pauses processing of Msg's in the state machine.
+	// TripCircuitBreaker pauses processing of Msg's in the state machine, or of
+	// gRPC queries.
 	TripCircuitBreaker(ctx context.Context, in *MsgTripCircuitBreaker, opts ...grpc.CallOption) (*MsgTripCircuitBreakerResponse, error)
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been paused using TripCircuitBreaker.
@@ -87,7 +88,8 @@ type MsgServer interface {
 	// AuthorizeCircuitBreaker allows a super-admin to grant (or revoke) another
 	// account's circuit breaker permissions.
 	AuthorizeCircuitBreaker(context.Context, *MsgAuthorizeCircuitBreaker) (*MsgAuthorizeCircuitBreakerResponse, error)
-	// TripCircuitBreaker pauses processing of Msg's in the state machine.
+	// TripCircuitBreaker pauses processing of Msg's in the state machine, or of
+	// gRPC queries.
 	TripCircuitBreaker(context.Context, *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error)
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been paused using TripCircuitBreaker.
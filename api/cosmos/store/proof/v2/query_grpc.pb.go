@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cosmos/store/proof/v2/query.proto
+
+package proofv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Query_ProveKey_FullMethodName   = "/cosmos.store.proof.v2.Query/ProveKey"
+	Query_KeyHistory_FullMethodName = "/cosmos.store.proof.v2.Query/KeyHistory"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the gRPC service querying keys of the store at historical heights.
+type QueryClient interface {
+	// ProveKey returns the value of a key in a store at a height, along with its
+	// ICS-23 proof against the app hash of that height.
+	ProveKey(ctx context.Context, in *QueryProveKeyRequest, opts ...grpc.CallOption) (*QueryProveKeyResponse, error)
+	// KeyHistory returns the values of a key in a store at every height it was
+	// written at, in ascending order.
+	KeyHistory(ctx context.Context, in *QueryKeyHistoryRequest, opts ...grpc.CallOption) (*QueryKeyHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ProveKey(ctx context.Context, in *QueryProveKeyRequest, opts ...grpc.CallOption) (*QueryProveKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProveKeyResponse)
+	err := c.cc.Invoke(ctx, Query_ProveKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyHistory(ctx context.Context, in *QueryKeyHistoryRequest, opts ...grpc.CallOption) (*QueryKeyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_KeyHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility.
+//
+// Query defines the gRPC service querying keys of the store at historical heights.
+type QueryServer interface {
+	// ProveKey returns the value of a key in a store at a height, along with its
+	// ICS-23 proof against the app hash of that height.
+	ProveKey(context.Context, *QueryProveKeyRequest) (*QueryProveKeyResponse, error)
+	// KeyHistory returns the values of a key in a store at every height it was
+	// written at, in ascending order.
+	KeyHistory(context.Context, *QueryKeyHistoryRequest) (*QueryKeyHistoryResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
+
+func (UnimplementedQueryServer) ProveKey(context.Context, *QueryProveKeyRequest) (*QueryProveKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProveKey not implemented")
+}
+func (UnimplementedQueryServer) KeyHistory(context.Context, *QueryKeyHistoryRequest) (*QueryKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyHistory not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call pancis, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_ProveKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProveKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProveKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProveKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProveKey(ctx, req.(*QueryProveKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KeyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyHistory(ctx, req.(*QueryKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.store.proof.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProveKey",
+			Handler:    _Query_ProveKey_Handler,
+		},
+		{
+			MethodName: "KeyHistory",
+			Handler:    _Query_KeyHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/store/proof/v2/query.proto",
+}
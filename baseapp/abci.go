@@ -839,26 +839,41 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for txIndex, rawTx := range req.Txs {
-
-		response := app.deliverTx(rawTx)
+	var txResults []*abci.ExecTxResult
+	if app.txExecutor != nil {
+		txResults, err = app.txExecutor.ExecuteTxs(
+			ctx,
+			req.Txs,
+			app.finalizeBlockState.ms,
+			app.finalizeBlockState.Context().BlockGasMeter(),
+			app.deliverTxWithMultiStore,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		txResults = make([]*abci.ExecTxResult, 0, len(req.Txs))
+		for _, rawTx := range req.Txs {
+			response := app.deliverTx(rawTx)
+
+			// check after every tx if we should abort
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+				// continue
+			}
 
-		// check after every tx if we should abort
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			// continue
+			txResults = append(txResults, response)
 		}
+	}
 
-		// append the tx index to the response.Events
+	// append the tx index to the response.Events
+	for txIndex, response := range txResults {
 		for i, event := range response.Events {
 			response.Events[i].Attributes = append(event.Attributes,
 				abci.EventAttribute{Key: "tx_index", Value: strconv.Itoa(txIndex)})
 		}
-
-		txResults = append(txResults, response)
 	}
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
@@ -1185,6 +1200,10 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.QueryReq
 		return queryResult(err, app.trace)
 	}
 
+	if err := app.checkQueryCircuit(ctx, req.Height, req.Path); err != nil {
+		return queryResult(err, app.trace)
+	}
+
 	resp, err := handler(ctx, req)
 	if err != nil {
 		resp = queryResult(gRPCErrorToSDKError(err), app.trace)
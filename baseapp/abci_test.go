@@ -494,6 +494,60 @@ func TestABCI_GRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+// queryCircuitBreaker is a circuit breaker disabling the query paths it holds.
+type queryCircuitBreaker map[string]bool
+
+func (cb queryCircuitBreaker) IsAllowed(context.Context, string) (bool, error) { return true, nil }
+
+func (cb queryCircuitBreaker) IsQueryAllowed(_ context.Context, path string) (bool, error) {
+	return !cb[path], nil
+}
+
+func TestABCI_GRPCQueryCircuitBreaker(t *testing.T) {
+	cb := queryCircuitBreaker{}
+	suite := NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), testdata.QueryImpl{})
+		bapp.SetCircuitBreaker(cb)
+	})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: suite.baseApp.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: fooStr}).Marshal()
+	require.NoError(t, err)
+
+	query := func(height int64) *abci.QueryResponse {
+		res, err := suite.baseApp.Query(context.TODO(), &abci.QueryRequest{
+			Data:   reqBz,
+			Path:   "/testpb.Query/SayHello",
+			Height: height,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	require.Equal(t, abci.CodeTypeOK, query(0).Code)
+
+	cb["/testpb.Query/SayHello"] = true
+	for _, height := range []int64{0, 1} {
+		res := query(height)
+		require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res)
+		require.Contains(t, res.Log, "circuit breaker disables query /testpb.Query/SayHello")
+	}
+
+	delete(cb, "/testpb.Query/SayHello")
+	require.Equal(t, abci.CodeTypeOK, query(1).Code)
+}
+
 func TestABCI_P2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAddrPeerFilter(func(addrport string) *abci.QueryResponse {
@@ -245,6 +245,10 @@ func (h *DefaultProposalHandler) SetTxSelector(ts TxSelector) {
 // Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
 // reached or the mempool is exhausted.
 //
+// If the mempool is a mempool.LaneMempool, lanes are enumerated in order and
+// each lane is limited to its MaxBlockSpace share of the block MaxBytes and
+// MaxGas, as verified by ProcessProposal, and to the MaxTxBytes left.
+//
 // Note:
 //
 // - Step (2) is identical to the validation step performed in
@@ -296,8 +300,12 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			resError        error
 			selectedTxsNums int
 			invalidTxs      []sdk.Tx // invalid txs to be removed out of the loop to avoid dead lock
+
+			// total bytes and gas of the selected txs, used to compute lane limits
+			totalTxBytes uint64
+			totalTxGas   uint64
 		)
-		h.mempool.SelectBy(ctx, decodedTxs, func(memTx sdk.Tx) bool {
+		selectTx := func(memTx sdk.Tx, maxTxBytes, maxBlockGas uint64) bool {
 			unorderedTx, ok := memTx.(sdk.TxWithUnordered)
 			isUnordered := ok && unorderedTx.GetUnordered()
 			txSignersSeqs := make(map[string]uint64)
@@ -344,12 +352,19 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			if err != nil {
 				invalidTxs = append(invalidTxs, memTx)
 			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
+				stop := h.txSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
 				if stop {
 					return false
 				}
 
 				txsLen := len(h.txSelector.SelectedTxs(ctx))
+				if txsLen != selectedTxsNums {
+					totalTxBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+					if gasTx, ok := memTx.(GasTx); ok {
+						totalTxGas += gasTx.GetGas()
+					}
+				}
+
 				// If the tx is unordered, we don't need to update the sender sequence.
 				if !isUnordered {
 					for sender, seq := range txSignersSeqs {
@@ -371,7 +386,32 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			}
 
 			return true
-		})
+		}
+
+		if laneMempool, ok := h.mempool.(*mempool.LaneMempool); ok {
+			// Lanes are filled in order, each lane being limited to its share
+			// of the block and to the space left by the previous lanes.
+			blockMaxBytes, _ := blockLimits(ctx)
+			for _, lane := range laneMempool.Lanes() {
+				laneMaxTxBytes, laneMaxGas := lane.Limits(blockMaxBytes, maxBlockGas)
+				laneMaxTxBytes = min(totalTxBytes+laneMaxTxBytes, uint64(req.MaxTxBytes))
+				if maxBlockGas > 0 {
+					laneMaxGas = min(totalTxGas+laneMaxGas, maxBlockGas)
+				}
+
+				lane.Mempool.SelectBy(ctx, decodedTxs, func(memTx sdk.Tx) bool {
+					return selectTx(memTx, laneMaxTxBytes, laneMaxGas)
+				})
+
+				if resError != nil || totalTxBytes >= uint64(req.MaxTxBytes) || (maxBlockGas > 0 && totalTxGas >= maxBlockGas) {
+					break
+				}
+			}
+		} else {
+			h.mempool.SelectBy(ctx, decodedTxs, func(memTx sdk.Tx) bool {
+				return selectTx(memTx, uint64(req.MaxTxBytes), maxBlockGas)
+			})
+		}
 
 		if resError != nil {
 			return nil, resError
@@ -394,6 +434,10 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 // 1. The transaction bytes must decode to a valid transaction.
 // 2. The transaction must be valid (i.e. pass runTx, AnteHandler only)
 //
+// If the mempool is a mempool.LaneMempool, the transactions must additionally be
+// ordered by lane and each lane must stay within its MaxBlockSpace share of the
+// block MaxBytes and MaxGas.
+//
 // If any transaction fails to pass either condition, the proposal is rejected.
 // Note that step (2) is identical to the validation step performed in
 // DefaultPrepareProposal. It is very important that the same validation logic
@@ -415,12 +459,22 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 			maxBlockGas = b.MaxGas
 		}
 
+		laneMempool, hasLanes := h.mempool.(*mempool.LaneMempool)
+		laneVerifier := newLaneQuotaVerifier(ctx, laneMempool)
+
 		for _, txBytes := range req.Txs {
 			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
 			if err != nil {
 				return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
 			}
 
+			if hasLanes {
+				if err := laneVerifier.verifyTx(tx, txBytes); err != nil {
+					ctx.Logger().Error("proposal does not respect mempool lanes", "err", err)
+					return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+				}
+			}
+
 			if maxBlockGas > 0 {
 				gasTx, ok := tx.(GasTx)
 				if ok {
@@ -437,6 +491,81 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 	}
 }
 
+// laneQuotaVerifier verifies that the transactions of a proposal are ordered by
+// lane and that each lane stays within its share of the block.
+type laneQuotaVerifier struct {
+	lanes       *mempool.LaneMempool
+	maxTxBytes  uint64
+	maxBlockGas uint64
+
+	laneIdx      int
+	laneTxBytes  uint64
+	laneTxGas    uint64
+	laneMaxBytes uint64
+	laneMaxGas   uint64
+}
+
+// newLaneQuotaVerifier returns a laneQuotaVerifier for the given mempool.
+func newLaneQuotaVerifier(ctx sdk.Context, lanes *mempool.LaneMempool) *laneQuotaVerifier {
+	v := &laneQuotaVerifier{lanes: lanes, laneIdx: -1}
+	v.maxTxBytes, v.maxBlockGas = blockLimits(ctx)
+
+	return v
+}
+
+// blockLimits returns the block MaxBytes and MaxGas the lane quotas are computed
+// from. Since ProcessProposal does not know the MaxTxBytes the proposer was
+// given, both PrepareProposal and ProcessProposal compute the byte quotas from
+// the block MaxBytes, so that the proposals built by honest proposers are the
+// only ones accepted. A zero MaxGas means the block gas is not limited.
+func blockLimits(ctx sdk.Context) (maxBytes, maxGas uint64) {
+	maxBytes = cmttypes.MaxBlockSizeBytes
+	if b := ctx.ConsensusParams().Block; b != nil { //nolint:staticcheck // ignore linting error
+		if b.MaxBytes > 0 {
+			maxBytes = uint64(b.MaxBytes)
+		}
+		if b.MaxGas > 0 {
+			maxGas = uint64(b.MaxGas)
+		}
+	}
+
+	return maxBytes, maxGas
+}
+
+func (v *laneQuotaVerifier) verifyTx(tx sdk.Tx, txBz []byte) error {
+	idx, err := v.lanes.LaneIndex(tx)
+	if err != nil {
+		return err
+	}
+
+	lanes := v.lanes.Lanes()
+	switch {
+	case idx < v.laneIdx:
+		return fmt.Errorf("tx of lane %s included after lane %s", lanes[idx].Name, lanes[v.laneIdx].Name)
+	case idx > v.laneIdx:
+		v.laneIdx = idx
+		v.laneTxBytes, v.laneTxGas = 0, 0
+		v.laneMaxBytes, v.laneMaxGas = lanes[idx].Limits(v.maxTxBytes, v.maxBlockGas)
+	}
+
+	v.laneTxBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+	if v.laneTxBytes > v.laneMaxBytes {
+		return fmt.Errorf("lane %s exceeds its max block bytes %d", lanes[idx].Name, v.laneMaxBytes)
+	}
+
+	if v.maxBlockGas > 0 {
+		if gasTx, ok := tx.(GasTx); ok {
+			v.laneTxGas += gasTx.GetGas()
+		}
+
+		if v.laneTxGas > v.laneMaxGas {
+			return fmt.Errorf("lane %s exceeds its max block gas %d", lanes[idx].Name, v.laneMaxGas)
+		}
+	}
+
+	return nil
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
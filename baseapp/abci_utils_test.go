@@ -22,6 +22,7 @@ import (
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
@@ -713,6 +714,135 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_LaneMempool() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	newPool := func() mempool.Mempool {
+		return mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		})
+	}
+	// the priority lane holds txs whose value starts with "p", and may use up to
+	// a quarter of the block
+	mp := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name: "priority",
+			Match: func(tx sdk.Tx) bool {
+				return tx.GetMsgs()[0].(*baseapptestutil.MsgKeyValue).Value[0] == 'p'
+			},
+			Mempool:       newPool(),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(25, 2),
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       newPool(),
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+
+	type testTx struct {
+		tx       sdk.Tx
+		priority int64
+		bz       []byte
+	}
+
+	testTxs := []testTx{
+		{tx: buildMsg(s.T(), txConfig, signingCtx.AddressCodec(), []byte(`p1`), [][]byte{[]byte("secret1")}, []uint64{1}), priority: 1},
+		{tx: buildMsg(s.T(), txConfig, signingCtx.AddressCodec(), []byte(`p2`), [][]byte{[]byte("secret2")}, []uint64{1}), priority: 2},
+		{tx: buildMsg(s.T(), txConfig, signingCtx.AddressCodec(), []byte(`d1`), [][]byte{[]byte("secret3")}, []uint64{1}), priority: 30},
+		{tx: buildMsg(s.T(), txConfig, signingCtx.AddressCodec(), []byte(`d2`), [][]byte{[]byte("secret4")}, []uint64{1}), priority: 20},
+		{tx: buildMsg(s.T(), txConfig, signingCtx.AddressCodec(), []byte(`d3`), [][]byte{[]byte("secret5")}, []uint64{1}), priority: 10},
+	}
+
+	ctrl := gomock.NewController(s.T())
+	app := mock.NewMockProposalTxVerifier(ctrl)
+	ph := baseapp.NewDefaultProposalHandler(mp, app)
+
+	for i := range testTxs {
+		bz, err := txConfig.TxEncoder()(testTxs[i].tx)
+		s.Require().NoError(err)
+		testTxs[i].bz = bz
+		s.Require().Equal(194, int(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})))
+
+		app.EXPECT().TxDecode(bz).Return(testTxs[i].tx, nil).AnyTimes()
+		app.EXPECT().PrepareProposalVerifyTx(testTxs[i].tx).Return(bz, nil).AnyTimes()
+		app.EXPECT().ProcessProposalVerifyTx(bz).Return(testTxs[i].tx, nil).AnyTimes()
+		s.Require().NoError(mp.Insert(s.ctx.WithPriority(testTxs[i].priority), testTxs[i].tx))
+	}
+
+	withMaxBytes := func(maxBytes int64) sdk.Context {
+		return s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: maxBytes, MaxGas: -1},
+		})
+	}
+
+	// the priority lane goes first but is limited to a quarter of the block
+	// MaxBytes, i.e. 250 bytes or a single tx
+	resp, err := ph.PrepareProposalHandler()(withMaxBytes(1000), &abci.PrepareProposalRequest{MaxTxBytes: 1000})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{testTxs[1].bz, testTxs[2].bz, testTxs[3].bz, testTxs[4].bz}, resp.Txs)
+
+	// a 150 bytes share cannot hold any tx, the default lane gets the whole block
+	resp, err = ph.PrepareProposalHandler()(withMaxBytes(600), &abci.PrepareProposalRequest{MaxTxBytes: 600})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{testTxs[2].bz, testTxs[3].bz, testTxs[4].bz}, resp.Txs)
+
+	testCases := map[string]struct {
+		txs    []int
+		status abci.ProcessProposalStatus
+	}{
+		"lanes in order": {
+			txs:    []int{0, 2, 3},
+			status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:    []int{2, 0},
+			status: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"lane quota exceeded": {
+			txs:    []int{0, 1, 2},
+			status: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &abci.ProcessProposalRequest{}
+			for _, i := range tc.txs {
+				req.Txs = append(req.Txs, testTxs[i].bz)
+			}
+
+			resp, err := ph.ProcessProposalHandler()(withMaxBytes(1000), req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+
+	// The MaxTxBytes given to the proposer is lower than the block MaxBytes, but
+	// both handlers compute the lane quotas from MaxBytes: a 194 bytes share
+	// holds exactly one priority tx, and a 193 bytes share none.
+	for maxBytes, expPriorityTxs := range map[int64]int{776: 1, 775: 0} {
+		ctx := withMaxBytes(maxBytes)
+		resp, err := ph.PrepareProposalHandler()(ctx, &abci.PrepareProposalRequest{MaxTxBytes: maxBytes - 100})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Txs, 3)
+		s.Require().Equal(expPriorityTxs == 1, bytes.Equal(testTxs[1].bz, resp.Txs[0]))
+
+		processResp, err := ph.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: resp.Txs})
+		s.Require().NoError(err)
+		s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_ACCEPT, processResp.Status)
+
+		// a proposal with one more priority tx than the builder includes is rejected
+		processResp, err = ph.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: append([][]byte{testTxs[0].bz}, resp.Txs...)})
+		s.Require().NoError(err)
+		s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_REJECT, processResp.Status)
+	}
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
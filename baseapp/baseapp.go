@@ -21,6 +21,7 @@ import (
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
@@ -189,6 +190,14 @@ type BaseApp struct {
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
 
+	// txExecutor executes the transactions of a block in FinalizeBlock. If nil,
+	// transactions are executed sequentially.
+	txExecutor TxExecutor
+
+	// moduleCodecs holds the codecs used to decode the state of the module
+	// stores, by store key name.
+	moduleCodecs map[string]schema.ModuleCodec
+
 	// includeNestedMsgsGas holds a set of message types for which gas costs for its nested messages are calculated.
 	includeNestedMsgsGas map[string]struct{}
 }
@@ -515,11 +524,35 @@ func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
 
 // SetCircuitBreaker sets the circuit breaker for the BaseApp.
 // The circuit breaker is checked on every message execution to verify if a transaction should be executed or not.
+// If it implements QueryCircuitBreaker, it is also checked on every gRPC query to verify if it should be served.
 func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
 	if app.msgServiceRouter == nil {
 		panic("cannot set circuit breaker with no msg service router set")
 	}
 	app.msgServiceRouter.SetCircuit(cb)
+
+	if qcb, ok := cb.(QueryCircuitBreaker); ok && app.grpcQueryRouter != nil {
+		app.grpcQueryRouter.SetCircuit(qcb)
+	}
+}
+
+// checkQueryCircuit returns an error if the circuit breaker disables the query
+// path. ctx is the context of the query, created at the given height. The circuit
+// breaker is always checked against the latest state, so that a disabled query
+// cannot be served at a previous height.
+func (app *BaseApp) checkQueryCircuit(ctx sdk.Context, height int64, path string) error {
+	if app.grpcQueryRouter.circuitBreaker == nil {
+		return nil
+	}
+
+	if height != 0 {
+		var err error
+		if ctx, err = app.CreateQueryContext(0, false); err != nil {
+			return err
+		}
+	}
+
+	return app.grpcQueryRouter.checkCircuit(ctx, path)
 }
 
 // GetConsensusParams returns the current consensus parameters from the BaseApp's
@@ -759,19 +792,27 @@ func (app *BaseApp) beginBlock(_ *abci.FinalizeBlockRequest) (sdk.BeginBlock, er
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
+	return app.deliverTxWithContext(app.getContextForTx(execModeFinalize, tx), tx)
+}
+
+// deliverTxWithContext executes a transaction in finalize mode against the
+// provided context, which must be derived from the finalize block state.
+func (app *BaseApp) deliverTxWithContext(ctx sdk.Context, tx []byte) *abci.ExecTxResult {
 	gInfo := sdk.GasInfo{}
 	resultStr := "successful"
 
 	var resp *abci.ExecTxResult
 
 	defer func() {
-		telemetry.IncrCounter(1, "tx", "count")
-		telemetry.IncrCounter(1, "tx", resultStr)
-		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
-		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+		applyEffect(ctx, func() {
+			telemetry.IncrCounter(1, "tx", "count")
+			telemetry.IncrCounter(1, "tx", resultStr)
+			telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
+			telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+		})
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx, nil)
+	gInfo, result, anteEvents, err := app.runTxWithContext(ctx, execModeFinalize, tx, nil)
 	if err != nil {
 		resultStr = "failed"
 		resp = responseExecTxResultWithEvents(
@@ -836,12 +877,18 @@ type HasNestedMsgs interface {
 // both txbytes and the decoded tx are passed to runTx to avoid the state machine encoding the tx and decoding the transaction twice
 // passing the decoded tx to runTX is optional, it will be decoded if the tx is nil
 func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+}
+
+// runTxWithContext is runTx against a context previously obtained through
+// getContextForTx, which allows callers to swap the multi-store and block gas
+// meter the transaction runs against.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -955,10 +1002,20 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 			return gInfo, nil, anteEvents, err
 		}
 	} else if mode == execModeFinalize {
-		err = app.mempool.Remove(tx)
-		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-			return gInfo, nil, anteEvents,
-				fmt.Errorf("failed to remove tx from mempool: %w", err)
+		if _, ok := sdk.UncommittedExecutionFromContext(ctx); ok {
+			// only remove the tx once the result of the execution is committed,
+			// which never happens for traces
+			applyEffect(ctx, func() {
+				if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+					app.logger.Error("failed to remove tx from mempool", "err", err)
+				}
+			})
+		} else {
+			err = app.mempool.Remove(tx)
+			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return gInfo, nil, anteEvents,
+					fmt.Errorf("failed to remove tx from mempool: %w", err)
+			}
 		}
 	}
 
@@ -1031,6 +1088,8 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, reflectMsgs []proto
 	events := sdk.EmptyEvents()
 	msgResponses := make([]*codectypes.Any, 0, len(msgs))
 
+	tracer := txTracerFromContext(ctx)
+
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
 		if mode != execModeFinalize && mode != execModeSimulate {
@@ -1042,8 +1101,15 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, reflectMsgs []proto
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
 		}
 
+		if tracer != nil {
+			tracer.beginMsg(i, msg, ctx.GasMeter().GasConsumed())
+		}
+
 		// ADR 031 request type routing
 		msgResult, err := handler(ctx, msg)
+		if tracer != nil {
+			tracer.endMsg(ctx.GasMeter().GasConsumed(), err)
+		}
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -6,3 +6,9 @@ import "context"
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// QueryCircuitBreaker is an interface that defines the methods for a circuit breaker
+// of gRPC queries.
+type QueryCircuitBreaker interface {
+	IsQueryAllowed(ctx context.Context, path string) (bool, error)
+}
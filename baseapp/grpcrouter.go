@@ -10,11 +10,14 @@ import (
 	"google.golang.org/grpc/encoding"
 	"google.golang.org/protobuf/runtime/protoiface"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/baseapp/internal/protocompat"
 	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type QueryRouter interface {
@@ -40,6 +43,8 @@ type GRPCQueryRouter struct {
 	cdc encoding.Codec
 	// serviceData contains the gRPC services and their handlers.
 	serviceData []serviceData
+	// circuitBreaker is used to disable query paths.
+	circuitBreaker QueryCircuitBreaker
 }
 
 // serviceData represents a gRPC service, along with its handler.
@@ -62,6 +67,29 @@ func NewGRPCQueryRouter() *GRPCQueryRouter {
 	}
 }
 
+// SetCircuit sets the circuit breaker checked before serving a gRPC query.
+func (qrt *GRPCQueryRouter) SetCircuit(cb QueryCircuitBreaker) {
+	qrt.circuitBreaker = cb
+}
+
+// checkCircuit returns an error if the circuit breaker disables the query path.
+func (qrt *GRPCQueryRouter) checkCircuit(ctx context.Context, path string) error {
+	if qrt.circuitBreaker == nil {
+		return nil
+	}
+
+	allowed, err := qrt.circuitBreaker.IsQueryAllowed(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker disables query %s", path)
+	}
+
+	return nil
+}
+
 // GRPCQueryHandler defines a function type which handles ABCI Query requests
 // using gRPC
 type GRPCQueryHandler = func(ctx sdk.Context, req *abci.QueryRequest) (*abci.QueryResponse, error)
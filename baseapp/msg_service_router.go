@@ -4,18 +4,22 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/go-metrics"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/runtime/protoiface"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp/internal/protocompat"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -190,7 +194,25 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 		)
 	}
 
-	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (_ *sdk.Result, err error) {
+		if telemetry.IsTelemetryEnabled() && ctx.ExecMode() == sdk.ExecModeFinalize && txTracerFromContext(ctx) == nil {
+			start, gasMeter := time.Now(), ctx.GasMeter()
+			gasStart := gasMeter.GasConsumed()
+			defer func() {
+				// panics, including out of gas errors, are recovered by runTx
+				// and reported under the sdk codespace
+				r := recover()
+				gasUsed, duration, msgErr := gasMeter.GasConsumed()-gasStart, time.Since(start), err
+				if r != nil {
+					msgErr = sdkerrors.ErrPanic
+				}
+				applyEffect(ctx, func() { emitMsgTelemetry(requestTypeName, duration, gasUsed, msgErr) })
+				if r != nil {
+					panic(r)
+				}
+			}()
+		}
+
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 			goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -245,6 +267,26 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 	return nil
 }
 
+// emitMsgTelemetry emits the execution time, gas used and outcome of a message
+// executed in finalize mode, labelled by its type URL. Failures are also
+// labelled by the codespace of the error.
+func emitMsgTelemetry(typeURL string, duration time.Duration, gasUsed storetypes.Gas, err error) {
+	labels := []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameTypeURL, typeURL)}
+
+	telemetry.IncrCounterWithLabels([]string{"msg", "count"}, 1, labels)
+	telemetry.AddSampleWithLabels([]string{"msg", "duration"}, float32(duration)/float32(time.Millisecond), labels)
+	telemetry.AddSampleWithLabels([]string{"msg", "gas_used"}, float32(gasUsed), labels)
+
+	if err != nil {
+		codespace, _, _ := errorsmod.ABCIInfo(err, false)
+		telemetry.IncrCounterWithLabels(
+			[]string{"msg", "failed"},
+			1,
+			append(labels, telemetry.NewLabel(telemetry.MetricLabelNameCodespace, codespace)),
+		)
+	}
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
@@ -11,11 +12,16 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -203,3 +209,87 @@ func TestMsgService(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code, "res=%+v", res)
 }
+
+// failingKeyValueImpl fails the messages whose value is "fail".
+type failingKeyValueImpl struct {
+	appendKeyValueImpl
+}
+
+func (impl failingKeyValueImpl) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	if string(msg.Value) == "fail" {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	return impl.appendKeyValueImpl.Set(ctx, msg)
+}
+
+func TestMsgServiceRouter_Telemetry(t *testing.T) {
+	testCases := map[string][]func(*baseapp.BaseApp){
+		"sequential execution": nil,
+		"parallel execution":   {baseapp.SetTxExecutor(baseapp.NewParallelTxExecutor(4))},
+	}
+
+	for name, opts := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m, err := telemetry.New(telemetry.Config{
+				MetricsSink: telemetry.MetricSinkInMem,
+				Enabled:     true,
+				ServiceName: "test",
+			})
+			require.NoError(t, err)
+
+			suite := NewBaseAppSuite(t, opts...)
+			baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), failingKeyValueImpl{})
+			_, err = suite.baseApp.InitChain(&abci.InitChainRequest{})
+			require.NoError(t, err)
+
+			var txs [][]byte
+			for i := 0; i < 6; i++ {
+				value := "value"
+				if i%3 == 0 {
+					value = "fail"
+				}
+				txs = append(txs, newKeyValueTx(t, suite, "shared", value))
+			}
+			_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: txs})
+			require.NoError(t, err)
+
+			gr, err := m.Gather(telemetry.FormatText)
+			require.NoError(t, err)
+
+			var summary struct {
+				Counters []struct {
+					Name   string
+					Count  int
+					Labels map[string]string
+				}
+				Samples []struct {
+					Name   string
+					Count  int
+					Labels map[string]string
+				}
+			}
+			require.NoError(t, json.Unmarshal(gr.Metrics, &summary))
+
+			typeURL := sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{})
+			counts := map[string]int{}
+			for _, c := range summary.Counters {
+				if c.Labels["type_url"] == typeURL {
+					counts[c.Name+"/"+c.Labels["codespace"]] += c.Count
+				}
+			}
+			for _, s := range summary.Samples {
+				if s.Labels["type_url"] == typeURL {
+					counts[s.Name] += s.Count
+				}
+			}
+
+			require.Equal(t, map[string]int{
+				"test.msg.count/":     6,
+				"test.msg.failed/sdk": 2,
+				"test.msg.duration":   6,
+				"test.msg.gas_used":   6,
+			}, counts)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/core/server"
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/schema"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
@@ -119,6 +120,18 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetTxExecutor sets the executor used to run the transactions of a block in
+// FinalizeBlock, e.g. a ParallelTxExecutor.
+func SetTxExecutor(executor TxExecutor) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetTxExecutor(executor) }
+}
+
+// SetModuleCodecs sets the codecs used to decode the state of the module
+// stores, by store key name, e.g. in transaction traces.
+func SetModuleCodecs(codecs map[string]schema.ModuleCodec) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetModuleCodecs(codecs) }
+}
+
 // SetIncludeNestedMsgsGas sets the message types for which gas costs for its nested messages are calculated when simulating.
 func SetIncludeNestedMsgsGas(msgs []sdk.Msg) func(*BaseApp) {
 	return func(app *BaseApp) {
@@ -350,6 +363,31 @@ func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
 	app.mempool = mempool
 }
 
+// SetTxExecutor sets the executor used to run the transactions of a block in
+// FinalizeBlock. A nil executor runs them sequentially.
+func (app *BaseApp) SetTxExecutor(executor TxExecutor) {
+	if app.sealed {
+		panic("SetTxExecutor() on sealed BaseApp")
+	}
+	app.txExecutor = executor
+}
+
+// SetModuleCodecs sets the codecs used to decode the state of the module
+// stores, by store key name. Module codecs are usually obtained from the
+// collections schema of the module keepers, see collections.Schema.ModuleCodec.
+func (app *BaseApp) SetModuleCodecs(codecs map[string]schema.ModuleCodec) {
+	if app.sealed {
+		panic("SetModuleCodecs() on sealed BaseApp")
+	}
+	app.moduleCodecs = codecs
+}
+
+// ModuleCodecs returns the codecs used to decode the state of the module
+// stores, by store key name.
+func (app *BaseApp) ModuleCodecs() map[string]schema.ModuleCodec {
+	return app.moduleCodecs
+}
+
 // SetProcessProposal sets the process proposal function for the BaseApp.
 func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 	if app.sealed {
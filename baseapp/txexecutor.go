@@ -0,0 +1,317 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxExecutor executes the raw transactions of a block during FinalizeBlock.
+//
+// Implementations must return exactly one result per transaction, in block
+// order, and must leave ms and blockGasMeter in the state a sequential
+// execution of the transactions through deliverTx would have left them in.
+type TxExecutor interface {
+	ExecuteTxs(
+		ctx context.Context,
+		txs [][]byte,
+		ms storetypes.MultiStore,
+		blockGasMeter storetypes.GasMeter,
+		deliverTx DeliverTxFunc,
+	) ([]*abci.ExecTxResult, error)
+}
+
+// DeliverTxFunc executes a raw transaction in finalize mode against ms and
+// consumes the gas it used from blockGasMeter. State changes are written to ms
+// only if the transaction succeeds.
+type DeliverTxFunc func(tx []byte, ms storetypes.MultiStore, blockGasMeter storetypes.GasMeter) *abci.ExecTxResult
+
+// deliverTxWithMultiStore is the DeliverTxFunc handed to the TxExecutor.
+func (app *BaseApp) deliverTxWithMultiStore(tx []byte, ms storetypes.MultiStore, blockGasMeter storetypes.GasMeter) *abci.ExecTxResult {
+	ctx := app.getContextForTx(execModeFinalize, tx).
+		WithMultiStore(ms).
+		WithBlockGasMeter(blockGasMeter)
+	if branch, ok := ms.(*branchMultiStore); ok && branch.effects != nil {
+		ctx = ctx.WithUncommittedExecution(branch.effects)
+	}
+
+	return app.deliverTxWithContext(ctx, tx)
+}
+
+var _ sdk.UncommittedExecution = (*deferredEffects)(nil)
+
+// deferredEffects is the UncommittedExecution of a speculative execution or of
+// a trace. It holds the effects of the execution outside of the multistore,
+// such as its metrics or the removal of the tx from the mempool, which are
+// only applied if its result is committed.
+type deferredEffects struct {
+	effects     []func()
+	invalidated bool
+}
+
+// Invalidate implements sdk.UncommittedExecution.
+func (d *deferredEffects) Invalidate() {
+	d.invalidated = true
+}
+
+func (d *deferredEffects) flush() {
+	for _, effect := range d.effects {
+		effect()
+	}
+}
+
+// applyEffect calls effect, or defers it until the result of the speculative
+// execution ctx belongs to is committed.
+func applyEffect(ctx sdk.Context, effect func()) {
+	if exec, ok := sdk.UncommittedExecutionFromContext(ctx); ok {
+		if d, ok := exec.(*deferredEffects); ok {
+			d.effects = append(d.effects, effect)
+			return
+		}
+	}
+
+	effect()
+}
+
+var _ TxExecutor = (*ParallelTxExecutor)(nil)
+
+// ParallelTxExecutor is an optimistic TxExecutor. All transactions of a block
+// are first executed concurrently, each one against its own branch of the
+// block state, while the keys they read, iterate over and write are recorded.
+// Results are then validated and committed in block order: a transaction that
+// read a key written by a transaction committed before it, or that would not
+// fit in the remaining block gas, is executed again against the up-to-date
+// block state. The resulting state, tx results and block gas consumption are
+// identical to those of sequential execution.
+//
+// Executing transactions speculatively is only sound if all the state they
+// depend on lives in KV stores: keepers holding mutable in-memory state must
+// not be used together with the ParallelTxExecutor, unless they leave it
+// untouched and invalidate the execution when its context is attached to an
+// sdk.UncommittedExecution, as the unordered tx decorator does.
+//
+// Keys updated by almost every transaction, such as the balance of the fee
+// collector, make every transaction conflict with the previous one. They can be
+// declared as DeltaKeys so that the changes made to them are merged instead.
+type ParallelTxExecutor struct {
+	workers int
+	deltas  []DeltaKeys
+}
+
+// NewParallelTxExecutor returns a ParallelTxExecutor running at most workers
+// transactions concurrently. If workers is not positive, GOMAXPROCS is used.
+func NewParallelTxExecutor(workers int, deltas ...DeltaKeys) *ParallelTxExecutor {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	return &ParallelTxExecutor{workers: workers, deltas: deltas}
+}
+
+// MergeDeltaFunc returns the value of a delta key once the change a transaction
+// made to it, from read to written, is applied on top of its current value. A
+// nil value stands for a missing key. It returns false if the change cannot be
+// merged.
+type MergeDeltaFunc func(read, written, current []byte) ([]byte, bool)
+
+// DeltaKeys declares the keys starting with Prefix of the store named StoreName
+// as delta keys: keys transactions only update by adding an amount to their
+// value, such as the balances of the fee collector into which fees are
+// deducted.
+//
+// A transaction reading and then writing a delta key written by a transaction
+// committed before it is not executed again by the ParallelTxExecutor: its
+// change is merged into the up-to-date value with Merge. This is only sound if
+// the outcome of the transaction does not otherwise depend on the value of the
+// key. Since the gas consumed by a transaction depends on the length of the
+// values it reads and writes, changes are only merged if the length of the
+// current and merged values equal the length of the values the transaction
+// read and wrote. Otherwise the remaining transactions of the block are
+// executed speculatively again, on top of the transactions committed so far.
+type DeltaKeys struct {
+	StoreName string
+	Prefix    []byte
+	Merge     MergeDeltaFunc
+}
+
+// maxSpeculationRounds bounds the number of times the transactions of a block
+// are executed speculatively again because the change made to a delta key
+// could not be merged.
+const maxSpeculationRounds = 8
+
+// speculativeTx holds the outcome of the speculative execution of a tx.
+type speculativeTx struct {
+	branch  *branchMultiStore
+	gasUsed storetypes.Gas
+	result  *abci.ExecTxResult
+}
+
+// ExecuteTxs implements TxExecutor.
+func (e *ParallelTxExecutor) ExecuteTxs(
+	ctx context.Context,
+	txs [][]byte,
+	ms storetypes.MultiStore,
+	blockGasMeter storetypes.GasMeter,
+	deliverTx DeliverTxFunc,
+) ([]*abci.ExecTxResult, error) {
+	results := make([]*abci.ExecTxResult, len(txs))
+	if e.workers == 1 || len(txs) < 2 {
+		for i, tx := range txs {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			results[i] = deliverTx(tx, ms, blockGasMeter)
+		}
+
+		return results, nil
+	}
+
+	speculative := e.executeSpeculatively(ctx, txs, ms, deliverTx)
+
+	// written holds the keys written since the speculative executions
+	written := make(keySet)
+	rounds := 1
+	for i := 0; i < len(txs); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		tx, spec := txs[i], speculative[i]
+		merged, stale := false, false
+		if !spec.branch.effects.invalidated && !spec.branch.accesses.conflictsWith(written) &&
+			!blockGasMeter.IsOutOfGas() && spec.gasUsed <= blockGasMeter.GasRemaining() {
+			merged, stale = mergeDeltas(spec.branch, ms, written)
+		}
+
+		if stale && rounds < maxSpeculationRounds {
+			// The length of a delta key value changed, e.g. the balance of the
+			// fee collector gained a digit, so the change of this tx and likely
+			// of the following ones cannot be merged. Execute them speculatively
+			// again on top of the transactions committed so far.
+			copy(speculative[i:], e.executeSpeculatively(ctx, txs[i:], ms, deliverTx))
+			written = make(keySet)
+			rounds++
+			i--
+			continue
+		}
+
+		if merged {
+			blockGasMeter.ConsumeGas(spec.gasUsed, "block gas meter")
+			spec.branch.Write()
+			spec.branch.effects.flush()
+			written.merge(spec.branch.accesses.writes)
+			results[i] = spec.result
+			continue
+		}
+
+		// The speculative execution observed stale state, or depends on state
+		// living outside of the multistore, so execute the tx again on top of
+		// the transactions committed so far.
+		branch := e.newBranch(ms)
+		results[i] = deliverTx(tx, branch, blockGasMeter)
+		branch.Write()
+		written.merge(branch.accesses.writes)
+	}
+
+	return results, nil
+}
+
+// executeSpeculatively executes every tx against its own tracked branch of ms
+// using up to e.workers goroutines. ms is only read from.
+func (e *ParallelTxExecutor) executeSpeculatively(
+	ctx context.Context,
+	txs [][]byte,
+	ms storetypes.MultiStore,
+	deliverTx DeliverTxFunc,
+) []speculativeTx {
+	speculative := make([]speculativeTx, len(txs))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(e.workers, len(txs)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				branch := e.newBranch(ms)
+				branch.effects = &deferredEffects{}
+				gasMeter := storetypes.NewInfiniteGasMeter()
+				result := deliverTx(txs[i], branch, gasMeter)
+				speculative[i] = speculativeTx{branch: branch, gasUsed: gasMeter.GasConsumed(), result: result}
+			}
+		}()
+	}
+
+	for i := range txs {
+		if ctx.Err() != nil {
+			break
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	// txs skipped because of an abort are never committed, but give them an
+	// empty branch so the commit loop does not need to special case them.
+	for i := range speculative {
+		if speculative[i].branch == nil {
+			speculative[i].branch = e.newBranch(ms)
+		}
+	}
+
+	return speculative
+}
+
+// newBranch returns a tracked branch of ms recording the values read from the
+// delta keys of e.
+func (e *ParallelTxExecutor) newBranch(ms storetypes.MultiStore) *branchMultiStore {
+	branch := newBranchMultiStore(ms, true)
+	branch.accesses.deltas = e.deltas
+
+	return branch
+}
+
+// mergeDeltas merges the changes the speculative execution made to the delta
+// keys written by the transactions committed before it into their current
+// value in ms, and returns true if all of them were merged. The merged values
+// are written to the branch, which is left in an unspecified state otherwise.
+// stale is true if a change could not be merged because the length of a value
+// differs from the length of the value read or written by the transaction.
+func mergeDeltas(branch *branchMultiStore, ms storetypes.MultiStore, written keySet) (merged, stale bool) {
+	for storeKey, reads := range branch.accesses.deltaReads {
+		writtenKeys := written[storeKey]
+		for key, read := range reads {
+			if _, ok := writtenKeys[key]; !ok {
+				continue
+			}
+
+			// the branch store holds the value written by the transaction, or
+			// the value it read if it did not write the key
+			store := branch.stores[storeKey]
+			value := store.Get([]byte(key))
+			if value == nil || bytes.Equal(value, read.value) {
+				return false, false
+			}
+
+			current := ms.GetKVStore(storeKey).Get([]byte(key))
+			mergedValue, ok := read.merge(read.value, value, current)
+			if !ok {
+				return false, false
+			}
+			if len(current) != len(read.value) || len(mergedValue) != len(value) {
+				return false, true
+			}
+
+			store.Set([]byte(key), mergedValue)
+		}
+	}
+
+	return true, false
+}
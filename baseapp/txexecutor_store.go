@@ -0,0 +1,281 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sort"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// keySet is a set of keys grouped by the store they belong to.
+type keySet map[storetypes.StoreKey]map[string]struct{}
+
+func (s keySet) add(storeKey storetypes.StoreKey, key []byte) {
+	keys, ok := s[storeKey]
+	if !ok {
+		keys = make(map[string]struct{})
+		s[storeKey] = keys
+	}
+	keys[string(key)] = struct{}{}
+}
+
+func (s keySet) merge(other keySet) {
+	for storeKey, keys := range other {
+		for key := range keys {
+			s.add(storeKey, []byte(key))
+		}
+	}
+}
+
+// keyRange is the [start, end) domain of an iterator, nil bounds being open.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// deltaRead is the value read by a transaction branch from a delta key.
+type deltaRead struct {
+	value []byte
+	merge MergeDeltaFunc
+}
+
+// storeAccesses records the keys a transaction branch read from, iterated over
+// and wrote to its parent multi-store. Reads from the delta keys are recorded
+// along with the value read, apart from the other reads.
+type storeAccesses struct {
+	reads      keySet
+	ranges     map[storetypes.StoreKey][]keyRange
+	writes     keySet
+	deltas     []DeltaKeys
+	deltaReads map[storetypes.StoreKey]map[string]deltaRead
+}
+
+func newStoreAccesses() *storeAccesses {
+	return &storeAccesses{
+		reads:  make(keySet),
+		ranges: make(map[storetypes.StoreKey][]keyRange),
+		writes: make(keySet),
+	}
+}
+
+// read records that key was read from the store of storeKey, value being the
+// value read.
+func (a *storeAccesses) read(storeKey storetypes.StoreKey, key, value []byte) {
+	for _, delta := range a.deltas {
+		if delta.StoreName != storeKey.Name() || !bytes.HasPrefix(key, delta.Prefix) {
+			continue
+		}
+
+		if a.deltaReads == nil {
+			a.deltaReads = make(map[storetypes.StoreKey]map[string]deltaRead)
+		}
+		reads, ok := a.deltaReads[storeKey]
+		if !ok {
+			reads = make(map[string]deltaRead)
+			a.deltaReads[storeKey] = reads
+		}
+		if _, ok := reads[string(key)]; !ok {
+			reads[string(key)] = deltaRead{value: value, merge: delta.Merge}
+		}
+
+		return
+	}
+
+	a.reads.add(storeKey, key)
+}
+
+// conflictsWith returns true if any of the recorded reads or iterated domains
+// covers a key of written.
+func (a *storeAccesses) conflictsWith(written keySet) bool {
+	for storeKey, keys := range a.reads {
+		writtenKeys := written[storeKey]
+		for key := range keys {
+			if _, ok := writtenKeys[key]; ok {
+				return true
+			}
+		}
+	}
+
+	for storeKey, ranges := range a.ranges {
+		for key := range written[storeKey] {
+			for _, r := range ranges {
+				if r.contains([]byte(key)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+var _ storetypes.KVStore = (*trackedKVStore)(nil)
+
+// trackedKVStore records every access made to the wrapped KVStore.
+type trackedKVStore struct {
+	storetypes.KVStore
+
+	storeKey storetypes.StoreKey
+	accesses *storeAccesses
+}
+
+func (s *trackedKVStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.accesses.read(s.storeKey, key, value)
+
+	return value
+}
+
+func (s *trackedKVStore) Has(key []byte) bool {
+	s.accesses.reads.add(s.storeKey, key)
+	return s.KVStore.Has(key)
+}
+
+func (s *trackedKVStore) Set(key, value []byte) {
+	s.accesses.writes.add(s.storeKey, key)
+	s.KVStore.Set(key, value)
+}
+
+func (s *trackedKVStore) Delete(key []byte) {
+	s.accesses.writes.add(s.storeKey, key)
+	s.KVStore.Delete(key)
+}
+
+func (s *trackedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.accesses.ranges[s.storeKey] = append(s.accesses.ranges[s.storeKey], keyRange{start: start, end: end})
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s *trackedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.accesses.ranges[s.storeKey] = append(s.accesses.ranges[s.storeKey], keyRange{start: start, end: end})
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s *trackedKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *trackedKVStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+var _ storetypes.CacheMultiStore = (*branchMultiStore)(nil)
+
+// branchMultiStore is a CacheMultiStore over any MultiStore which branches the
+// parent stores lazily, the first time they are accessed. When created with
+// tracking enabled, all accesses made to the parent are recorded. When a tracer
+// is set, the accesses made to the branch and its own branches are traced.
+//
+// A branchMultiStore must only be used from a single goroutine, but distinct
+// branches of the same parent may be read from concurrently.
+type branchMultiStore struct {
+	parent   storetypes.MultiStore
+	stores   map[storetypes.StoreKey]*cachekv.Store
+	accesses *storeAccesses
+	tracer   *txTracer
+
+	// effects is set on the branches of speculative executions.
+	effects *deferredEffects
+}
+
+func newBranchMultiStore(parent storetypes.MultiStore, track bool) *branchMultiStore {
+	b := &branchMultiStore{
+		parent: parent,
+		stores: make(map[storetypes.StoreKey]*cachekv.Store),
+	}
+	if track {
+		b.accesses = newStoreAccesses()
+	}
+
+	return b
+}
+
+func (b *branchMultiStore) GetStoreType() storetypes.StoreType {
+	return b.parent.GetStoreType()
+}
+
+func (b *branchMultiStore) CacheWrap() storetypes.CacheWrap {
+	return b.CacheMultiStore()
+}
+
+func (b *branchMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return b.CacheMultiStore()
+}
+
+func (b *branchMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	branch := newBranchMultiStore(b, false)
+	branch.tracer = b.tracer
+
+	return branch
+}
+
+func (b *branchMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, errors.New("cannot branch a transaction branch at a previous version")
+}
+
+func (b *branchMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return b.GetKVStore(key)
+}
+
+func (b *branchMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := b.stores[key]
+	if !ok {
+		parent := b.parent.GetKVStore(key)
+		if b.accesses != nil {
+			parent = &trackedKVStore{KVStore: parent, storeKey: key, accesses: b.accesses}
+		}
+
+		store = cachekv.NewStore(parent)
+		b.stores[key] = store
+	}
+
+	if b.tracer != nil {
+		return &tracedKVStore{KVStore: store, storeKey: key, tracer: b.tracer}
+	}
+
+	return store
+}
+
+// TracingEnabled returns false: operations are traced once the branch is
+// written to a traced parent.
+func (b *branchMultiStore) TracingEnabled() bool {
+	return false
+}
+
+func (b *branchMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return b
+}
+
+func (b *branchMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return b
+}
+
+func (b *branchMultiStore) LatestVersion() int64 {
+	return b.parent.LatestVersion()
+}
+
+// Write writes the branched stores to the parent, in store name order.
+func (b *branchMultiStore) Write() {
+	if b.tracer != nil {
+		// writes to the parent are not made by the transaction itself
+		b.tracer.depth++
+		defer func() { b.tracer.depth-- }()
+	}
+
+	keys := make([]storetypes.StoreKey, 0, len(b.stores))
+	for key := range b.stores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	for _, key := range keys {
+		b.stores[key].Write()
+	}
+}
@@ -0,0 +1,151 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// appendKeyValueImpl appends the message value to the value stored under the
+// message key, reports the number of keys in the store when the key is "count"
+// and adds the message value to the number stored under "sum", so that
+// transactions touching the same keys depend on each other.
+type appendKeyValueImpl struct{}
+
+func (appendKeyValueImpl) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey2)
+
+	// values returned by the store must not be modified
+	value := append(bytes.Clone(store.Get(msg.Key)), msg.Value...)
+	emitted := value
+	if string(msg.Key) == "count" {
+		it := store.Iterator(nil, nil)
+		n := 0
+		for ; it.Valid(); it.Next() {
+			n++
+		}
+		if err := it.Close(); err != nil {
+			return nil, err
+		}
+		value = []byte(strconv.Itoa(n))
+		emitted = value
+	}
+	if string(msg.Key) == "sum" {
+		sum, _ := strconv.Atoi(string(store.Get(msg.Key)))
+		amount, err := strconv.Atoi(string(msg.Value))
+		if err != nil {
+			return nil, err
+		}
+		value = []byte(strconv.Itoa(sum + amount))
+		// the sum is a delta key, the outcome of the tx must not depend on it
+		emitted = msg.Value
+	}
+	store.Set(msg.Key, value)
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("kv", sdk.NewAttribute("value", string(emitted))))
+
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+func newKeyValueTx(t *testing.T, suite *BaseAppSuite, key, value string) []byte {
+	t.Helper()
+	_, _, addr := testdata.KeyTestPubAddr()
+	addrStr, err := suite.ac.BytesToString(addr)
+	require.NoError(t, err)
+
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte(key), Value: []byte(value), Signer: addrStr}))
+	setTxSignature(t, builder, 0)
+
+	txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	return txBytes
+}
+
+// mergeSum is the baseapp.MergeDeltaFunc of the "sum" key.
+func mergeSum(read, written, current []byte) ([]byte, bool) {
+	r, _ := strconv.Atoi(string(read))
+	w, _ := strconv.Atoi(string(written))
+	c, _ := strconv.Atoi(string(current))
+
+	return []byte(strconv.Itoa(c + w - r)), true
+}
+
+func TestParallelTxExecutor_MatchesSequential(t *testing.T) {
+	testCases := map[string]struct {
+		maxBlockGas int64
+		deltas      []baseapp.DeltaKeys
+	}{
+		"no block gas limit": {maxBlockGas: -1},
+		"block gas limit":    {maxBlockGas: 60000},
+		"delta keys": {
+			maxBlockGas: -1,
+			deltas:      []baseapp.DeltaKeys{{StoreName: capKey2.Name(), Prefix: []byte("sum"), Merge: mergeSum}},
+		},
+		"delta keys with block gas limit": {
+			maxBlockGas: 60000,
+			deltas:      []baseapp.DeltaKeys{{StoreName: capKey2.Name(), Prefix: []byte("sum"), Merge: mergeSum}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sequential := NewBaseAppSuite(t)
+			parallel := NewBaseAppSuite(t, baseapp.SetTxExecutor(baseapp.NewParallelTxExecutor(4, tc.deltas...)))
+
+			for _, suite := range []*BaseAppSuite{sequential, parallel} {
+				baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), appendKeyValueImpl{})
+				_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+					ConsensusParams: &cmtproto.ConsensusParams{
+						Block: &cmtproto.BlockParams{MaxGas: tc.maxBlockGas},
+					},
+				})
+				require.NoError(t, err)
+			}
+
+			for height := int64(1); height <= 3; height++ {
+				var txs [][]byte
+				for i := 0; i < 20; i++ {
+					key := fmt.Sprintf("key-%d-%d", height, i)
+					switch i % 5 {
+					case 1, 2:
+						// conflicting txs, appending to a key shared across the block
+						key = "shared"
+					case 3:
+						key = "count"
+					case 4:
+						// txs adding to a counter, merged when it is a delta key
+						key = "sum"
+					}
+					txs = append(txs, newKeyValueTx(t, sequential, key, strconv.Itoa(i)))
+				}
+
+				expected, err := sequential.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Txs: txs})
+				require.NoError(t, err)
+				actual, err := parallel.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Txs: txs})
+				require.NoError(t, err)
+
+				require.Equal(t, expected.TxResults, actual.TxResults)
+				require.Equal(t, expected.AppHash, actual.AppHash)
+
+				_, err = sequential.baseApp.Commit()
+				require.NoError(t, err)
+				_, err = parallel.baseApp.Commit()
+				require.NoError(t, err)
+			}
+		})
+	}
+}
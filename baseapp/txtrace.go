@@ -0,0 +1,260 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+
+	traceservice "github.com/cosmos/cosmos-sdk/client/grpc/trace"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txTracerKey is the context key under which the tracer of a traced
+// transaction is stored.
+type txTracerKey struct{}
+
+// txTracerFromContext returns the tracer of the transaction executed with ctx,
+// or nil if the transaction is not traced.
+func txTracerFromContext(ctx sdk.Context) *txTracer {
+	tracer, _ := ctx.Value(txTracerKey{}).(*txTracer)
+	return tracer
+}
+
+// txTracer records the store accesses and per-message gas consumption of a
+// traced transaction.
+type txTracer struct {
+	codecs map[string]schema.ModuleCodec
+
+	phase    traceservice.Phase
+	msgIndex uint32
+	msgStart storetypes.Gas
+	anteGas  storetypes.Gas
+	msgsEnd  storetypes.Gas
+
+	msgs     []traceservice.MsgTrace
+	accesses []traceservice.StoreAccess
+
+	// depth is non-zero while a traced store calls into another one, so that
+	// only the accesses made by the transaction itself are recorded.
+	depth int
+}
+
+func newTxTracer(codecs map[string]schema.ModuleCodec) *txTracer {
+	return &txTracer{codecs: codecs, phase: traceservice.Phase_PHASE_ANTE}
+}
+
+// beginMsg is called before the execution of the i-th message of the tx.
+func (t *txTracer) beginMsg(i int, msg sdk.Msg, gasConsumed storetypes.Gas) {
+	if t.phase == traceservice.Phase_PHASE_ANTE {
+		t.anteGas = gasConsumed
+	}
+
+	t.phase = traceservice.Phase_PHASE_MSG
+	t.msgIndex = uint32(i)
+	t.msgStart = gasConsumed
+	t.msgs = append(t.msgs, traceservice.MsgTrace{TypeUrl: sdk.MsgTypeURL(msg)})
+}
+
+// endMsg is called after the execution of the current message.
+func (t *txTracer) endMsg(gasConsumed storetypes.Gas, err error) {
+	msg := &t.msgs[len(t.msgs)-1]
+	msg.GasUsed = gasConsumed - t.msgStart
+	if err != nil {
+		msg.Error = err.Error()
+	}
+
+	t.phase = traceservice.Phase_PHASE_POST
+	t.msgsEnd = gasConsumed
+}
+
+// record appends an access to the trace, decoding it with the module codec
+// registered for the store if any.
+func (t *txTracer) record(storeKey storetypes.StoreKey, op traceservice.Operation, key, value []byte) {
+	if t.depth > 0 {
+		return
+	}
+
+	access := traceservice.StoreAccess{
+		Phase:     t.phase,
+		Operation: op,
+		Store:     storeKey.Name(),
+		Key:       key,
+		Value:     value,
+	}
+	if t.phase == traceservice.Phase_PHASE_MSG {
+		access.MsgIndex = t.msgIndex
+	}
+
+	if cdc, ok := t.codecs[storeKey.Name()]; ok && cdc.KVDecoder != nil && op != traceservice.Operation_OPERATION_ITERATE {
+		// a decoding error still returns the parts of the update it could decode
+		updates, _ := cdc.KVDecoder(schema.KVPairUpdate{
+			Key:    key,
+			Value:  value,
+			Remove: op == traceservice.Operation_OPERATION_DELETE,
+		})
+		if len(updates) > 0 {
+			access.Collection = updates[0].TypeName
+			access.DecodedKey = marshalDecoded(updates[0].Key)
+			access.DecodedValue = marshalDecoded(updates[0].Value)
+		}
+	}
+
+	t.accesses = append(t.accesses, access)
+}
+
+// marshalDecoded returns the JSON encoding of a decoded key or value.
+func marshalDecoded(v any) string {
+	if v == nil {
+		return ""
+	}
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(bz)
+}
+
+var _ storetypes.KVStore = (*tracedKVStore)(nil)
+
+// tracedKVStore records the accesses made to a store of a traced transaction.
+type tracedKVStore struct {
+	storetypes.KVStore
+
+	storeKey storetypes.StoreKey
+	tracer   *txTracer
+}
+
+func (s *tracedKVStore) Get(key []byte) []byte {
+	s.tracer.depth++
+	value := s.KVStore.Get(key)
+	s.tracer.depth--
+
+	s.tracer.record(s.storeKey, traceservice.Operation_OPERATION_READ, key, value)
+	return value
+}
+
+func (s *tracedKVStore) Has(key []byte) bool {
+	s.tracer.depth++
+	has := s.KVStore.Has(key)
+	s.tracer.depth--
+
+	s.tracer.record(s.storeKey, traceservice.Operation_OPERATION_READ, key, nil)
+	return has
+}
+
+func (s *tracedKVStore) Set(key, value []byte) {
+	s.tracer.record(s.storeKey, traceservice.Operation_OPERATION_WRITE, key, value)
+
+	s.tracer.depth++
+	defer func() { s.tracer.depth-- }()
+	s.KVStore.Set(key, value)
+}
+
+func (s *tracedKVStore) Delete(key []byte) {
+	s.tracer.record(s.storeKey, traceservice.Operation_OPERATION_DELETE, key, nil)
+
+	s.tracer.depth++
+	defer func() { s.tracer.depth-- }()
+	s.KVStore.Delete(key)
+}
+
+func (s *tracedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.tracer.record(s.storeKey, traceservice.Operation_OPERATION_ITERATE, start, end)
+
+	s.tracer.depth++
+	defer func() { s.tracer.depth-- }()
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s *tracedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.tracer.record(s.storeKey, traceservice.Operation_OPERATION_ITERATE, start, end)
+
+	s.tracer.depth++
+	defer func() { s.tracer.depth-- }()
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s *tracedKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// TraceTx executes txBytes in finalize mode against the state committed at
+// height, or the latest committed state if height is 0, and returns a trace of
+// the execution: the gas consumed by the ante handler, each message and the
+// post handler, every access made to the module stores and the emitted events.
+// No state change is persisted, and the mempool and the in-memory state of the
+// application, such as the unordered tx manager, are left untouched.
+func (app *BaseApp) TraceTx(txBytes []byte, height int64) (*traceservice.QueryTraceTxResponse, error) {
+	queryCtx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := newTxTracer(app.moduleCodecs)
+	ms := newBranchMultiStore(queryCtx.MultiStore(), false)
+	ms.tracer = tracer
+
+	// the query context only sets the height of the header info, while the
+	// ante handler checks the timeouts of transactions against its time
+	headerInfo := queryCtx.HeaderInfo()
+	headerInfo.Time = queryCtx.BlockHeader().Time
+
+	// the effects of the execution outside of the multistore, such as the
+	// removal of the tx from the mempool, are never applied
+	ctx := queryCtx.
+		WithMultiStore(ms).
+		WithHeaderInfo(headerInfo).
+		WithTxBytes(txBytes).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithBlockGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager()).
+		WithIsSigverifyTx(app.sigverifyTx).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithConsensusParams(app.GetConsensusParams(queryCtx)).
+		WithValue(txTracerKey{}, tracer).
+		WithUncommittedExecution(&deferredEffects{})
+
+	gInfo, result, anteEvents, err := app.runTxWithContext(ctx, execModeFinalize, txBytes, nil)
+
+	resp := &traceservice.QueryTraceTxResponse{
+		Height:    ctx.BlockHeight(),
+		GasWanted: gInfo.GasWanted,
+		GasUsed:   gInfo.GasUsed,
+		Msgs:      tracer.msgs,
+		Accesses:  tracer.accesses,
+	}
+
+	switch tracer.phase {
+	case traceservice.Phase_PHASE_ANTE:
+		resp.AnteGasUsed = gInfo.GasUsed
+	case traceservice.Phase_PHASE_MSG:
+		// the current message aborted, e.g. by running out of gas
+		resp.AnteGasUsed = tracer.anteGas
+		resp.Msgs[len(resp.Msgs)-1].GasUsed = gInfo.GasUsed - tracer.msgStart
+		if err != nil {
+			resp.Msgs[len(resp.Msgs)-1].Error = err.Error()
+		}
+	case traceservice.Phase_PHASE_POST:
+		resp.AnteGasUsed = tracer.anteGas
+		resp.PostGasUsed = gInfo.GasUsed - tracer.msgsEnd
+	}
+
+	var events []abci.Event
+	if err != nil {
+		resp.Codespace, resp.Code, resp.Log = errorsmod.ABCIInfo(err, app.trace)
+		events = anteEvents
+	} else {
+		events = result.Events
+	}
+	resp.Events = sdk.MarkEventsToIndex(events, app.indexEvents)
+
+	return resp, nil
+}
@@ -0,0 +1,73 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	traceservice "github.com/cosmos/cosmos-sdk/client/grpc/trace"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTraceTx(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), appendKeyValueImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height: 1,
+		Txs:    [][]byte{newKeyValueTx(t, suite, "a", "1")},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	txBytes := newKeyValueTx(t, suite, "a", "2")
+	for i := 0; i < 2; i++ {
+		// tracing twice yields the same trace as no state change is persisted
+		res, err := suite.baseApp.TraceTx(txBytes, 0)
+		require.NoError(t, err)
+
+		require.Equal(t, int64(1), res.Height)
+		require.Zero(t, res.Code)
+		require.NotZero(t, res.GasUsed)
+		require.Equal(t, res.GasUsed, res.AnteGasUsed+res.Msgs[0].GasUsed+res.PostGasUsed)
+
+		require.Len(t, res.Msgs, 1)
+		require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{}), res.Msgs[0].TypeUrl)
+		require.Empty(t, res.Msgs[0].Error)
+
+		require.Equal(t, []traceservice.StoreAccess{
+			{
+				Phase:     traceservice.Phase_PHASE_MSG,
+				Operation: traceservice.Operation_OPERATION_READ,
+				Store:     capKey2.Name(),
+				Key:       []byte("a"),
+				Value:     []byte("1"),
+			},
+			{
+				Phase:     traceservice.Phase_PHASE_MSG,
+				Operation: traceservice.Operation_OPERATION_WRITE,
+				Store:     capKey2.Name(),
+				Key:       []byte("a"),
+				Value:     []byte("12"),
+			},
+		}, res.Accesses)
+
+		require.Len(t, res.Events, 2)
+		require.Equal(t, "message", res.Events[0].Type)
+		require.Equal(t, "kv", res.Events[1].Type)
+	}
+
+	res, err := suite.baseApp.TraceTx([]byte("invalid"), 0)
+	require.NoError(t, err)
+	require.NotZero(t, res.Code)
+	require.Empty(t, res.Msgs)
+
+	_, err = suite.baseApp.TraceTx(txBytes, 5)
+	require.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package mempool
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	mempoolv1 "cosmossdk.io/api/cosmos/mempool/v1"
+)
+
+var ServiceAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
+	Service: mempoolv1.Query_ServiceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod: "Txs",
+			Use:       "txs",
+			Short:     "Query the transactions pending in the app-side mempool, in selection order",
+			Example:   "$ <appd> query mempool txs --sender [address]",
+			FlagOptions: map[string]*autocliv1.FlagOptions{
+				"sender": {Usage: "Only list the transactions of the given sender"},
+			},
+		},
+		{
+			RpcMethod: "Senders",
+			Use:       "senders",
+			Short:     "Query the pending transactions summary and nonce gaps of every sender",
+		},
+		{
+			RpcMethod: "Count",
+			Use:       "count",
+			Short:     "Query the number of transactions pending in the app-side mempool",
+		},
+	},
+}
+
+// NewMempoolCommands is a fake `appmodule.Module` to be considered as a module
+// and be added in AutoCLI.
+func NewMempoolCommands() *mempoolModule {
+	return &mempoolModule{}
+}
+
+type mempoolModule struct{}
+
+func (m mempoolModule) IsOnePerModuleType() {}
+func (m mempoolModule) IsAppModule()        {}
+
+func (m mempoolModule) Name() string {
+	return "mempool"
+}
+
+func (m mempoolModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: ServiceAutoCLIDescriptor,
+	}
+}
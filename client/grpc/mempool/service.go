@@ -0,0 +1,263 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// App defines the application the mempool service reads the app-side mempool
+// from. It is implemented by BaseApp.
+type App interface {
+	Mempool() mempool.Mempool
+	TxEncode(tx sdk.Tx) ([]byte, error)
+}
+
+// RegisterMempoolService registers the mempool gRPC service on the provided
+// gRPC router.
+func RegisterMempoolService(server gogogrpc.Server, app App, addressCodec address.Codec) {
+	RegisterQueryServer(server, NewQueryServer(app, addressCodec))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	app             App
+	addressCodec    address.Codec
+	signerExtractor mempool.SignerExtractionAdapter
+}
+
+// NewQueryServer returns a new mempool QueryServer.
+func NewQueryServer(app App, addressCodec address.Codec) QueryServer {
+	return queryServer{
+		app:             app,
+		addressCodec:    addressCodec,
+		signerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+	}
+}
+
+// pendingTx is a transaction read from the mempool, along with its position
+// and first signer.
+type pendingTx struct {
+	tx       sdk.Tx
+	position uint64
+	sender   []byte
+	nonce    uint64
+}
+
+// Txs implements the Query/Txs gRPC method.
+func (s queryServer) Txs(ctx context.Context, req *QueryTxsRequest) (*QueryTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var sender []byte
+	if req.Sender != "" {
+		var err error
+		if sender, err = s.addressCodec.StringToBytes(req.Sender); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+		}
+	}
+
+	offset, limit, err := pageBounds(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only keep the requested page while the mempool is locked, encoding is
+	// done afterwards.
+	var (
+		page  []pendingTx
+		total uint64
+	)
+	mp := s.app.Mempool()
+	s.selectAll(ctx, mp, func(ptx pendingTx) bool {
+		if sender != nil && !bytes.Equal(sender, ptx.sender) {
+			return true
+		}
+
+		if total >= offset && uint64(len(page)) < limit {
+			page = append(page, ptx)
+		}
+		total++
+
+		return uint64(len(page)) < limit || req.Pagination.GetCountTotal()
+	})
+
+	txs := make([]*PendingTx, 0, len(page))
+	for _, ptx := range page {
+		res, err := s.pendingTx(mp, ptx)
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, res)
+	}
+
+	return &QueryTxsResponse{Txs: txs, Pagination: pageResponse(req.Pagination, total)}, nil
+}
+
+// Senders implements the Query/Senders gRPC method.
+func (s queryServer) Senders(ctx context.Context, req *QuerySendersRequest) (*QuerySendersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	offset, limit, err := pageBounds(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	nonces := make(map[string][]uint64)
+	s.selectAll(ctx, s.app.Mempool(), func(ptx pendingTx) bool {
+		nonces[string(ptx.sender)] = append(nonces[string(ptx.sender)], ptx.nonce)
+		return true
+	})
+
+	senders := make([]*SenderTxs, 0, len(nonces))
+	for sender, senderNonces := range nonces {
+		addr, err := s.addressCodec.BytesToString([]byte(sender))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		senders = append(senders, senderTxs(addr, senderNonces))
+	}
+	sort.Slice(senders, func(i, j int) bool { return senders[i].Sender < senders[j].Sender })
+
+	total := uint64(len(senders))
+	start := min(offset, total)
+	end := start + min(limit, total-start)
+
+	return &QuerySendersResponse{Senders: senders[start:end], Pagination: pageResponse(req.Pagination, total)}, nil
+}
+
+// Count implements the Query/Count gRPC method.
+func (s queryServer) Count(_ context.Context, _ *QueryCountRequest) (*QueryCountResponse, error) {
+	return &QueryCountResponse{Count: uint64(s.app.Mempool().CountTx())}, nil
+}
+
+// selectAll iterates over the mempool in selection order until the callback
+// returns false. Transactions without signers are skipped.
+func (s queryServer) selectAll(ctx context.Context, mp mempool.Mempool, callback func(pendingTx) bool) {
+	var position uint64
+	mp.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		ptx := pendingTx{tx: tx, position: position}
+		position++
+
+		signers, err := s.signerExtractor.GetSigners(tx)
+		if err != nil || len(signers) == 0 {
+			return true
+		}
+		ptx.sender, ptx.nonce = signers[0].Signer, signers[0].Sequence
+
+		return callback(ptx)
+	})
+}
+
+// pendingTx converts a transaction read from the mempool into its gRPC
+// representation.
+func (s queryServer) pendingTx(mp mempool.Mempool, ptx pendingTx) (*PendingTx, error) {
+	txBz, err := s.app.TxEncode(ptx.tx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sender, err := s.addressCodec.BytesToString(ptx.sender)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &PendingTx{
+		Hash:     fmt.Sprintf("%X", cmttypes.Tx(txBz).Hash()),
+		TxBytes:  txBz,
+		Sender:   sender,
+		Nonce:    ptx.nonce,
+		Position: ptx.position,
+	}
+
+	if getter, ok := mp.(mempool.TxPriorityGetter); ok {
+		res.Priority, _ = getter.GetTxPriority(ptx.tx)
+	}
+
+	if lanes, ok := mp.(*mempool.LaneMempool); ok {
+		if idx, err := lanes.LaneIndex(ptx.tx); err == nil {
+			res.Lane = lanes.Lanes()[idx].Name
+		}
+	}
+
+	return res, nil
+}
+
+// maxNonceGaps is the maximum number of nonce gaps reported per sender, so that
+// a sender cannot inflate the response with a far away nonce.
+const maxNonceGaps = 100
+
+// senderTxs summarizes the nonces of the pending transactions of a sender.
+func senderTxs(sender string, nonces []uint64) *SenderTxs {
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	res := &SenderTxs{
+		Sender:   sender,
+		TxCount:  uint64(len(nonces)),
+		MinNonce: nonces[0],
+		MaxNonce: nonces[len(nonces)-1],
+	}
+	for i := 1; i < len(nonces) && len(res.NonceGaps) < maxNonceGaps; i++ {
+		for gap := nonces[i-1] + 1; gap < nonces[i] && len(res.NonceGaps) < maxNonceGaps; gap++ {
+			res.NonceGaps = append(res.NonceGaps, gap)
+		}
+	}
+
+	return res
+}
+
+// pageBounds returns the offset and limit of an offset based page request.
+func pageBounds(pageReq *query.PageRequest) (offset, limit uint64, err error) {
+	if pageReq == nil {
+		return 0, query.DefaultLimit, nil
+	}
+
+	if len(pageReq.Key) > 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "key based pagination is not supported")
+	}
+
+	if pageReq.Reverse {
+		return 0, 0, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+
+	limit = pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	return pageReq.Offset, limit, nil
+}
+
+// pageResponse returns the page response of an offset based page request.
+func pageResponse(pageReq *query.PageRequest, total uint64) *query.PageResponse {
+	if !pageReq.GetCountTotal() {
+		return &query.PageResponse{}
+	}
+
+	return &query.PageResponse{Total: total}
+}
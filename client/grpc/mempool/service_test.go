@@ -0,0 +1,126 @@
+package mempool_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+type testApp struct {
+	mp       mempool.Mempool
+	txConfig client.TxConfig
+}
+
+func (a testApp) Mempool() mempool.Mempool { return a.mp }
+
+func (a testApp) TxEncode(tx sdk.Tx) ([]byte, error) { return a.txConfig.TxEncoder()(tx) }
+
+func buildTx(t *testing.T, txConfig client.TxConfig, secret string, nonce uint64) sdk.Tx {
+	t.Helper()
+
+	pubKey := secp256k1.GenPrivKeyFromSecret([]byte(secret)).PubKey()
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&testdata.TestMsg{Signers: []string{}}))
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   pubKey,
+		Sequence: nonce,
+		Data:     &signingtypes.SingleSignatureData{},
+	}))
+
+	return builder.GetTx()
+}
+
+func TestQueryServer(t *testing.T) {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+
+	mp := mempool.DefaultPriorityMempool()
+	txs := []struct {
+		tx       sdk.Tx
+		priority int64
+	}{
+		{tx: buildTx(t, txConfig, "alice", 1), priority: 10},
+		{tx: buildTx(t, txConfig, "alice", 2), priority: 10},
+		{tx: buildTx(t, txConfig, "alice", 5), priority: 10},
+		{tx: buildTx(t, txConfig, "bob", 3), priority: 20},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx.tx))
+	}
+
+	ac := signingCtx.AddressCodec()
+	alice, err := ac.BytesToString(secp256k1.GenPrivKeyFromSecret([]byte("alice")).PubKey().Address())
+	require.NoError(t, err)
+	bob, err := ac.BytesToString(secp256k1.GenPrivKeyFromSecret([]byte("bob")).PubKey().Address())
+	require.NoError(t, err)
+
+	svr := mempoolservice.NewQueryServer(testApp{mp: mp, txConfig: txConfig}, ac)
+
+	count, err := svr.Count(context.Background(), &mempoolservice.QueryCountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count.Count)
+
+	// all txs, in selection order
+	res, err := svr.Txs(ctx, &mempoolservice.QueryTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 4)
+	require.Equal(t, bob, res.Txs[0].Sender)
+	require.Equal(t, "20", res.Txs[0].Priority)
+	for i, tx := range res.Txs {
+		require.Equal(t, uint64(i), tx.Position)
+		require.NotEmpty(t, tx.Hash)
+		require.NotEmpty(t, tx.TxBytes)
+	}
+
+	// filtered by sender and paginated
+	res, err = svr.Txs(ctx, &mempoolservice.QueryTxsRequest{
+		Sender:     alice,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, alice, res.Txs[0].Sender)
+	require.Equal(t, uint64(2), res.Txs[0].Nonce)
+	require.Equal(t, uint64(2), res.Txs[0].Position)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	_, err = svr.Txs(ctx, &mempoolservice.QueryTxsRequest{Sender: "invalid"})
+	require.Error(t, err)
+
+	_, err = svr.Txs(ctx, &mempoolservice.QueryTxsRequest{Pagination: &query.PageRequest{Key: []byte("key")}})
+	require.Error(t, err)
+
+	senders, err := svr.Senders(ctx, &mempoolservice.QuerySendersRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), senders.Pagination.Total)
+
+	expected := map[string]*mempoolservice.SenderTxs{
+		alice: {Sender: alice, TxCount: 3, MinNonce: 1, MaxNonce: 5, NonceGaps: []uint64{3, 4}},
+		bob:   {Sender: bob, TxCount: 1, MinNonce: 3, MaxNonce: 3},
+	}
+	require.Len(t, senders.Senders, 2)
+	require.Less(t, senders.Senders[0].Sender, senders.Senders[1].Sender)
+	for _, sender := range senders.Senders {
+		require.Equal(t, expected[sender.Sender], sender)
+	}
+
+	senders, err = svr.Senders(ctx, &mempoolservice.QuerySendersRequest{Pagination: &query.PageRequest{Offset: 5}})
+	require.NoError(t, err)
+	require.Empty(t, senders.Senders)
+}
@@ -0,0 +1,44 @@
+package trace
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	tracev1 "cosmossdk.io/api/cosmos/trace/v1"
+)
+
+var ServiceAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
+	Service: tracev1.Query_ServiceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod: "TraceTx",
+			Use:       "tx [tx-bytes] [height]",
+			Short:     "Execute a transaction against committed state and print its execution trace",
+			Long:      "Execute a transaction against the state committed at the given height (latest by default), without persisting any change, and print the gas consumed by each message, every store access and the emitted events.",
+			Example:   "$ <appd> query trace tx [hex-or-base64-tx-bytes] 41",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "tx_bytes"},
+				{ProtoField: "height", Optional: true},
+			},
+		},
+	},
+}
+
+// NewTraceCommands is a fake `appmodule.Module` to be considered as a module
+// and be added in AutoCLI.
+func NewTraceCommands() *traceModule {
+	return &traceModule{}
+}
+
+type traceModule struct{}
+
+func (m traceModule) IsOnePerModuleType() {}
+func (m traceModule) IsAppModule()        {}
+
+func (m traceModule) Name() string {
+	return "trace"
+}
+
+func (m traceModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: ServiceAutoCLIDescriptor,
+	}
+}
@@ -0,0 +1,80 @@
+package trace
+
+import (
+	"context"
+	"errors"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// App defines the application executing the traced transactions. It is
+// implemented by BaseApp.
+type App interface {
+	TraceTx(txBytes []byte, height int64) (*QueryTraceTxResponse, error)
+}
+
+// RegisterTraceService registers the trace gRPC service on the provided gRPC
+// router.
+func RegisterTraceService(server gogogrpc.Server, app App) {
+	RegisterQueryServer(server, NewQueryServer(app))
+}
+
+// RegisterGRPCGatewayRoutes mounts the trace gRPC service's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	app App
+}
+
+// NewQueryServer returns a new trace QueryServer.
+func NewQueryServer(app App) QueryServer {
+	return queryServer{app: app}
+}
+
+// TraceTx implements the Query/TraceTx gRPC method.
+func (s queryServer) TraceTx(_ context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx bytes")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be negative")
+	}
+
+	res, err := s.app.TraceTx(req.TxBytes, req.Height)
+	if err != nil {
+		return nil, status.Error(errorCode(err), err.Error())
+	}
+
+	return res, nil
+}
+
+// errorCode returns the gRPC status code of an error returned by App.TraceTx.
+// The state at the requested height may have been pruned (NotFound) or not be
+// available yet (FailedPrecondition); anything else is an internal failure.
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, sdkerrors.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, sdkerrors.ErrInvalidHeight):
+		return codes.FailedPrecondition
+	case errors.Is(err, sdkerrors.ErrInvalidRequest):
+		return codes.InvalidArgument
+	default:
+		return codes.Internal
+	}
+}
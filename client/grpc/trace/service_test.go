@@ -0,0 +1,92 @@
+package trace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	traceservice "github.com/cosmos/cosmos-sdk/client/grpc/trace"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type testApp struct {
+	err error
+}
+
+func (a testApp) TraceTx(_ []byte, height int64) (*traceservice.QueryTraceTxResponse, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	return &traceservice.QueryTraceTxResponse{Height: height}, nil
+}
+
+func TestQueryServerTraceTx(t *testing.T) {
+	testCases := []struct {
+		name    string
+		req     *traceservice.QueryTraceTxRequest
+		appErr  error
+		expCode codes.Code
+	}{
+		{
+			name:    "nil request",
+			req:     nil,
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "empty tx bytes",
+			req:     &traceservice.QueryTraceTxRequest{},
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "negative height",
+			req:     &traceservice.QueryTraceTxRequest{TxBytes: []byte{1}, Height: -1},
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "pruned height",
+			req:     &traceservice.QueryTraceTxRequest{TxBytes: []byte{1}, Height: 1},
+			appErr:  errorsmod.Wrap(sdkerrors.ErrNotFound, "failed to load state at height 1"),
+			expCode: codes.NotFound,
+		},
+		{
+			name:    "future height",
+			req:     &traceservice.QueryTraceTxRequest{TxBytes: []byte{1}, Height: 100},
+			appErr:  errorsmod.Wrap(sdkerrors.ErrInvalidHeight, "cannot query with height in the future"),
+			expCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "invalid request",
+			req:     &traceservice.QueryTraceTxRequest{TxBytes: []byte{1}},
+			appErr:  errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "bad request"),
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "unexpected error",
+			req:     &traceservice.QueryTraceTxRequest{TxBytes: []byte{1}},
+			appErr:  errors.New("boom"),
+			expCode: codes.Internal,
+		},
+		{
+			name:    "success",
+			req:     &traceservice.QueryTraceTxRequest{TxBytes: []byte{1}, Height: 5},
+			expCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			qs := traceservice.NewQueryServer(testApp{err: tc.appErr})
+			res, err := qs.TraceTx(context.Background(), tc.req)
+			require.Equal(t, tc.expCode, status.Code(err))
+			if tc.expCode == codes.OK {
+				require.Equal(t, tc.req.Height, res.Height)
+			}
+		})
+	}
+}
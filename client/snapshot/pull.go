@@ -0,0 +1,159 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// maxManifestSize is the maximum size of a snapshot manifest.
+const maxManifestSize = 64 << 20
+
+// PullSnapshotCmd returns a command to pull a snapshot from a remote into the local snapshot store
+func PullSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pull <height> <format> <remote>",
+		Short: "Pull a snapshot from a remote directory or s3://<bucket>/<prefix> into the local snapshot store",
+		Long: `Pull a snapshot pushed with the push command into the local snapshot store.
+The checksums of the chunks are verified against the snapshot manifest, and nothing is saved if any of them does not match.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper := client.GetViperFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(viper)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			remote, err := remoteFromCmd(cmd, args[2])
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := pullSnapshot(cmd.Context(), snapshotStore, remote, height, uint32(format))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot pulled at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	addRemoteFlags(cmd)
+
+	return cmd
+}
+
+// pullSnapshot pulls a snapshot from the remote into the local snapshot store, verifying the
+// checksum of each chunk against its manifest.
+func pullSnapshot(ctx context.Context, snapshotStore *snapshots.Store, remote Remote, height uint64, format uint32) (*snapshottypes.Snapshot, error) {
+	existing, err := snapshotStore.Get(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("snapshot already exists for height %d format %d", height, format)
+	}
+
+	manifest, err := getManifest(ctx, remote, height, format)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := verifySnapshotManifest(manifest, height, format)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make(chan io.ReadCloser)
+	done := make(chan error, 1)
+	go func() {
+		defer close(chunks)
+
+		for i, chunk := range manifest.Chunks {
+			bz, err := getChunk(ctx, remote, height, format, uint32(i), chunk)
+			if err != nil {
+				done <- err
+				return
+			}
+			chunks <- io.NopCloser(bytes.NewReader(bz))
+		}
+		done <- nil
+	}()
+
+	imported, err := snapshotStore.Import(snapshot, chunks)
+	if pullErr := <-done; pullErr != nil {
+		if err == nil {
+			_ = snapshotStore.Delete(height, format)
+		}
+		return nil, pullErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return imported, nil
+}
+
+func getManifest(ctx context.Context, remote Remote, height uint64, format uint32) (*Manifest, error) {
+	body, err := remote.Get(ctx, remoteSnapshotPath(height, format, ManifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifest: %w", err)
+	}
+	defer body.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(io.LimitReader(body, maxManifestSize)).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	return &manifest, nil
+}
+
+// getChunk downloads a chunk, checking its size and checksum.
+func getChunk(ctx context.Context, remote Remote, height uint64, format, index uint32, chunk ManifestChunk) ([]byte, error) {
+	if chunk.Name != strconv.FormatUint(uint64(index), 10) {
+		return nil, fmt.Errorf("invalid manifest, expected chunk %d, got %s", index, chunk.Name)
+	}
+
+	body, err := remote.Get(ctx, remoteSnapshotPath(height, format, chunk.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chunk %d: %w", index, err)
+	}
+	defer body.Close()
+
+	bz, err := io.ReadAll(io.LimitReader(body, chunk.Size+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chunk %d: %w", index, err)
+	}
+	if int64(len(bz)) != chunk.Size {
+		return nil, fmt.Errorf("chunk %d size mismatch: expected %d bytes, got %d", index, chunk.Size, len(bz))
+	}
+	checksum := sha256.Sum256(bz)
+	if hex.EncodeToString(checksum[:]) != chunk.SHA256 {
+		return nil, fmt.Errorf("chunk %d checksum mismatch: expected %s, got %x", index, chunk.SHA256, checksum)
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,188 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// Manifest describes a snapshot pushed to a remote. It is written once all the chunks
+// are pushed, so a snapshot is complete if its manifest exists.
+type Manifest struct {
+	Height uint64 `json:"height"`
+	Format uint32 `json:"format"`
+	// Hash is the hex-encoded hash of the snapshot.
+	Hash string `json:"hash"`
+	// Chunks lists the chunks of the snapshot, in order.
+	Chunks []ManifestChunk `json:"chunks"`
+	// Snapshot is the protobuf-encoded snapshot, including its metadata.
+	Snapshot []byte `json:"snapshot"`
+}
+
+// ManifestChunk describes a chunk of a snapshot pushed to a remote.
+type ManifestChunk struct {
+	// Name is the name of the chunk object, relative to the snapshot.
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+	// SHA256 is the hex-encoded SHA-256 checksum of the chunk.
+	SHA256 string `json:"sha256"`
+}
+
+// remoteSnapshotPath returns the path of a snapshot object in a remote.
+func remoteSnapshotPath(height uint64, format uint32, name string) string {
+	return fmt.Sprintf("%d/%d/%s", height, format, name)
+}
+
+// PushSnapshotCmd returns a command to push a local snapshot to a remote
+func PushSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "push <height> <format> <remote>",
+		Short: "Push a local snapshot and its manifest to a remote directory or s3://<bucket>/<prefix>",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper := client.GetViperFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(viper)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			remote, err := remoteFromCmd(cmd, args[2])
+			if err != nil {
+				return err
+			}
+
+			manifest, err := pushSnapshot(cmd.Context(), snapshotStore, remote, height, uint32(format))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot pushed at height %d, format %d, chunks %d\n", manifest.Height, manifest.Format, len(manifest.Chunks))
+			return nil
+		},
+	}
+
+	addRemoteFlags(cmd)
+
+	return cmd
+}
+
+// pushSnapshot pushes the chunks of a local snapshot to the remote, checking them against the
+// snapshot metadata, then its manifest.
+func pushSnapshot(ctx context.Context, snapshotStore *snapshots.Store, remote Remote, height uint64, format uint32) (*Manifest, error) {
+	snapshot, err := snapshotStore.Get(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, errors.New("snapshot doesn't exist")
+	}
+	if len(snapshot.Metadata.ChunkHashes) != int(snapshot.Chunks) {
+		return nil, fmt.Errorf("snapshot has %d chunks but %d chunk hashes", snapshot.Chunks, len(snapshot.Metadata.ChunkHashes))
+	}
+
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{
+		Height:   snapshot.Height,
+		Format:   snapshot.Format,
+		Hash:     hex.EncodeToString(snapshot.Hash),
+		Chunks:   make([]ManifestChunk, snapshot.Chunks),
+		Snapshot: bz,
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := readChunk(snapshotStore, height, format, i)
+		if err != nil {
+			return nil, err
+		}
+		checksum := sha256.Sum256(chunk)
+		if !bytes.Equal(checksum[:], snapshot.Metadata.ChunkHashes[i]) {
+			return nil, fmt.Errorf("local chunk %d checksum mismatch: expected %x, got %x", i, snapshot.Metadata.ChunkHashes[i], checksum)
+		}
+
+		name := strconv.FormatUint(uint64(i), 10)
+		if err := remote.Put(ctx, remoteSnapshotPath(height, format, name), bytes.NewReader(chunk), int64(len(chunk))); err != nil {
+			return nil, fmt.Errorf("failed to push chunk %d: %w", i, err)
+		}
+		manifest.Chunks[i] = ManifestChunk{
+			Name:   name,
+			Size:   int64(len(chunk)),
+			SHA256: hex.EncodeToString(checksum[:]),
+		}
+	}
+
+	bz, err = json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := remote.Put(ctx, remoteSnapshotPath(height, format, ManifestFileName), bytes.NewReader(bz), int64(len(bz))); err != nil {
+		return nil, fmt.Errorf("failed to push manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+func readChunk(snapshotStore *snapshots.Store, height uint64, format, index uint32) ([]byte, error) {
+	chunk, err := snapshotStore.LoadChunk(height, format, index)
+	if err != nil {
+		return nil, err
+	}
+	if chunk == nil {
+		return nil, fmt.Errorf("chunk %d doesn't exist", index)
+	}
+	defer chunk.Close()
+
+	return io.ReadAll(chunk)
+}
+
+// verifySnapshotManifest checks that the manifest matches the snapshot it contains, returning it.
+func verifySnapshotManifest(manifest *Manifest, height uint64, format uint32) (*snapshottypes.Snapshot, error) {
+	var snapshot snapshottypes.Snapshot
+	if err := snapshot.Unmarshal(manifest.Snapshot); err != nil {
+		return nil, fmt.Errorf("invalid manifest, failed to unmarshal snapshot: %w", err)
+	}
+
+	switch {
+	case manifest.Height != height || manifest.Format != format:
+		return nil, fmt.Errorf("invalid manifest, expected snapshot %d/%d, got %d/%d", height, format, manifest.Height, manifest.Format)
+	case snapshot.Height != height || snapshot.Format != format:
+		return nil, fmt.Errorf("invalid manifest, expected snapshot %d/%d, got %d/%d", height, format, snapshot.Height, snapshot.Format)
+	case manifest.Hash != hex.EncodeToString(snapshot.Hash):
+		return nil, fmt.Errorf("invalid manifest, snapshot hash %x does not match %s", snapshot.Hash, manifest.Hash)
+	case len(manifest.Chunks) != int(snapshot.Chunks) || len(snapshot.Metadata.ChunkHashes) != int(snapshot.Chunks):
+		return nil, fmt.Errorf("invalid manifest, expected %d chunks, got %d", snapshot.Chunks, len(manifest.Chunks))
+	}
+
+	for i, chunk := range manifest.Chunks {
+		if chunk.SHA256 != hex.EncodeToString(snapshot.Metadata.ChunkHashes[i]) {
+			return nil, fmt.Errorf("invalid manifest, chunk %d checksum %s does not match %x", i, chunk.SHA256, snapshot.Metadata.ChunkHashes[i])
+		}
+	}
+
+	return &snapshot, nil
+}
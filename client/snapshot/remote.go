@@ -0,0 +1,165 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagS3Endpoint = "s3-endpoint"
+	flagS3Region   = "s3-region"
+
+	// ManifestFileName is the name of the manifest of a snapshot pushed to a remote.
+	ManifestFileName = "manifest.json"
+)
+
+// ErrRemoteNotFound is returned by a Remote when an object does not exist.
+var ErrRemoteNotFound = errors.New("object not found")
+
+// Remote is a storage backend snapshots are pushed to and pulled from, addressing
+// objects by slash-separated names.
+type Remote interface {
+	// Put writes an object of the given size, replacing any existing object with the same name.
+	Put(ctx context.Context, name string, body io.ReadSeeker, size int64) error
+	// Get returns the content of an object, or ErrRemoteNotFound if it does not exist.
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// NewRemote returns the Remote at the given location, either a local directory path (optionally
+// as a file:// URL) or an s3://<bucket>/<prefix> URL of an S3-compatible object store.
+//
+// S3 credentials are read from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and optional
+// AWS_SESSION_TOKEN environment variables.
+func NewRemote(location string, s3Config S3Config) (Remote, error) {
+	u, err := url.Parse(location)
+	if err != nil || u.Scheme == "" || len(u.Scheme) == 1 { // a single letter is a windows drive
+		return NewDirRemote(location)
+	}
+
+	switch u.Scheme {
+	case "file":
+		return NewDirRemote(u.Path)
+	case "s3":
+		if u.Host == "" {
+			return nil, fmt.Errorf("invalid remote %s: missing bucket", location)
+		}
+		s3Config.Bucket = u.Host
+		s3Config.Prefix = strings.Trim(u.Path, "/")
+		if s3Config.AccessKeyID == "" {
+			s3Config.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+			s3Config.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+			s3Config.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+		}
+		return NewS3Remote(s3Config)
+	default:
+		return nil, fmt.Errorf("unsupported remote %s: expected a directory or an s3:// URL", location)
+	}
+}
+
+// addRemoteFlags adds the flags configuring the remotes to the command.
+func addRemoteFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagS3Endpoint, defaultS3Endpoint, "Endpoint of the S3-compatible API of s3:// remotes")
+	cmd.Flags().String(flagS3Region, defaultS3Region, "Region of the buckets of s3:// remotes")
+}
+
+// remoteFromCmd returns the Remote at the given location, configured by the command flags.
+func remoteFromCmd(cmd *cobra.Command, location string) (Remote, error) {
+	endpoint, err := cmd.Flags().GetString(flagS3Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	region, err := cmd.Flags().GetString(flagS3Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemote(location, S3Config{Endpoint: endpoint, Region: region})
+}
+
+var _ Remote = (*DirRemote)(nil)
+
+// DirRemote is a Remote storing objects as files in a local directory, which may be a
+// mounted network filesystem.
+type DirRemote struct {
+	dir string
+}
+
+// NewDirRemote returns a Remote storing objects in the given directory, created if needed.
+func NewDirRemote(dir string) (*DirRemote, error) {
+	if dir == "" {
+		return nil, errors.New("remote directory cannot be empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create remote directory: %w", err)
+	}
+
+	return &DirRemote{dir: dir}, nil
+}
+
+func (r *DirRemote) path(name string) (string, error) {
+	clean := path.Clean("/" + name)
+	if clean == "/" || clean != "/"+name {
+		return "", fmt.Errorf("invalid object name %q", name)
+	}
+
+	return filepath.Join(r.dir, filepath.FromSlash(clean)), nil
+}
+
+// Put implements Remote. The object is written to a temporary file first, so that a partially
+// written object is never visible.
+func (r *DirRemote) Put(_ context.Context, name string, body io.ReadSeeker, _ int64) error {
+	p, err := r.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p), "."+filepath.Base(p)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // removed only if not renamed
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), p)
+}
+
+// Get implements Remote.
+func (r *DirRemote) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	p, err := r.path(name)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", name, ErrRemoteNotFound)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
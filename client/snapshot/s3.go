@@ -0,0 +1,229 @@
+package snapshot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultS3Endpoint = "https://s3.amazonaws.com"
+	defaultS3Region   = "us-east-1"
+
+	s3Algorithm   = "AWS4-HMAC-SHA256"
+	s3TimeFormat  = "20060102T150405Z"
+	s3DateFormat  = "20060102"
+	s3ServiceName = "s3"
+)
+
+// S3Config configures an S3Remote.
+type S3Config struct {
+	// Endpoint is the base URL of the S3-compatible API, e.g. https://s3.amazonaws.com or
+	// http://localhost:9000 for a local stand-in.
+	Endpoint string
+	// Region is the region of the bucket, used to sign the requests.
+	Region string
+	// Bucket is the name of the bucket, addressed in the path of the requests.
+	Bucket string
+	// Prefix is prepended to the names of the objects.
+	Prefix string
+
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+var _ Remote = (*S3Remote)(nil)
+
+// S3Remote is a Remote storing objects in a bucket of an S3-compatible object store, using
+// path-style requests signed with AWS Signature Version 4.
+type S3Remote struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3Remote returns a Remote storing objects in the configured S3 bucket.
+func NewS3Remote(config S3Config) (*S3Remote, error) {
+	if config.Endpoint == "" {
+		config.Endpoint = defaultS3Endpoint
+	}
+	if config.Region == "" {
+		config.Region = defaultS3Region
+	}
+	if config.Bucket == "" {
+		return nil, errors.New("s3 bucket cannot be empty")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, errors.New("s3 credentials are required, set AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	}
+
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", config.Endpoint)
+	}
+
+	return &S3Remote{
+		config:   config,
+		endpoint: endpoint,
+		client:   &http.Client{},
+		now:      time.Now,
+	}, nil
+}
+
+// objectURL returns the URL of an object, with its path segments escaped as required by
+// the signature.
+func (r *S3Remote) objectURL(name string) *url.URL {
+	key := name
+	if r.config.Prefix != "" {
+		key = r.config.Prefix + "/" + name
+	}
+
+	u := *r.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + r.config.Bucket + "/" + key
+	u.RawPath = s3EscapePath(u.Path)
+	return &u
+}
+
+// Put implements Remote. The content is hashed first so that the store checks its integrity.
+func (r *S3Remote) Put(ctx context.Context, name string, body io.ReadSeeker, size int64) error {
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, body); err != nil {
+		return err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, r.objectURL(name).String(), io.NopCloser(body))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	r.sign(req, hex.EncodeToString(hasher.Sum(nil)))
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to put %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to put %s: %w", name, s3ResponseError(resp))
+	}
+
+	return nil
+}
+
+// Get implements Remote.
+func (r *S3Remote) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.objectURL(name).String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	emptyHash := sha256.Sum256(nil)
+	r.sign(req, hex.EncodeToString(emptyHash[:]))
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", name, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %w", name, ErrRemoteNotFound)
+	default:
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("failed to get %s: %w", name, s3ResponseError(resp))
+	}
+}
+
+// sign signs the request with AWS Signature Version 4, see
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_sigv-create-signed-request.html.
+func (r *S3Remote) sign(req *http.Request, payloadHash string) {
+	now := r.now().UTC()
+	amzDate := now.Format(s3TimeFormat)
+	scope := strings.Join([]string{now.Format(s3DateFormat), r.config.Region, s3ServiceName, "aws4_request"}, "/")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if r.config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", r.config.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(req.Header.Get(name))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	key := []byte("AWS4" + r.config.SecretAccessKey)
+	for _, part := range strings.Split(scope, "/") {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, r.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3EscapePath escapes all the characters of a path but the unreserved ones and slashes.
+func s3EscapePath(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		if c == '/' || c == '-' || c == '_' || c == '.' || c == '~' ||
+			('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// s3ResponseError returns an error describing a failed S3 response.
+func s3ResponseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
@@ -175,6 +175,7 @@ replace github.com/cosmos/cosmos-sdk => ./../../
 // TODO remove post spinning out all modules
 replace (
 	cosmossdk.io/api => ./../../api
+	cosmossdk.io/collections => ./../../collections
 	cosmossdk.io/store => ./../../store
 	cosmossdk.io/x/bank => ./../../x/bank
 	cosmossdk.io/x/gov => ./../../x/gov
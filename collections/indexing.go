@@ -133,6 +133,9 @@ func (c collectionImpl[K, V]) schemaCodec() (*collectionSchemaCodec, error) {
 		if err != nil {
 			return nil, err
 		}
+		if keyDecoder.ToSchemaType == nil {
+			return x, nil
+		}
 		return keyDecoder.ToSchemaType(x)
 	}
 	ensureFieldNames(c.m.kc, "key", res.objectType.KeyFields)
@@ -147,6 +150,9 @@ func (c collectionImpl[K, V]) schemaCodec() (*collectionSchemaCodec, error) {
 		if err != nil {
 			return nil, err
 		}
+		if valueDecoder.ToSchemaType == nil {
+			return x, nil
+		}
 		return valueDecoder.ToSchemaType(x)
 	}
 	ensureFieldNames(c.m.vc, "value", res.objectType.ValueFields)
@@ -165,7 +171,7 @@ func ensureFieldNames(x any, defaultName string, cols []schema.Field) {
 		}
 	}
 	for i, col := range cols {
-		if names != nil && i < len(names) {
+		if i < len(names) && names[i] != "" {
 			col.Name = names[i]
 		} else if col.Name == "" {
 			if i == 0 && len(cols) == 1 {
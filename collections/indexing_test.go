@@ -0,0 +1,51 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+)
+
+func TestModuleCodec(t *testing.T) {
+	sk, ctx := deps()
+	sb := NewSchemaBuilder(sk)
+	item := NewItem(sb, NewPrefix(0), "item", Uint64Value)
+	m := NewMap(sb, NewPrefix(1), "map", StringKey, Uint64Value)
+	pairs := NewMap(sb, NewPrefix(2), "pairs", PairKeyCodec(StringKey, Uint64Key), StringValue)
+	s, err := sb.Build()
+	require.NoError(t, err)
+
+	cdc, err := s.ModuleCodec(IndexingOptions{})
+	require.NoError(t, err)
+
+	// unnamed key fields get the default names
+	typ, ok := cdc.Schema.LookupStateObjectType("pairs")
+	require.True(t, ok)
+	require.Equal(t, "key1", typ.KeyFields[0].Name)
+	require.Equal(t, "key2", typ.KeyFields[1].Name)
+
+	require.NoError(t, item.Set(ctx, 1))
+	require.NoError(t, m.Set(ctx, "a", 2))
+	require.NoError(t, pairs.Set(ctx, Join("b", uint64(3)), "c"))
+
+	expected := []schema.StateObjectUpdate{
+		{TypeName: "item", Value: uint64(1)},
+		{TypeName: "map", Key: "a", Value: uint64(2)},
+		{TypeName: "pairs", Key: []interface{}{"b", uint64(3)}, Value: "c"},
+	}
+
+	store := sk.OpenKVStore(ctx)
+	it, err := store.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+
+	var updates []schema.StateObjectUpdate
+	for ; it.Valid(); it.Next() {
+		update, err := cdc.KVDecoder(schema.KVPairUpdate{Key: it.Key(), Value: it.Value()})
+		require.NoError(t, err)
+		updates = append(updates, update...)
+	}
+	require.Equal(t, expected, updates)
+}
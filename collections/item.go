@@ -65,3 +65,10 @@ func (noKey) DecodeJSON(b []byte) (noKey, error) {
 func (k noKey) EncodeNonTerminal(_ []byte, _ noKey) (int, error) { panic("must not be called") }
 func (k noKey) DecodeNonTerminal(_ []byte) (int, noKey, error)   { panic("must not be called") }
 func (k noKey) SizeNonTerminal(_ noKey) int                      { panic("must not be called") }
+
+// SchemaCodec returns a schema codec without key fields, the item being a singleton.
+func (k noKey) SchemaCodec() (codec.SchemaCodec[noKey], error) {
+	return codec.SchemaCodec[noKey]{
+		ToSchemaType: func(noKey) (any, error) { return nil, nil },
+	}, nil
+}
@@ -235,32 +235,59 @@ func (p pairKeyCodec[K1, K2]) Name() string {
 }
 
 func (p pairKeyCodec[K1, K2]) SchemaCodec() (codec.SchemaCodec[Pair[K1, K2]], error) {
-	field1, err := getNamedKeyField(p.keyCodec1, p.key1Name)
+	field1, toSchema1, err := getNamedKeyField(p.keyCodec1, p.key1Name)
 	if err != nil {
 		return codec.SchemaCodec[Pair[K1, K2]]{}, fmt.Errorf("error getting key1 field: %w", err)
 	}
 
-	field2, err := getNamedKeyField(p.keyCodec2, p.key2Name)
+	field2, toSchema2, err := getNamedKeyField(p.keyCodec2, p.key2Name)
 	if err != nil {
 		return codec.SchemaCodec[Pair[K1, K2]]{}, fmt.Errorf("error getting key2 field: %w", err)
 	}
 
 	return codec.SchemaCodec[Pair[K1, K2]]{
 		Fields: []schema.Field{field1, field2},
+		ToSchemaType: func(key Pair[K1, K2]) (any, error) {
+			return toSchemaKeys(
+				func() (any, error) { return toSchema1(key.K1()) },
+				func() (any, error) { return toSchema2(key.K2()) },
+			)
+		},
 	}, nil
 }
 
-func getNamedKeyField[T any](keyCdc codec.KeyCodec[T], name string) (schema.Field, error) {
+// getNamedKeyField returns the schema field of a part of a composite key, with
+// the given name, and the function converting the part to its schema value.
+func getNamedKeyField[T any](keyCdc codec.KeyCodec[T], name string) (schema.Field, func(T) (any, error), error) {
 	keySchema, err := codec.KeySchemaCodec(keyCdc)
 	if err != nil {
-		return schema.Field{}, err
+		return schema.Field{}, nil, err
 	}
 	if len(keySchema.Fields) != 1 {
-		return schema.Field{}, fmt.Errorf("key schema in composite key has more than one field, got %v", keySchema.Fields)
+		return schema.Field{}, nil, fmt.Errorf("key schema in composite key has more than one field, got %v", keySchema.Fields)
 	}
 	field := keySchema.Fields[0]
 	field.Name = name
-	return field, nil
+
+	toSchema := keySchema.ToSchemaType
+	if toSchema == nil {
+		toSchema = func(t T) (any, error) { return t, nil }
+	}
+	return field, toSchema, nil
+}
+
+// toSchemaKeys returns the schema value of a composite key from the schema
+// values of its parts.
+func toSchemaKeys(parts ...func() (any, error)) (any, error) {
+	keys := make([]interface{}, len(parts))
+	for i, part := range parts {
+		key, err := part()
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return keys, nil
 }
 
 // NewPrefixUntilPairRange defines a collection query which ranges until the provided Pair prefix.
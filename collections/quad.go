@@ -360,28 +360,36 @@ func (t quadKeyCodec[K1, K2, K3, K4]) SizeNonTerminal(key Quad[K1, K2, K3, K4])
 }
 
 func (t quadKeyCodec[K1, K2, K3, K4]) SchemaCodec() (codec.SchemaCodec[Quad[K1, K2, K3, K4]], error) {
-	field1, err := getNamedKeyField(t.keyCodec1, t.name1)
+	field1, toSchema1, err := getNamedKeyField(t.keyCodec1, t.name1)
 	if err != nil {
 		return codec.SchemaCodec[Quad[K1, K2, K3, K4]]{}, fmt.Errorf("error getting key1 field: %w", err)
 	}
 
-	field2, err := getNamedKeyField(t.keyCodec2, t.name2)
+	field2, toSchema2, err := getNamedKeyField(t.keyCodec2, t.name2)
 	if err != nil {
 		return codec.SchemaCodec[Quad[K1, K2, K3, K4]]{}, fmt.Errorf("error getting key2 field: %w", err)
 	}
 
-	field3, err := getNamedKeyField(t.keyCodec3, t.name3)
+	field3, toSchema3, err := getNamedKeyField(t.keyCodec3, t.name3)
 	if err != nil {
 		return codec.SchemaCodec[Quad[K1, K2, K3, K4]]{}, fmt.Errorf("error getting key3 field: %w", err)
 	}
 
-	field4, err := getNamedKeyField(t.keyCodec4, t.name4)
+	field4, toSchema4, err := getNamedKeyField(t.keyCodec4, t.name4)
 	if err != nil {
 		return codec.SchemaCodec[Quad[K1, K2, K3, K4]]{}, fmt.Errorf("error getting key4 field: %w", err)
 	}
 
 	return codec.SchemaCodec[Quad[K1, K2, K3, K4]]{
 		Fields: []schema.Field{field1, field2, field3, field4},
+		ToSchemaType: func(key Quad[K1, K2, K3, K4]) (any, error) {
+			return toSchemaKeys(
+				func() (any, error) { return toSchema1(key.K1()) },
+				func() (any, error) { return toSchema2(key.K2()) },
+				func() (any, error) { return toSchema3(key.K3()) },
+				func() (any, error) { return toSchema4(key.K4()) },
+			)
+		},
 	}, nil
 }
 
@@ -294,23 +294,30 @@ func (t tripleKeyCodec[K1, K2, K3]) Name() string {
 }
 
 func (t tripleKeyCodec[K1, K2, K3]) SchemaCodec() (codec.SchemaCodec[Triple[K1, K2, K3]], error) {
-	field1, err := getNamedKeyField(t.keyCodec1, t.key1Name)
+	field1, toSchema1, err := getNamedKeyField(t.keyCodec1, t.key1Name)
 	if err != nil {
 		return codec.SchemaCodec[Triple[K1, K2, K3]]{}, fmt.Errorf("error getting key1 field: %w", err)
 	}
 
-	field2, err := getNamedKeyField(t.keyCodec2, t.key2Name)
+	field2, toSchema2, err := getNamedKeyField(t.keyCodec2, t.key2Name)
 	if err != nil {
 		return codec.SchemaCodec[Triple[K1, K2, K3]]{}, fmt.Errorf("error getting key2 field: %w", err)
 	}
 
-	field3, err := getNamedKeyField(t.keyCodec3, t.key3Name)
+	field3, toSchema3, err := getNamedKeyField(t.keyCodec3, t.key3Name)
 	if err != nil {
 		return codec.SchemaCodec[Triple[K1, K2, K3]]{}, fmt.Errorf("error getting key3 field: %w", err)
 	}
 
 	return codec.SchemaCodec[Triple[K1, K2, K3]]{
 		Fields: []schema.Field{field1, field2, field3},
+		ToSchemaType: func(key Triple[K1, K2, K3]) (any, error) {
+			return toSchemaKeys(
+				func() (any, error) { return toSchema1(key.K1()) },
+				func() (any, error) { return toSchema2(key.K2()) },
+				func() (any, error) { return toSchema3(key.K3()) },
+			)
+		},
 	}, nil
 }
 
@@ -4,6 +4,8 @@ import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
+	traceservice "github.com/cosmos/cosmos-sdk/client/grpc/trace"
 	"github.com/cosmos/cosmos-sdk/runtime/services"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -17,5 +19,8 @@ func (a *App) registerRuntimeServices(cfg module.Configurator) error { //nolint:
 	}
 	reflectionv1.RegisterReflectionServiceServer(cfg.QueryServer(), reflectionSvc)
 
+	mempoolservice.RegisterMempoolService(cfg.QueryServer(), a, a.interfaceRegistry.SigningContext().AddressCodec())
+	traceservice.RegisterTraceService(cfg.QueryServer(), a)
+
 	return nil
 }
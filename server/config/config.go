@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 const (
@@ -46,6 +47,14 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningKeepTime defines for how long, based on the block times, the heights
+	// are kept on top of the recent heights, e.g. "720h". "0s" disables it.
+	PruningKeepTime string `mapstructure:"pruning-keep-time"`
+
+	// PruningKeepSnapshots defines whether the heights since the oldest retained
+	// state sync snapshot are kept, so that the snapshots can be served.
+	PruningKeepSnapshots bool `mapstructure:"pruning-keep-snapshots"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -150,6 +159,14 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotMaxDeltas sets the number of delta snapshots taken in a row
+	// before a full snapshot is taken again. 0 disables delta snapshots.
+	SnapshotMaxDeltas uint32 `mapstructure:"snapshot-max-deltas"`
+
+	// SnapshotCompression sets the compressor of the snapshot chunks, e.g. zstd.
+	// Empty keeps the single zlib stream supported by all nodes.
+	SnapshotCompression string `mapstructure:"snapshot-compression"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -160,8 +177,32 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+
+	// Type defines the app-side mempool implementation, either sender-nonce
+	// (the default) or priority-nonce.
+	Type string `mapstructure:"type"`
+
+	// EvictionPolicy defines which tx is evicted to make room for a new tx when
+	// a priority-nonce mempool is full: none, lowest-priority or oldest.
+	EvictionPolicy string `mapstructure:"eviction-policy"`
+
+	// MaxTxsPerSender caps the number of txs a single sender may have in a
+	// priority-nonce mempool. Zero means no cap.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+
+	// MinFeeBump defines the minimum gas price increase, in percent, a tx must
+	// pay to replace a tx with the same sender and nonce in a priority-nonce
+	// mempool. Zero means any tx replaces the existing one.
+	MinFeeBump uint64 `mapstructure:"min-fee-bump"`
 }
 
+const (
+	// MempoolTypeSenderNonce selects the sender-nonce app-side mempool.
+	MempoolTypeSenderNonce = "sender-nonce"
+	// MempoolTypePriorityNonce selects the priority-nonce app-side mempool.
+	MempoolTypePriorityNonce = "priority-nonce"
+)
+
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
@@ -218,6 +259,7 @@ func DefaultConfig() *Config {
 			Pruning:             pruningtypes.PruningOptionDefault,
 			PruningKeepRecent:   "0",
 			PruningInterval:     "0",
+			PruningKeepTime:     "0s",
 			MinRetainBlocks:     0,
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250,
@@ -253,7 +295,9 @@ func DefaultConfig() *Config {
 			},
 		},
 		Mempool: MempoolConfig{
-			MaxTxs: -1,
+			MaxTxs:         -1,
+			Type:           MempoolTypeSenderNonce,
+			EvictionPolicy: mempool.EvictionPolicyNone.String(),
 		},
 	}
 }
@@ -277,6 +321,12 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if t := c.Mempool.Type; t != "" && t != MempoolTypeSenderNonce && t != MempoolTypePriorityNonce {
+		return sdkerrors.ErrAppConfig.Wrapf("unknown mempool type %q", t)
+	}
+	if _, err := mempool.ParseEvictionPolicy(c.Mempool.EvictionPolicy); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
 
 	return nil
 }
@@ -24,6 +24,14 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# These are applied to all the strategies but nothing.
+# pruning-keep-time keeps the heights whose block time is within the given duration of the
+# latest block time, on top of the recent heights kept (e.g. "720h" for 30 days, "0s" to disable).
+pruning-keep-time = "{{ .BaseConfig.PruningKeepTime }}"
+# pruning-keep-snapshots keeps the heights since the oldest state sync snapshot retained, so
+# that the retained snapshots can always be served to the nodes state syncing.
+pruning-keep-snapshots = {{ .BaseConfig.PruningKeepSnapshots }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -186,6 +194,16 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-max-deltas specifies the number of delta snapshots, containing only the changes
+# since the previous snapshot, taken in a row before a full snapshot is taken again
+# (0 to disable). Pruning must keep at least snapshot-interval recent heights.
+snapshot-max-deltas = {{ .StateSync.SnapshotMaxDeltas }}
+
+# snapshot-compression specifies the compressor of the snapshot chunks, compressed
+# independently so that they are restored in parallel (zlib|zstd). Leave empty to keep the
+# single zlib stream, which nodes running an older version can restore.
+snapshot-compression = "{{ .StateSync.SnapshotCompression }}"
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -223,4 +241,29 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 #
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
-max-txs = {{ .Mempool.MaxTxs }}
\ No newline at end of file
+max-txs = {{ .Mempool.MaxTxs }}
+
+# The app-side mempool implementation, either "sender-nonce" or "priority-nonce".
+# The sender-nonce mempool selects txs randomly across senders, while the
+# priority-nonce mempool orders txs by priority, e.g. their gas price.
+type = "{{ .Mempool.Type }}"
+
+# The following settings only apply to the priority-nonce mempool.
+#
+# eviction-policy defines which tx is evicted to make room for a new tx when the
+# mempool holds max-txs txs:
+# - none: no tx is evicted, the new tx is rejected.
+# - lowest-priority: the tx with the lowest priority is evicted, if the new tx has a higher priority.
+# - oldest: the tx which has been in the mempool for the longest time is evicted.
+# Only the last tx of a sender is evicted, so the remaining txs of every sender stay executable.
+eviction-policy = "{{ .Mempool.EvictionPolicy }}"
+
+# max-txs-per-sender caps the number of txs a single sender may have in the mempool.
+# Setting it to 0 disables the cap.
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
+
+# min-fee-bump defines the replace-by-fee policy: a tx with the same sender and
+# nonce as a pooled tx replaces it only if its gas price is at least min-fee-bump
+# percent higher, e.g. 10 for a 10% bump. Setting it to 0 lets any tx replace
+# the pooled tx.
+min-fee-bump = {{ .Mempool.MinFeeBump }}
\ No newline at end of file
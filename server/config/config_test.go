@@ -277,6 +277,23 @@ func TestValidateBasic(t *testing.T) {
 	err = cfg.ValidateBasic()
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "cannot enable state sync snapshots with 'everything' pruning setting")
+
+	// Test case 4: Invalid mempool type
+	cfg = DefaultConfig()
+	cfg.MinGasPrices = "0.01stake"
+	cfg.Mempool.Type = "fifo"
+	err = cfg.ValidateBasic()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown mempool type")
+
+	// Test case 5: Invalid mempool eviction policy
+	cfg = DefaultConfig()
+	cfg.MinGasPrices = "0.01stake"
+	cfg.Mempool.Type = MempoolTypePriorityNonce
+	cfg.Mempool.EvictionPolicy = "newest"
+	err = cfg.ValidateBasic()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown mempool eviction policy")
 }
 
 func TestGetConfig(t *testing.T) {
@@ -18,14 +18,25 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
 
 	switch strategy {
-	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
+	case pruningtypes.PruningOptionNothing:
 		return pruningtypes.NewPruningOptionsFromString(strategy), nil
 
+	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionEverything:
+		opts := pruningtypes.NewPruningOptionsFromString(strategy)
+		setRetentionOptions(&opts, appOpts)
+
+		if err := opts.Validate(); err != nil {
+			return opts, fmt.Errorf("invalid pruning options: %w", err)
+		}
+
+		return opts, nil
+
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
 			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
 			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
 		)
+		setRetentionOptions(&opts, appOpts)
 
 		if err := opts.Validate(); err != nil {
 			return opts, fmt.Errorf("invalid custom pruning options: %w", err)
@@ -37,3 +48,10 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 		return pruningtypes.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
 }
+
+// setRetentionOptions sets the time and snapshot based retention options, which
+// apply to all the strategies pruning heights.
+func setRetentionOptions(opts *pruningtypes.PruningOptions, appOpts types.AppOptions) {
+	opts.KeepTime = cast.ToDuration(appOpts.Get(FlagPruningKeepTime))
+	opts.KeepSnapshots = cast.ToBool(appOpts.Get(FlagPruningKeepSnapshots))
+}
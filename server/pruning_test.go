@@ -2,6 +2,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -46,6 +47,46 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 			},
 			expectedOptions: pruningtypes.NewPruningOptions(pruningtypes.PruningDefault),
 		},
+		{
+			name: "retention options",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionCustom)
+				v.Set(FlagPruningKeepRecent, 100)
+				v.Set(FlagPruningInterval, 10)
+				v.Set(FlagPruningKeepTime, "720h")
+				v.Set(FlagPruningKeepSnapshots, true)
+				return v
+			},
+			expectedOptions: pruningtypes.PruningOptions{
+				KeepRecent:    100,
+				Interval:      10,
+				KeepTime:      720 * time.Hour,
+				KeepSnapshots: true,
+				Strategy:      pruningtypes.PruningCustom,
+			},
+		},
+		{
+			name: "retention options ignored with nothing",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionNothing)
+				v.Set(FlagPruningKeepTime, "720h")
+				v.Set(FlagPruningKeepSnapshots, true)
+				return v
+			},
+			expectedOptions: pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+		},
+		{
+			name: "negative keep time",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionDefault)
+				v.Set(FlagPruningKeepTime, "-1h")
+				return v
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
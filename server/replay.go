@@ -0,0 +1,182 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewReplayBlockCmd creates a command to re-execute a committed block against
+// the state committed at the previous height and compare the resulting app hash
+// and store hashes with the committed ones.
+func NewReplayBlockCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	return &cobra.Command{
+		Use:   "replay-block <height>",
+		Short: "Re-execute a committed block and diff the resulting app hash against the committed one",
+		Long: `Re-execute the block at the given height against the application state committed
+at height - 1, and compare the resulting app hash and per-store hashes with the ones
+committed at that height. Transaction results differing from the ones stored by
+CometBFT are reported as well.
+
+This is useful to diagnose app hash mismatches: replaying a block with a patched
+binary shows which stores diverge. Nothing is written to the application or
+CometBFT databases. The state at height - 1 must not have been pruned, and the
+node must not be running.
+`,
+		Example: fmt.Sprintf("%s debug replay-block 16841115", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %w", err)
+			}
+
+			return replayBlock(cmd.OutOrStdout(), GetServerContextFromCmd(cmd), appCreator, height)
+		},
+	}
+}
+
+func replayBlock[T types.Application](out io.Writer, svrCtx *Context, appCreator types.AppCreator[T], height int64) error {
+	cfg := svrCtx.Config
+
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return err
+	}
+	blockStore := store.NewBlockStore(blockStoreDB)
+	defer blockStore.Close()
+
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	defer stateStore.Close()
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load CometBFT state: %w", err)
+	}
+	if height <= state.InitialHeight {
+		return fmt.Errorf("cannot replay block %d: there is no committed state before the initial height %d", height, state.InitialHeight)
+	}
+
+	block, _ := blockStore.LoadBlock(height)
+	if block == nil {
+		return fmt.Errorf("block %d not found in the block store (base %d, height %d)", height, blockStore.Base(), blockStore.Height())
+	}
+
+	lastValSet, err := stateStore.LoadValidators(height - 1)
+	if err != nil {
+		return fmt.Errorf("failed to load the validator set at height %d: %w", height-1, err)
+	}
+
+	db, err := OpenDB(cfg.RootDir, GetAppDBBackend(svrCtx.Viper))
+	if err != nil {
+		return fmt.Errorf("error opening DB, make sure daemon is not running when calling this command: %w", err)
+	}
+	app := appCreator(svrCtx.Logger, db, nil, svrCtx.Viper)
+	defer app.Close()
+
+	rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("expected rootmulti.Store, got %T", app.CommitMultiStore())
+	}
+
+	committed, err := rms.GetCommitInfo(height)
+	if err != nil {
+		return fmt.Errorf("failed to get the commit info at height %d: %w", height, err)
+	}
+
+	// FinalizeBlock branches the latest version of the multistore, so loading
+	// the previous version is enough to replay the block on top of it. The
+	// inter-block cache would keep serving the stores of the latest version.
+	rms.SetInterBlockCache(nil)
+	if err := rms.LoadVersion(height - 1); err != nil {
+		return fmt.Errorf("failed to load the state at height %d: %w", height-1, err)
+	}
+
+	res, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  sm.BuildLastCommitInfo(block, lastValSet, state.InitialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+		SyncingToHeight:    block.Height,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replay block %d: %w", height, err)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "STORE\tCOMMITTED\tREPLAYED\t\n")
+
+	diverging := 0
+	for _, info := range committed.StoreInfos {
+		var replayed []byte
+		if s, ok := rms.GetStoreByName(info.Name).(storetypes.Committer); ok {
+			replayed = s.WorkingHash()
+		}
+
+		status := ""
+		if !bytes.Equal(info.CommitId.Hash, replayed) {
+			status = "MISMATCH"
+			diverging++
+		}
+		fmt.Fprintf(w, "%s\t%X\t%X\t%s\n", info.Name, info.CommitId.Hash, replayed, status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\ncommitted app hash: %X\nreplayed app hash:  %X\n", committed.Hash(), res.AppHash)
+
+	if stored, err := stateStore.LoadFinalizeBlockResponse(height); err == nil {
+		reportTxResultDiffs(out, stored.TxResults, res.TxResults)
+	}
+
+	if diverging > 0 || !bytes.Equal(committed.Hash(), res.AppHash) {
+		return fmt.Errorf("replayed app hash does not match the committed one: %d store(s) diverge", diverging)
+	}
+
+	fmt.Fprintln(out, "replayed app hash matches the committed one")
+	return nil
+}
+
+// reportTxResultDiffs prints the transactions whose replayed result code or
+// gas usage differ from the stored ones.
+func reportTxResultDiffs(out io.Writer, stored, replayed []*abci.ExecTxResult) {
+	if len(stored) != len(replayed) {
+		fmt.Fprintf(out, "\nstored and replayed tx results count differ: %d != %d\n", len(stored), len(replayed))
+		return
+	}
+
+	for i := range stored {
+		if stored[i].Code != replayed[i].Code || stored[i].GasUsed != replayed[i].GasUsed {
+			fmt.Fprintf(
+				out,
+				"tx %d: stored code=%d gas_used=%d, replayed code=%d gas_used=%d log=%q\n",
+				i, stored[i].Code, stored[i].GasUsed, replayed[i].Code, replayed[i].GasUsed, replayed[i].Log,
+			)
+		}
+	}
+}
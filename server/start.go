@@ -72,19 +72,23 @@ const (
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
 
-	FlagPruning             = "pruning"
-	FlagPruningKeepRecent   = "pruning-keep-recent"
-	FlagPruningInterval     = "pruning-interval"
-	FlagIndexEvents         = "index-events"
-	FlagMinRetainBlocks     = "min-retain-blocks"
-	FlagIAVLCacheSize       = "iavl-cache-size"
-	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
-	FlagShutdownGrace       = "shutdown-grace"
+	FlagPruning              = "pruning"
+	FlagPruningKeepRecent    = "pruning-keep-recent"
+	FlagPruningInterval      = "pruning-interval"
+	FlagPruningKeepTime      = "pruning-keep-time"
+	FlagPruningKeepSnapshots = "pruning-keep-snapshots"
+	FlagIndexEvents          = "index-events"
+	FlagMinRetainBlocks      = "min-retain-blocks"
+	FlagIAVLCacheSize        = "iavl-cache-size"
+	FlagDisableIAVLFastNode  = "iavl-disable-fastnode"
+	FlagShutdownGrace        = "shutdown-grace"
 
 	// state sync-related flags
 
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval    = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent  = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotMaxDeltas   = "state-sync.snapshot-max-deltas"
+	FlagStateSyncSnapshotCompression = "state-sync.snapshot-compression"
 
 	// api-related flags
 
@@ -105,7 +109,11 @@ const (
 
 	// mempool flags
 
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs          = "mempool.max-txs"
+	FlagMempoolType            = "mempool.type"
+	FlagMempoolEvictionPolicy  = "mempool.eviction-policy"
+	FlagMempoolMaxTxsPerSender = "mempool.max-txs-per-sender"
+	FlagMempoolMinFeeBump      = "mempool.min-fee-bump"
 
 	// testnet keys
 
@@ -166,6 +174,10 @@ nothing: all historic states will be saved, nothing will be deleted (i.e. archiv
 everything: 2 latest states will be kept; pruning at 10 block intervals.
 custom: allow pruning options to be manually specified through 'pruning-keep-recent', and 'pruning-interval'
 
+On top of the recent heights, the heights whose block time is within '--pruning-keep-time' of the latest block
+time can be kept, and '--pruning-keep-snapshots' keeps the heights of the retained state sync snapshots. These
+are ignored with the 'nothing' strategy.
+
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
 the halt-height or if the current block time is greater than or equal to the halt-time. If so, the
@@ -1014,6 +1026,8 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Duration(FlagPruningKeepTime, 0, "Duration, based on the block times, for which the heights are kept on disk (e.g. 720h, 0 to disable)")
+	cmd.Flags().Bool(FlagPruningKeepSnapshots, false, "Keep on disk the heights of the retained state sync snapshots")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
@@ -1029,8 +1043,14 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().String(flagGRPCAddress, serverconfig.DefaultGRPCAddress, "the gRPC server address to listen on")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotMaxDeltas, 0, "State sync delta snapshots to take in a row before a full snapshot (0 to disable)")
+	cmd.Flags().String(FlagStateSyncSnapshotCompression, "", "State sync snapshot chunks compressor (zlib|zstd), empty for the legacy zlib stream")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().String(FlagMempoolType, serverconfig.MempoolTypeSenderNonce, "Sets the app-side mempool implementation (sender-nonce|priority-nonce)")
+	cmd.Flags().String(FlagMempoolEvictionPolicy, mempool.EvictionPolicyNone.String(), "Sets the eviction policy of the priority-nonce mempool (none|lowest-priority|oldest)")
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Sets the maximum number of txs per sender in the priority-nonce mempool (0 means no cap)")
+	cmd.Flags().Uint64(FlagMempoolMinFeeBump, 0, "Sets the minimum gas price increase, in percent, required to replace a tx in the priority-nonce mempool")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
 	// support old flags name for backwards compatibility
@@ -0,0 +1,373 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagStateHeight     = "height"
+	flagStatePrefix     = "prefix"
+	flagStateCollection = "collection"
+	flagStateLimit      = "limit"
+	flagStateRaw        = "raw"
+)
+
+// hasModuleCodecs is implemented by the applications registering the codecs
+// used to decode the state of the module stores, see BaseApp.SetModuleCodecs.
+type hasModuleCodecs interface {
+	ModuleCodecs() map[string]schema.ModuleCodec
+}
+
+// NewStateCmd creates a command to inspect the state of the module stores of a
+// stopped node, decoding the keys and values with the collections schema of the
+// modules.
+func NewStateCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Inspect the state of the module stores of a stopped node",
+		Long: `Inspect the application state committed at a height, reading the module stores
+directly from the application database. The keys and values of the stores whose
+module registered its collections schema are decoded to JSON.
+
+The node must not be running.
+`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		newStateStoresCmd(appCreator),
+		newStateDumpCmd(appCreator),
+	)
+
+	return cmd
+}
+
+func newStateStoresCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stores",
+		Short:   "List the module stores committed at a height with their hash and collections",
+		Example: fmt.Sprintf("%s debug state stores --height 16841115", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			height, err := cmd.Flags().GetInt64(flagStateHeight)
+			if err != nil {
+				return err
+			}
+
+			state, err := openAppState(GetServerContextFromCmd(cmd), appCreator)
+			if err != nil {
+				return err
+			}
+			defer state.Close()
+
+			if height, err = state.resolveHeight(height); err != nil {
+				return err
+			}
+
+			return state.printStores(cmd.OutOrStdout(), height)
+		},
+	}
+
+	cmd.Flags().Int64(flagStateHeight, 0, "Height of the state to inspect (0 for the latest height)")
+
+	return cmd
+}
+
+func newStateDumpCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <store>",
+		Short: "Print the decoded entries of a module store as JSON lines",
+		Long: `Print the entries of a module store committed at a height, one JSON object per
+line. The entries of the stores whose module registered its collections schema
+are decoded, with the collection they belong to and their decoded key and value.
+The raw value is only printed for the entries which could not be decoded, unless
+--raw is set.`,
+		Example: fmt.Sprintf(`%s debug state dump bank --collection balances
+%s debug state dump staking --prefix 21 --limit 10 --height 16841115`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetInt64(flagStateHeight)
+			if err != nil {
+				return err
+			}
+
+			prefixStr, err := cmd.Flags().GetString(flagStatePrefix)
+			if err != nil {
+				return err
+			}
+			prefix, err := hex.DecodeString(prefixStr)
+			if err != nil {
+				return fmt.Errorf("invalid hex prefix: %w", err)
+			}
+
+			opts := stateDumpOptions{prefix: prefix}
+			if opts.collection, err = cmd.Flags().GetString(flagStateCollection); err != nil {
+				return err
+			}
+			if opts.limit, err = cmd.Flags().GetUint64(flagStateLimit); err != nil {
+				return err
+			}
+			if opts.raw, err = cmd.Flags().GetBool(flagStateRaw); err != nil {
+				return err
+			}
+
+			state, err := openAppState(GetServerContextFromCmd(cmd), appCreator)
+			if err != nil {
+				return err
+			}
+			defer state.Close()
+
+			if height, err = state.resolveHeight(height); err != nil {
+				return err
+			}
+
+			return state.dump(cmd.OutOrStdout(), args[0], height, opts)
+		},
+	}
+
+	cmd.Flags().Int64(flagStateHeight, 0, "Height of the state to inspect (0 for the latest height)")
+	cmd.Flags().String(flagStatePrefix, "", "Only print the entries whose raw key starts with the hex encoded prefix")
+	cmd.Flags().String(flagStateCollection, "", "Only print the entries of the collection with the given name")
+	cmd.Flags().Uint64(flagStateLimit, 0, "Maximum number of entries to print (0 for no limit)")
+	cmd.Flags().Bool(flagStateRaw, false, "Print the raw value of the decoded entries as well")
+
+	return cmd
+}
+
+// appState is the application state of a stopped node, opened offline.
+type appState struct {
+	app    types.Application
+	rms    *rootmulti.Store
+	codecs map[string]schema.ModuleCodec
+}
+
+// openAppState opens the application database of a stopped node.
+func openAppState[T types.Application](svrCtx *Context, appCreator types.AppCreator[T]) (*appState, error) {
+	db, err := OpenDB(svrCtx.Config.RootDir, GetAppDBBackend(svrCtx.Viper))
+	if err != nil {
+		return nil, fmt.Errorf("error opening DB, make sure daemon is not running when calling this command: %w", err)
+	}
+	app := appCreator(svrCtx.Logger, db, nil, svrCtx.Viper)
+
+	rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return nil, errors.Join(fmt.Errorf("expected rootmulti.Store, got %T", app.CommitMultiStore()), app.Close())
+	}
+
+	state := &appState{
+		app: app,
+		rms: rms,
+	}
+	if hc, ok := any(app).(hasModuleCodecs); ok {
+		state.codecs = hc.ModuleCodecs()
+	}
+
+	return state, nil
+}
+
+// Close closes the application and its database.
+func (s *appState) Close() error {
+	return s.app.Close()
+}
+
+// resolveHeight returns the given height, or the latest height if it is 0,
+// checking that it was committed.
+func (s *appState) resolveHeight(height int64) (int64, error) {
+	latest := s.rms.LatestVersion()
+	if height == 0 {
+		height = latest
+	}
+	if height <= 0 || height > latest {
+		return 0, fmt.Errorf("invalid height %d: the latest height is %d", height, latest)
+	}
+
+	return height, nil
+}
+
+// iavlStore returns the immutable IAVL store with the given name at the given
+// height, which fails if the height was pruned.
+func (s *appState) iavlStore(name string, height int64) (*iavl.Store, error) {
+	key, ok := s.rms.StoreKeysByName()[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown store %q", name)
+	}
+
+	// the store is unwrapped from the inter-block cache if any
+	store, ok := s.rms.GetCommitKVStore(key).(*iavl.Store)
+	if !ok {
+		return nil, fmt.Errorf("store %q is not an IAVL store", name)
+	}
+
+	immutable, err := store.GetImmutable(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load store %q at height %d: %w", name, height, err)
+	}
+
+	return immutable, nil
+}
+
+// printStores prints the stores committed at the given height, with their hash
+// and the collections of their schema if any.
+func (s *appState) printStores(out io.Writer, height int64) error {
+	commitInfo, err := s.rms.GetCommitInfo(height)
+	if err != nil {
+		return fmt.Errorf("failed to get the commit info at height %d: %w", height, err)
+	}
+
+	storeInfos := slices.Clone(commitInfo.StoreInfos)
+	slices.SortFunc(storeInfos, func(a, b storetypes.StoreInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "STORE\tHASH\tCOLLECTIONS\n")
+	for _, info := range storeInfos {
+		collections := "-"
+		if cdc, ok := s.codecs[info.Name]; ok {
+			var names []string
+			cdc.Schema.StateObjectTypes(func(t schema.StateObjectType) bool {
+				names = append(names, t.Name)
+				return true
+			})
+			if len(names) > 0 {
+				collections = strings.Join(names, ",")
+			}
+		}
+		fmt.Fprintf(w, "%s\t%X\t%s\n", info.Name, info.CommitId.Hash, collections)
+	}
+
+	return w.Flush()
+}
+
+// stateDumpOptions filter the entries printed by dump.
+type stateDumpOptions struct {
+	prefix     []byte
+	collection string
+	limit      uint64
+	raw        bool
+}
+
+// dump prints the entries of a store at the given height as JSON lines.
+func (s *appState) dump(out io.Writer, storeName string, height int64, opts stateDumpOptions) error {
+	store, err := s.iavlStore(storeName, height)
+	if err != nil {
+		return err
+	}
+	if len(opts.prefix) == 0 {
+		opts.prefix = nil
+	}
+
+	cdc, hasCodec := s.codecs[storeName]
+	if opts.collection != "" && !hasCodec {
+		return fmt.Errorf("store %q has no registered collections schema", storeName)
+	}
+
+	it := storetypes.KVStorePrefixIterator(store, opts.prefix)
+	defer it.Close()
+
+	enc := json.NewEncoder(out)
+	var count uint64
+	for ; it.Valid() && (opts.limit == 0 || count < opts.limit); it.Next() {
+		entry := decodeStateEntry(cdc, it.Key(), it.Value(), opts.raw)
+		if opts.collection != "" && entry.Collection != opts.collection {
+			continue
+		}
+
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+		count++
+	}
+
+	return it.Error()
+}
+
+// stateEntry is a store entry, decoded with the module codec of the store if any.
+type stateEntry struct {
+	Key          string `json:"key"`
+	Value        string `json:"value,omitempty"`
+	Collection   string `json:"collection,omitempty"`
+	DecodedKey   any    `json:"decoded_key,omitempty"`
+	DecodedValue any    `json:"decoded_value,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// decodeStateEntry decodes a store entry with the given module codec. The raw
+// value is kept if the entry can't be fully decoded, or if raw is true.
+func decodeStateEntry(cdc schema.ModuleCodec, key, value []byte, raw bool) stateEntry {
+	entry := stateEntry{Key: strings.ToUpper(hex.EncodeToString(key))}
+
+	decoded := false
+	if cdc.KVDecoder != nil {
+		// a decoding error still returns the parts of the update it could decode
+		updates, err := cdc.KVDecoder(schema.KVPairUpdate{Key: key, Value: value})
+		if len(updates) > 0 {
+			entry.Collection = updates[0].TypeName
+			entry.DecodedKey = updates[0].Key
+			entry.DecodedValue = updates[0].Value
+			if typ, ok := cdc.Schema.LookupStateObjectType(entry.Collection); ok {
+				entry.DecodedKey = formatSchemaFields(typ.KeyFields, entry.DecodedKey)
+				entry.DecodedValue = formatSchemaFields(typ.ValueFields, entry.DecodedValue)
+			}
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		decoded = err == nil && len(updates) > 0
+	}
+
+	if raw || !decoded {
+		entry.Value = strings.ToUpper(hex.EncodeToString(value))
+	}
+
+	return entry
+}
+
+// formatSchemaFields returns the schema value of the given fields with the
+// addresses and bytes hex encoded, like the raw keys and values, instead of
+// being base64 encoded in JSON.
+func formatSchemaFields(fields []schema.Field, value any) any {
+	switch {
+	case len(fields) == 1:
+		return formatSchemaField(fields[0], value)
+	case len(fields) > 1:
+		values, ok := value.([]interface{})
+		if !ok || len(values) != len(fields) {
+			return value
+		}
+
+		formatted := make([]interface{}, len(values))
+		for i, v := range values {
+			formatted[i] = formatSchemaField(fields[i], v)
+		}
+		return formatted
+	default:
+		return value
+	}
+}
+
+func formatSchemaField(field schema.Field, value any) any {
+	if bz, ok := value.([]byte); ok && (field.Kind == schema.AddressKind || field.Kind == schema.BytesKind) {
+		return strings.ToUpper(hex.EncodeToString(bz))
+	}
+
+	return value
+}
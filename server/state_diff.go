@@ -0,0 +1,286 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/schema"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagStateDiffFrom  = "from"
+	flagStateDiffTo    = "to"
+	flagStateDiffStore = "store"
+)
+
+// State diff operations.
+const (
+	stateDiffAdded   = "added"
+	stateDiffRemoved = "removed"
+	stateDiffChanged = "changed"
+)
+
+// NewStateDiffCmd creates a command to diff the state of a module store
+// between two heights of a stopped node.
+func NewStateDiffCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff",
+		Short: "Print the keys of a module store added, removed or changed between two heights",
+		Long: `Iterate the IAVL trees of a module store committed at two heights and print the
+keys added, removed or changed between them, one JSON object per line. The keys and
+values of the stores whose module registered its collections schema are decoded.
+
+The root hashes of both trees are verified against the store hashes committed at
+their heights, so that the diff covers the committed state. A summary with the
+number of keys added, removed and changed is printed to stderr. This is useful to
+verify that an upgrade migration only touched the intended keys.
+
+Both heights must not have been pruned, and the node must not be running.
+`,
+		Example: fmt.Sprintf(`%s debug state-diff --from 16841114 --to 16841115 --store bank
+%s debug state-diff --from 16841114 --store bank --collection balances`, version.AppName, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			from, err := cmd.Flags().GetInt64(flagStateDiffFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagStateDiffTo)
+			if err != nil {
+				return err
+			}
+			storeName, err := cmd.Flags().GetString(flagStateDiffStore)
+			if err != nil {
+				return err
+			}
+
+			prefixStr, err := cmd.Flags().GetString(flagStatePrefix)
+			if err != nil {
+				return err
+			}
+			prefix, err := hex.DecodeString(prefixStr)
+			if err != nil {
+				return fmt.Errorf("invalid hex prefix: %w", err)
+			}
+
+			opts := stateDumpOptions{prefix: prefix}
+			if opts.collection, err = cmd.Flags().GetString(flagStateCollection); err != nil {
+				return err
+			}
+			if opts.raw, err = cmd.Flags().GetBool(flagStateRaw); err != nil {
+				return err
+			}
+
+			state, err := openAppState(GetServerContextFromCmd(cmd), appCreator)
+			if err != nil {
+				return err
+			}
+			defer state.Close()
+
+			if from, err = state.resolveHeight(from); err != nil {
+				return err
+			}
+			if to, err = state.resolveHeight(to); err != nil {
+				return err
+			}
+			if from >= to {
+				return fmt.Errorf("--%s height %d must be lower than --%s height %d", flagStateDiffFrom, from, flagStateDiffTo, to)
+			}
+
+			return state.diff(cmd.OutOrStdout(), cmd.ErrOrStderr(), storeName, from, to, opts)
+		},
+	}
+
+	cmd.Flags().Int64(flagStateDiffFrom, 0, "Height of the state to diff from")
+	cmd.Flags().Int64(flagStateDiffTo, 0, "Height of the state to diff to (0 for the latest height)")
+	cmd.Flags().String(flagStateDiffStore, "", "Name of the module store to diff")
+	cmd.Flags().String(flagStatePrefix, "", "Only diff the entries whose raw key starts with the hex encoded prefix")
+	cmd.Flags().String(flagStateCollection, "", "Only diff the entries of the collection with the given name")
+	cmd.Flags().Bool(flagStateRaw, false, "Print the raw values of the decoded entries as well")
+	_ = cmd.MarkFlagRequired(flagStateDiffFrom)
+	_ = cmd.MarkFlagRequired(flagStateDiffStore)
+
+	return cmd
+}
+
+// diff prints the differences of a store between two heights as JSON lines to
+// out, and their summary to summaryOut.
+func (s *appState) diff(out, summaryOut io.Writer, storeName string, from, to int64, opts stateDumpOptions) error {
+	cdc, hasCodec := s.codecs[storeName]
+	if opts.collection != "" && !hasCodec {
+		return fmt.Errorf("store %q has no registered collections schema", storeName)
+	}
+
+	fromStore, fromHash, err := s.verifiedIAVLStore(storeName, from)
+	if err != nil {
+		return err
+	}
+	toStore, toHash, err := s.verifiedIAVLStore(storeName, to)
+	if err != nil {
+		return err
+	}
+
+	// identical root hashes commit to identical trees
+	var summary stateDiffSummary
+	if !bytes.Equal(fromHash, toHash) {
+		enc := json.NewEncoder(out)
+		summary, err = diffStores(fromStore, toStore, cdc, opts, func(d stateDiff) error {
+			return enc.Encode(d)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(summaryOut, "%s: %d added, %d removed, %d changed from height %d (%X) to height %d (%X)\n",
+		storeName, summary.Added, summary.Removed, summary.Changed, from, fromHash, to, toHash)
+	return err
+}
+
+// verifiedIAVLStore returns the immutable IAVL store with the given name at the
+// given height, after checking that its root hash is the committed one.
+func (s *appState) verifiedIAVLStore(name string, height int64) (storetypes.KVStore, []byte, error) {
+	store, err := s.iavlStore(name, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commitInfo, err := s.rms.GetCommitInfo(height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the commit info at height %d: %w", height, err)
+	}
+
+	hash := store.LastCommitID().Hash
+	for _, info := range commitInfo.StoreInfos {
+		if info.Name != name {
+			continue
+		}
+		if !bytes.Equal(info.CommitId.Hash, hash) {
+			return nil, nil, fmt.Errorf("store %q root hash %X at height %d does not match the committed hash %X", name, hash, height, info.CommitId.Hash)
+		}
+		return store, hash, nil
+	}
+
+	return nil, nil, fmt.Errorf("store %q was not committed at height %d", name, height)
+}
+
+// stateDiff is a key added, removed or changed between two versions of a store.
+type stateDiff struct {
+	Op         string          `json:"op"`
+	Key        string          `json:"key"`
+	Collection string          `json:"collection,omitempty"`
+	DecodedKey any             `json:"decoded_key,omitempty"`
+	From       *stateDiffValue `json:"from,omitempty"`
+	To         *stateDiffValue `json:"to,omitempty"`
+}
+
+// stateDiffValue is the value of a key in one of the versions of a store.
+type stateDiffValue struct {
+	Value        string `json:"value,omitempty"`
+	DecodedValue any    `json:"decoded_value,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// stateDiffSummary counts the keys added, removed and changed between two
+// versions of a store.
+type stateDiffSummary struct {
+	Added, Removed, Changed uint64
+}
+
+// diffStores iterates both stores in key order and calls fn for each key added,
+// removed or changed from the first store to the second one.
+func diffStores(from, to storetypes.KVStore, cdc schema.ModuleCodec, opts stateDumpOptions, fn func(stateDiff) error) (stateDiffSummary, error) {
+	var summary stateDiffSummary
+	if len(opts.prefix) == 0 {
+		opts.prefix = nil
+	}
+
+	fromIt := storetypes.KVStorePrefixIterator(from, opts.prefix)
+	defer fromIt.Close()
+	toIt := storetypes.KVStorePrefixIterator(to, opts.prefix)
+	defer toIt.Close()
+
+	for fromIt.Valid() || toIt.Valid() {
+		var cmp int
+		switch {
+		case !fromIt.Valid():
+			cmp = 1
+		case !toIt.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(fromIt.Key(), toIt.Key())
+		}
+
+		var d stateDiff
+		switch {
+		case cmp < 0:
+			d = newStateDiff(cdc, stateDiffRemoved, fromIt.Key(), fromIt.Value(), nil, opts.raw)
+			fromIt.Next()
+		case cmp > 0:
+			d = newStateDiff(cdc, stateDiffAdded, toIt.Key(), nil, toIt.Value(), opts.raw)
+			toIt.Next()
+		default:
+			fromValue, toValue := fromIt.Value(), toIt.Value()
+			if !bytes.Equal(fromValue, toValue) {
+				d = newStateDiff(cdc, stateDiffChanged, fromIt.Key(), fromValue, toValue, opts.raw)
+			}
+			fromIt.Next()
+			toIt.Next()
+		}
+
+		if d.Op == "" || (opts.collection != "" && d.Collection != opts.collection) {
+			continue
+		}
+
+		switch d.Op {
+		case stateDiffAdded:
+			summary.Added++
+		case stateDiffRemoved:
+			summary.Removed++
+		case stateDiffChanged:
+			summary.Changed++
+		}
+		if err := fn(d); err != nil {
+			return summary, err
+		}
+	}
+
+	if err := fromIt.Error(); err != nil {
+		return summary, err
+	}
+	return summary, toIt.Error()
+}
+
+// newStateDiff returns the diff of a key, decoded with the module codec of the
+// store if any. Only the to value is set for added keys, and only the from value
+// for removed keys.
+func newStateDiff(cdc schema.ModuleCodec, op string, key, fromValue, toValue []byte, raw bool) stateDiff {
+	d := stateDiff{Op: op}
+	decode := func(value []byte) *stateDiffValue {
+		entry := decodeStateEntry(cdc, key, value, raw)
+		d.Key, d.Collection, d.DecodedKey = entry.Key, entry.Collection, entry.DecodedKey
+		return &stateDiffValue{
+			Value:        entry.Value,
+			DecodedValue: entry.DecodedValue,
+			Error:        entry.Error,
+		}
+	}
+
+	if op != stateDiffAdded {
+		d.From = decode(fromValue)
+	}
+	if op != stateDiffRemoved {
+		d.To = decode(toValue)
+	}
+
+	return d
+}
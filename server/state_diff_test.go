@@ -0,0 +1,92 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/dbadapter"
+)
+
+func TestDiffStores(t *testing.T) {
+	ctx := coretesting.Context()
+	sb := collections.NewSchemaBuilder(coretesting.KVStoreService(ctx, "test"))
+	_ = collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	_ = collections.NewMap(sb, collections.NewPrefix(2), "supply", collections.StringKey, collections.Uint64Value)
+	s, err := sb.Build()
+	require.NoError(t, err)
+	cdc, err := s.ModuleCodec(collections.IndexingOptions{})
+	require.NoError(t, err)
+
+	set := func(store *dbadapter.Store, prefix collections.Prefix, key string, value uint64) {
+		t.Helper()
+		k, err := collections.EncodeKeyWithPrefix(prefix, collections.StringKey, key)
+		require.NoError(t, err)
+		v, err := collections.Uint64Value.Encode(value)
+		require.NoError(t, err)
+		store.Set(k, v)
+	}
+
+	from := &dbadapter.Store{DB: coretesting.NewMemDB()}
+	set(from, collections.NewPrefix(1), "alice", 10)
+	set(from, collections.NewPrefix(1), "bob", 20)
+	set(from, collections.NewPrefix(1), "carol", 30)
+	set(from, collections.NewPrefix(2), "stake", 60)
+
+	to := &dbadapter.Store{DB: coretesting.NewMemDB()}
+	set(to, collections.NewPrefix(1), "alice", 10)
+	set(to, collections.NewPrefix(1), "carol", 35)
+	set(to, collections.NewPrefix(1), "dave", 20)
+	set(to, collections.NewPrefix(2), "stake", 65)
+
+	var diffs []stateDiff
+	collect := func(d stateDiff) error {
+		diffs = append(diffs, d)
+		return nil
+	}
+
+	summary, err := diffStores(from, to, cdc, stateDumpOptions{}, collect)
+	require.NoError(t, err)
+	require.Equal(t, stateDiffSummary{Added: 1, Removed: 1, Changed: 2}, summary)
+	require.Len(t, diffs, 4)
+
+	require.Equal(t, stateDiffRemoved, diffs[0].Op)
+	require.Equal(t, "bob", diffs[0].DecodedKey)
+	require.Equal(t, uint64(20), diffs[0].From.DecodedValue)
+	require.Nil(t, diffs[0].To)
+
+	require.Equal(t, stateDiffChanged, diffs[1].Op)
+	require.Equal(t, "balances", diffs[1].Collection)
+	require.Equal(t, "carol", diffs[1].DecodedKey)
+	require.Equal(t, uint64(30), diffs[1].From.DecodedValue)
+	require.Equal(t, uint64(35), diffs[1].To.DecodedValue)
+
+	require.Equal(t, stateDiffAdded, diffs[2].Op)
+	require.Equal(t, "dave", diffs[2].DecodedKey)
+	require.Nil(t, diffs[2].From)
+	require.Equal(t, uint64(20), diffs[2].To.DecodedValue)
+
+	require.Equal(t, stateDiffChanged, diffs[3].Op)
+	require.Equal(t, "supply", diffs[3].Collection)
+
+	// the diffs can be filtered by collection or raw key prefix
+	diffs = nil
+	summary, err = diffStores(from, to, cdc, stateDumpOptions{collection: "supply"}, collect)
+	require.NoError(t, err)
+	require.Equal(t, stateDiffSummary{Changed: 1}, summary)
+	require.Len(t, diffs, 1)
+
+	diffs = nil
+	summary, err = diffStores(from, to, cdc, stateDumpOptions{prefix: []byte{1}}, collect)
+	require.NoError(t, err)
+	require.Equal(t, stateDiffSummary{Added: 1, Removed: 1, Changed: 1}, summary)
+
+	// identical stores have no diff
+	diffs = nil
+	summary, err = diffStores(from, from, cdc, stateDumpOptions{}, collect)
+	require.NoError(t, err)
+	require.Equal(t, stateDiffSummary{}, summary)
+	require.Empty(t, diffs)
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/schema"
+)
+
+func TestDecodeStateEntry(t *testing.T) {
+	ctx := coretesting.Context()
+	sb := collections.NewSchemaBuilder(coretesting.KVStoreService(ctx, "test"))
+	_ = collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	s, err := sb.Build()
+	require.NoError(t, err)
+	cdc, err := s.ModuleCodec(collections.IndexingOptions{})
+	require.NoError(t, err)
+
+	key, err := collections.EncodeKeyWithPrefix(collections.NewPrefix(1), collections.StringKey, "alice")
+	require.NoError(t, err)
+	value, err := collections.Uint64Value.Encode(100)
+	require.NoError(t, err)
+
+	entry := decodeStateEntry(cdc, key, value, false)
+	require.Equal(t, "balances", entry.Collection)
+	require.Equal(t, "alice", entry.DecodedKey)
+	require.Equal(t, uint64(100), entry.DecodedValue)
+	require.Empty(t, entry.Value)
+	require.Empty(t, entry.Error)
+
+	// the raw value is kept when requested
+	entry = decodeStateEntry(cdc, key, value, true)
+	require.Equal(t, "0000000000000064", entry.Value)
+
+	// the entries of unknown collections are not decoded
+	entry = decodeStateEntry(cdc, []byte{2, 3}, []byte{4}, false)
+	require.Equal(t, stateEntry{Key: "0203", Value: "04"}, entry)
+
+	// the stores without a codec are not decoded
+	entry = decodeStateEntry(schema.ModuleCodec{}, key, value, false)
+	require.Empty(t, entry.Collection)
+	require.Equal(t, "0000000000000064", entry.Value)
+
+	// an invalid value is reported with the decoded key
+	entry = decodeStateEntry(cdc, key, []byte{1}, false)
+	require.Equal(t, "balances", entry.Collection)
+	require.Equal(t, "alice", entry.DecodedKey)
+	require.NotEmpty(t, entry.Error)
+	require.Equal(t, "01", entry.Value)
+
+	// the addresses and bytes are hex encoded
+	sb = collections.NewSchemaBuilder(coretesting.KVStoreService(ctx, "test"))
+	_ = collections.NewMap(sb, collections.NewPrefix(1), "pairs", collections.PairKeyCodec(collections.BytesKey, collections.StringKey), collections.BytesValue)
+	s, err = sb.Build()
+	require.NoError(t, err)
+	bytesCdc, err := s.ModuleCodec(collections.IndexingOptions{})
+	require.NoError(t, err)
+
+	key, err = collections.EncodeKeyWithPrefix(collections.NewPrefix(1), collections.PairKeyCodec(collections.BytesKey, collections.StringKey), collections.Join([]byte{0xab}, "b"))
+	require.NoError(t, err)
+	entry = decodeStateEntry(bytesCdc, key, []byte{0xcd}, false)
+	require.Equal(t, []interface{}{"AB", "b"}, entry.DecodedKey)
+	require.Equal(t, "CD", entry.DecodedValue)
+}
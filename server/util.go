@@ -528,14 +528,17 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.MaxDeltas = cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotMaxDeltas))
+	snapshotOptions.Compression = cast.ToString(appOpts.Get(FlagStateSyncSnapshotCompression))
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
-		defaultMempool = baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
-				mempool.SenderNonceMaxTxOpt(maxTxs),
-			),
-		)
+		mp, err := GetMempool(appOpts, maxTxs)
+		if err != nil {
+			panic(err)
+		}
+
+		defaultMempool = baseapp.SetMempool(mp)
 	}
 
 	return []func(*baseapp.BaseApp){
@@ -556,6 +559,34 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	}
 }
 
+// GetMempool returns the app-side mempool configured in the [mempool] section of
+// app.toml, holding at most maxTxs transactions.
+func GetMempool(appOpts types.AppOptions, maxTxs int) (mempool.Mempool, error) {
+	switch mempoolType := cast.ToString(appOpts.Get(FlagMempoolType)); mempoolType {
+	case "", config.MempoolTypeSenderNonce:
+		return mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(maxTxs)), nil
+
+	case config.MempoolTypePriorityNonce:
+		evictionPolicy, err := mempool.ParseEvictionPolicy(cast.ToString(appOpts.Get(FlagMempoolEvictionPolicy)))
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := mempool.DefaultPriorityNonceMempoolConfig()
+		cfg.MaxTx = maxTxs
+		cfg.EvictionPolicy = evictionPolicy
+		cfg.MaxTxPerSender = cast.ToInt(appOpts.Get(FlagMempoolMaxTxsPerSender))
+		if minFeeBump := cast.ToUint64(appOpts.Get(FlagMempoolMinFeeBump)); minFeeBump > 0 {
+			cfg.TxReplacement = mempool.NewFeeBumpReplacement[int64](minFeeBump)
+		}
+
+		return mempool.NewPriorityMempool(cfg), nil
+
+	default:
+		return nil, fmt.Errorf("unknown mempool type %q", mempoolType)
+	}
+}
+
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
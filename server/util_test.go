@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
@@ -502,3 +503,27 @@ func (m mapGetter) GetString(key string) string {
 }
 
 var _ servertypes.AppOptions = mapGetter{}
+
+func TestGetMempool(t *testing.T) {
+	v := viper.New()
+
+	mp, err := server.GetMempool(v, 10)
+	require.NoError(t, err)
+	require.IsType(t, &mempool.SenderNonceMempool{}, mp)
+
+	v.Set(server.FlagMempoolType, config.MempoolTypePriorityNonce)
+	v.Set(server.FlagMempoolEvictionPolicy, "lowest-priority")
+	v.Set(server.FlagMempoolMaxTxsPerSender, 2)
+	v.Set(server.FlagMempoolMinFeeBump, 10)
+	mp, err = server.GetMempool(v, 10)
+	require.NoError(t, err)
+	require.IsType(t, &mempool.PriorityNonceMempool[int64]{}, mp)
+
+	v.Set(server.FlagMempoolEvictionPolicy, "newest")
+	_, err = server.GetMempool(v, 10)
+	require.Error(t, err)
+
+	v.Set(server.FlagMempoolType, "fifo")
+	_, err = server.GetMempool(v, 10)
+	require.Error(t, err)
+}
@@ -25,6 +25,8 @@ import (
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
 	"cosmossdk.io/server/v2/api/grpc/gogoreflection"
+	"cosmossdk.io/server/v2/store"
+	storetypes "cosmossdk.io/server/v2/store/types"
 )
 
 const (
@@ -68,6 +70,11 @@ func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.L
 		),
 	)
 
+	// the store query service is served along the app query handlers when supported by the store
+	if prover, ok := appI.GetStore().(store.ProverStore); ok {
+		storetypes.RegisterQueryServer(grpcSrv, store.NewQueryServer(prover))
+	}
+
 	// Reflection allows external clients to see what services and methods the gRPC server exposes.
 	gogoreflection.Register(grpcSrv, slices.Collect(maps.Keys(methodsMap)), logger.With("sub-module", "grpc-reflection"))
 
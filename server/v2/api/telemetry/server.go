@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/stf"
 )
 
 var (
@@ -54,6 +55,7 @@ func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.L
 	}
 	s.config = serverCfg
 	s.logger = logger.With(log.ModuleKey, s.Name())
+	stf.SetTelemetryEnabled(s.config.Enable)
 
 	metrics, err := NewMetrics(s.config)
 	if err != nil {
@@ -6,10 +6,13 @@ require (
 	cosmossdk.io/core v1.0.0-alpha.4
 	cosmossdk.io/schema v0.3.0
 	github.com/cosmos/gogoproto v1.7.0
+	github.com/hashicorp/go-metrics v0.5.3
 	github.com/tidwall/btree v1.7.0
 )
 
 require (
 	github.com/google/go-cmp v0.6.0 // indirect
+	github.com/hashicorp/go-immutable-radix v1.0.0 // indirect
+	github.com/hashicorp/golang-lru v0.5.0 // indirect
 	google.golang.org/protobuf v1.35.1 // indirect
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	corecontext "cosmossdk.io/core/context"
@@ -346,7 +347,11 @@ func (s STF[T]) runTxMsgs(
 	for i, msg := range msgs {
 		execCtx.sender = txSenders[i]
 		execCtx.events = make([]event.Event, 0) // reset events
+		start, gasStart := time.Now(), execCtx.meter.Consumed()
 		resp, err := s.msgRouter.Invoke(execCtx, msg)
+		if execMode == transaction.ExecModeFinalize && IsTelemetryEnabled() {
+			emitMsgTelemetry("/"+msgTypeURL(msg), time.Since(start), execCtx.meter.Consumed()-gasStart, err)
+		}
 		if err != nil {
 			return nil, 0, nil, err // do not wrap the error or we lose the original error type
 		}
@@ -0,0 +1,63 @@
+package stf
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+)
+
+// Metric label names, the same as the ones of the telemetry package of the
+// SDK so that messages executed by the STF and by baseapp report the same
+// metrics.
+const (
+	MetricLabelNameTypeURL   = "type_url"
+	MetricLabelNameCodespace = "codespace"
+)
+
+// undefinedCodespace is the codespace of errors not registered in any codespace.
+const undefinedCodespace = "undefined"
+
+// telemetryEnabled stores whether the STF emits telemetry.
+var telemetryEnabled atomic.Bool
+
+// SetTelemetryEnabled enables or disables the telemetry emitted by the STF. It
+// is disabled by default, and set by the telemetry server from its config.
+func SetTelemetryEnabled(enabled bool) {
+	telemetryEnabled.Store(enabled)
+}
+
+// IsTelemetryEnabled returns whether the STF emits telemetry.
+func IsTelemetryEnabled() bool {
+	return telemetryEnabled.Load()
+}
+
+// emitMsgTelemetry emits the execution time, gas used and outcome of a message
+// executed in finalize mode, labelled by its type URL. Failures are also
+// labelled by the codespace of the error.
+func emitMsgTelemetry(typeURL string, duration time.Duration, gasUsed uint64, err error) {
+	labels := []metrics.Label{{Name: MetricLabelNameTypeURL, Value: typeURL}}
+
+	metrics.IncrCounterWithLabels([]string{"msg", "count"}, 1, labels)
+	metrics.AddSampleWithLabels([]string{"msg", "duration"}, float32(duration)/float32(time.Millisecond), labels)
+	metrics.AddSampleWithLabels([]string{"msg", "gas_used"}, float32(gasUsed), labels)
+
+	if err != nil {
+		metrics.IncrCounterWithLabels(
+			[]string{"msg", "failed"},
+			1,
+			append(labels, metrics.Label{Name: MetricLabelNameCodespace, Value: errorCodespace(err)}),
+		)
+	}
+}
+
+// errorCodespace returns the codespace of a registered error.
+func errorCodespace(err error) string {
+	var registered interface{ Codespace() string }
+	if errors.As(err, &registered) {
+		return registered.Codespace()
+	}
+
+	return undefinedCodespace
+}
@@ -0,0 +1,154 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/metrics"
+	"cosmossdk.io/store/v2/migration"
+	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/storage"
+)
+
+// MigrationResult is the outcome of a store/v1 to store/v2 migration.
+type MigrationResult struct {
+	// Height is the height of the migrated state.
+	Height uint64
+	// Keys is the number of keys migrated by this run, 0 if the state was already migrated.
+	Keys uint64
+	// Duration is the duration of this run.
+	Duration time.Duration
+}
+
+// MigrateCmd returns a command to migrate the state committed by store/v1 to store/v2.
+func (s *Server[T]) MigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the application state from store/v1 to store/v2",
+		Long: `Migrate the latest application state committed by store/v1 (rootmulti) to store/v2.
+The node must be stopped. The migrated version is persisted, so that an interrupted migration can be restarted
+with the same command, skipping the stores already migrated. The progress is logged and reported in the
+migration metrics.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := serverv2.GetViperFromCmd(cmd)
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			storeConfig, err := UnmarshalConfig(vp.AllSettings())
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal config: %w", err)
+			}
+
+			result, err := MigrateV1(log.NewLogger(cmd.OutOrStdout()), storeConfig, metrics.Metrics{})
+			if err != nil {
+				return err
+			}
+			if result.Keys == 0 {
+				cmd.Printf("state already migrated at height %d\n", result.Height)
+				return nil
+			}
+
+			cmd.Printf("migrated state at height %d: %d keys in %s (%.0f keys/s)\n",
+				result.Height, result.Keys, result.Duration, float64(result.Keys)/result.Duration.Seconds())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+
+	return cmd
+}
+
+// MigrateV1 migrates the latest state committed by store/v1 in the application
+// database to store/v2, with the store keys of store/v1. It is meant to be run
+// before the application opens its store, either by the migrate command or by the
+// application on start. If a previous run was interrupted, the stores already
+// restored are skipped, and nothing is done if the state was already migrated.
+func MigrateV1(logger log.Logger, config *root.Config, telemetry metrics.StoreMetrics) (result MigrationResult, err error) {
+	if config.Home == "" {
+		return result, errors.New("home directory is required")
+	}
+	dataDir := filepath.Join(config.Home, "data")
+
+	appDB, err := db.NewDB(db.DBType(config.AppDBBackend), "application", dataDir, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to open application db: %w", err)
+	}
+	v1Store, err := migration.NewV1Store(appDB, logger)
+	if err != nil {
+		return result, errors.Join(err, appDB.Close())
+	}
+	defer func() {
+		err = errors.Join(err, v1Store.Close())
+	}()
+	result.Height = v1Store.LatestVersion()
+	if result.Height == 0 {
+		return result, errors.Join(errors.New("no store/v1 state to migrate"), appDB.Close())
+	}
+
+	// the application db is closed by the root store
+	rootStore, err := root.CreateRootStore(&root.FactoryOptions{
+		Logger:    logger,
+		RootDir:   config.Home,
+		Options:   config.Options,
+		StoreKeys: v1Store.StoreKeys(),
+		SCRawDB:   appDB,
+	})
+	if err != nil {
+		return result, errors.Join(fmt.Errorf("failed to create root store: %w", err), appDB.Close())
+	}
+	defer func() {
+		err = errors.Join(err, rootStore.Close())
+	}()
+
+	migrationDB, err := db.NewDB(db.DBType(config.AppDBBackend), "migration", dataDir, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to open migration db: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, migrationDB.Close())
+	}()
+
+	snapshotStore, err := snapshots.NewStore(filepath.Join(dataDir, "snapshots"))
+	if err != nil {
+		return result, err
+	}
+	ss := rootStore.GetStateStorage().(*storage.StorageStore)
+	sc := rootStore.GetStateCommitment().(*commitment.CommitStore)
+	sm := snapshots.NewManager(snapshotStore, snapshots.NewSnapshotOptions(0, 0), v1Store, ss, nil, logger)
+
+	mm := migration.NewManager(migrationDB, sm, ss, sc, logger)
+	if telemetry != nil {
+		mm.SetMetrics(telemetry)
+	}
+
+	migratedVersion, err := mm.LoadMigratedVersion()
+	if err != nil {
+		return result, err
+	}
+	if migratedVersion >= result.Height {
+		return result, nil
+	}
+
+	start := time.Now()
+	if err := mm.Migrate(result.Height); err != nil {
+		return result, fmt.Errorf("failed to migrate state at height %d: %w", result.Height, err)
+	}
+	sm.EndMigration(sc)
+
+	result.Keys = mm.RestoredKeys()
+	result.Duration = time.Since(start)
+
+	return result, nil
+}
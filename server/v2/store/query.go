@@ -0,0 +1,237 @@
+package store
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/store/types"
+	storev2 "cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+const (
+	// defaultKeyHistoryLimit is the number of versions returned by KeyHistory when
+	// the request has no limit.
+	defaultKeyHistoryLimit = 100
+	// maxKeyHistoryLimit is the maximum number of versions returned by KeyHistory.
+	maxKeyHistoryLimit = 1000
+)
+
+// ProverStore is a store able to prove keys at historical heights. If it also
+// implements storev2.HistoryReader, the history of the keys can be queried.
+type ProverStore interface {
+	storev2.Prover
+
+	GetLatestVersion() (uint64, error)
+}
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	store ProverStore
+}
+
+// NewQueryServer returns the store query gRPC service implementation.
+func NewQueryServer(store ProverStore) types.QueryServer {
+	return queryServer{store: store}
+}
+
+// ProveKey implements types.QueryServer.
+func (q queryServer) ProveKey(_ context.Context, req *types.QueryProveKeyRequest) (*types.QueryProveKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Store == "" {
+		return nil, status.Error(codes.InvalidArgument, "store cannot be empty")
+	}
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "key cannot be empty")
+	}
+
+	height := req.Height
+	if height == 0 {
+		latest, err := q.store.GetLatestVersion()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		height = latest
+	}
+
+	result, err := q.store.ProveKey([]byte(req.Store), height, req.Key)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to prove key at height %d: %v", height, err)
+	}
+	if len(result.ProofOps) == 0 {
+		return nil, status.Errorf(codes.Internal, "no proof for key at height %d", height)
+	}
+
+	res := &types.QueryProveKeyResponse{
+		Value:    result.Value,
+		Height:   height,
+		ProofOps: make([]*types.ProofOp, len(result.ProofOps)),
+	}
+	for i, op := range result.ProofOps {
+		bz, err := op.Proof.Marshal()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.ProofOps[i] = &types.ProofOp{ProofType: op.Type, Key: op.Key, Data: bz}
+	}
+
+	// the last proof goes from the root of the store to the app hash
+	res.AppHash, err = result.ProofOps[len(result.ProofOps)-1].Proof.Calculate()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+// KeyHistory implements types.QueryServer.
+func (q queryServer) KeyHistory(_ context.Context, req *types.QueryKeyHistoryRequest) (*types.QueryKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Store == "" {
+		return nil, status.Error(codes.InvalidArgument, "store cannot be empty")
+	}
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "key cannot be empty")
+	}
+
+	reader, ok := q.store.(storev2.HistoryReader)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "store does not support key history")
+	}
+
+	toHeight := req.ToHeight
+	if toHeight == 0 {
+		latest, err := q.store.GetLatestVersion()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		toHeight = latest
+	}
+	if req.FromHeight > toHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "from height %d is greater than to height %d", req.FromHeight, toHeight)
+	}
+
+	limit := defaultKeyHistoryLimit
+	if req.Limit > 0 {
+		limit = min(int(req.Limit), maxKeyHistoryLimit)
+	}
+
+	itr, err := reader.VersionIterator([]byte(req.Store), req.Key, req.FromHeight, toHeight)
+	if err != nil {
+		if errors.As(err, &storeerrors.ErrVersionPruned{}) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer itr.Close()
+
+	res := &types.QueryKeyHistoryResponse{}
+	for ; itr.Valid(); itr.Next() {
+		if len(res.Versions) == limit {
+			res.NextHeight = itr.Version()
+			break
+		}
+
+		value := itr.Value()
+		res.Versions = append(res.Versions, &types.KeyVersion{
+			Height:  itr.Version(),
+			Value:   value,
+			Deleted: value == nil,
+		})
+	}
+	if err := itr.Error(); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+// KeyHistoryCmd returns a command to print the values of a key at every height it
+// was written at, read from the state storage of the stopped node.
+func (s *Server[T]) KeyHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-history <store> <hex-key>",
+		Short: "Print the values of a key at every height it was written at",
+		Long: `Print the values of a key of a store at every height it was written at, in ascending order.
+The key is hex encoded, the values are printed hex encoded and the deletions as "deleted".
+The history is read from the state storage, so it is only available above the pruned heights.`,
+		Example: fmt.Sprintf("%s store key-history bank 0200 --from-height 100 --to-height 200", "<appd>"),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := serverv2.GetViperFromCmd(cmd)
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid hex key: %w", err)
+			}
+			fromHeight, err := cmd.Flags().GetUint64("from-height")
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetUint64("to-height")
+			if err != nil {
+				return err
+			}
+
+			rootStore, _, err := createRootStore(vp, log.NewNopLogger())
+			if err != nil {
+				return fmt.Errorf("can not create root store %w", err)
+			}
+			defer rootStore.Close()
+
+			prover, ok := rootStore.(ProverStore)
+			if !ok {
+				return errors.New("store does not support key history")
+			}
+			qs := NewQueryServer(prover)
+
+			req := &types.QueryKeyHistoryRequest{
+				Store:      args[0],
+				Key:        key,
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Limit:      maxKeyHistoryLimit,
+			}
+			for {
+				res, err := qs.KeyHistory(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				for _, v := range res.Versions {
+					if v.Deleted {
+						cmd.Printf("%d\tdeleted\n", v.Height)
+					} else {
+						cmd.Printf("%d\t%X\n", v.Height, v.Value)
+					}
+				}
+				if res.NextHeight == 0 {
+					return nil
+				}
+				req.FromHeight = res.NextHeight
+			}
+		},
+	}
+
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().Uint64("from-height", 0, "First height of the history")
+	cmd.Flags().Uint64("to-height", 0, "Last height of the history, default to latest state height")
+
+	return cmd
+}
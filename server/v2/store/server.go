@@ -59,6 +59,8 @@ func (s *Server[T]) CLICommands() serverv2.CLIConfig {
 			s.DumpArchiveCmd(),
 			s.LoadArchiveCmd(),
 			s.RestoreSnapshotCmd(s.backend),
+			s.MigrateCmd(),
+			s.KeyHistoryCmd(),
 		},
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"io"
 
 	clienthelpers "cosmossdk.io/client/v2/helpers"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
@@ -19,13 +20,19 @@ import (
 	lockupdepinject "cosmossdk.io/x/accounts/defaults/lockup/depinject"
 	multisigdepinject "cosmossdk.io/x/accounts/defaults/multisig/depinject"
 	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
 	consensuskeeper "cosmossdk.io/x/consensus/keeper"
 	distrkeeper "cosmossdk.io/x/distribution/keeper"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	"cosmossdk.io/x/feegrant"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	_ "cosmossdk.io/x/protocolpool"
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
+	slashingtypes "cosmossdk.io/x/slashing/types"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -258,6 +265,8 @@ func NewSimApp(
 
 	app.sm.RegisterStoreDecoders()
 
+	app.setModuleCodecs(app.moduleSchemas())
+
 	// A custom InitChainer can be set if extra pre-init-genesis logic is required.
 	// By default, when using app wiring enabled module, this is not required.
 	// For instance, the upgrade module will set automatically the module version map in its init genesis thanks to app wiring.
@@ -288,6 +297,25 @@ func NewSimApp(
 	return app
 }
 
+// moduleSchemas returns the collections schemas of the modules, by store key
+// name.
+func (app *SimApp) moduleSchemas() map[string]collections.Schema {
+	schemas := map[string]collections.Schema{
+		authtypes.StoreKey:     app.AuthKeeper.Schema,
+		circuittypes.StoreKey:  app.CircuitBreakerKeeper.Schema,
+		distrtypes.StoreKey:    app.DistrKeeper.Schema,
+		feegrant.StoreKey:      app.FeeGrantKeeper.Schema,
+		slashingtypes.StoreKey: app.SlashingKeeper.Schema,
+		stakingtypes.StoreKey:  app.StakingKeeper.Schema,
+	}
+	// the bank keeper is only exposed through its interface
+	if bk, ok := app.BankKeeper.(bankkeeper.BaseKeeper); ok {
+		schemas[banktypes.StoreKey] = bk.Schema
+	}
+
+	return schemas
+}
+
 // setCustomAnteHandler overwrites default ante handlers with custom ante handlers
 // set SkipAnteHandler to true in app config and set custom ante handler on baseapp
 func (app *SimApp) setCustomAnteHandler() {
@@ -0,0 +1,25 @@
+package simapp
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/schema"
+)
+
+// setModuleCodecs registers the codecs of the module collections schemas, by
+// store key name, so that module state can be decoded, e.g. in transaction
+// traces.
+func (app *SimApp) setModuleCodecs(schemas map[string]collections.Schema) {
+	codecs := make(map[string]schema.ModuleCodec, len(schemas))
+	for storeKey, s := range schemas {
+		cdc, err := s.ModuleCodec(collections.IndexingOptions{})
+		if err != nil {
+			// the schemas which can't be described yet, e.g. with nested composite
+			// keys, are skipped and the state of their module is left undecoded
+			app.Logger().Debug("skipping module codec", "store", storeKey, "err", err)
+			continue
+		}
+		codecs[storeKey] = cdc
+	}
+
+	app.SetModuleCodecs(codecs)
+}
@@ -36,10 +36,17 @@ func initRootCmd(
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(
+		server.NewReplayBlockCmd(newApp),
+		server.NewStateCmd(newApp),
+		server.NewStateDiffCmd(newApp),
+	)
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(moduleManager),
 		NewTestnetCmd(moduleManager),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp),
 		snapshot.Cmd(newApp),
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/simapp"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// corruptingTxExecutor executes the block transactions sequentially, then
+// writes an unexpected key to the store of storeKey.
+type corruptingTxExecutor struct {
+	storeKey storetypes.StoreKey
+}
+
+func (e *corruptingTxExecutor) ExecuteTxs(
+	_ context.Context,
+	txs [][]byte,
+	ms storetypes.MultiStore,
+	blockGasMeter storetypes.GasMeter,
+	deliverTx baseapp.DeliverTxFunc,
+) ([]*abci.ExecTxResult, error) {
+	results := make([]*abci.ExecTxResult, len(txs))
+	for i, tx := range txs {
+		results[i] = deliverTx(tx, ms, blockGasMeter)
+	}
+	ms.GetKVStore(e.storeKey).Set([]byte("corrupted"), []byte{1})
+
+	return results, nil
+}
+
+func TestReplayBlockCmd(t *testing.T) {
+	cfg := network.DefaultConfig(simapp.NewTestNetworkFixture)
+	cfg.NumValidators = 1
+	// the databases of the validator are replayed once the network is stopped
+	cfg.CleanupDir = false
+	// replaying needs the application state persisted on disk
+	cfg.AppConstructor = func(val network.ValidatorI) servertypes.Application {
+		home := client.GetConfigFromViper(val.GetViper()).RootDir
+		db, err := server.OpenDB(home, server.GetAppDBBackend(val.GetViper()))
+		if err != nil {
+			panic(err)
+		}
+
+		return simapp.NewSimApp(
+			val.GetLogger(), db, nil, true,
+			simtestutil.NewAppOptionsWithFlagHome(home),
+			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
+			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+			baseapp.SetChainID(val.GetViper().GetString(flags.FlagChainID)),
+		)
+	}
+
+	net, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	val := net.GetValidators()[0]
+	clientCtx := val.GetClientCtx()
+
+	// commit a block with a bank send
+	_, err = net.WaitForHeight(2)
+	require.NoError(t, err)
+	to := sdk.AccAddress("replay_block_receiver")
+	toStr, err := clientCtx.AddressCodec.BytesToString(to)
+	require.NoError(t, err)
+	fromStr, err := clientCtx.AddressCodec.BytesToString(val.GetAddress())
+	require.NoError(t, err)
+	out, err := clitestutil.SubmitTestTx(clientCtx,
+		banktypes.NewMsgSend(fromStr, toStr, sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, sdkmath.NewInt(10)))),
+		val.GetAddress(), clitestutil.TestTxConfig{})
+	require.NoError(t, err)
+
+	var txRes sdk.TxResponse
+	require.NoError(t, clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	require.Zero(t, txRes.Code, txRes.RawLog)
+	require.NoError(t, net.WaitForNextBlock())
+	require.NoError(t, net.WaitForNextBlock())
+
+	committedTx, err := authtx.QueryTx(clientCtx, txRes.TxHash)
+	require.NoError(t, err)
+	height := committedTx.Height
+
+	net.Cleanup()
+
+	cmtCfg := client.GetConfigFromViper(val.GetViper())
+	v := val.GetViper()
+	v.Set(flags.FlagHome, cmtCfg.RootDir)
+	// load the app.toml of the validator, as the server does on start
+	v.SetConfigFile(filepath.Join(cmtCfg.RootDir, "config", "app.toml"))
+	require.NoError(t, v.MergeInConfig())
+	svrCtx := server.NewContext(v, cmtCfg, log.NewNopLogger())
+
+	replay := func(appCreator servertypes.AppCreator[servertypes.Application]) (string, error) {
+		cmd := server.NewReplayBlockCmd(appCreator)
+		cmd.SetArgs([]string{fmt.Sprint(height)})
+		var buf bytes.Buffer
+		cmd.SetOut(&buf)
+		cmd.SetErr(io.Discard)
+
+		err := cmd.ExecuteContext(context.WithValue(context.Background(), server.ServerContextKey, svrCtx))
+		return buf.String(), err
+	}
+
+	t.Run("matching app hash", func(t *testing.T) {
+		out, err := replay(newApp)
+		require.NoError(t, err, out)
+		require.Contains(t, out, "replayed app hash matches the committed one")
+		require.NotContains(t, out, "MISMATCH")
+		require.NotContains(t, out, "tx 0:")
+	})
+
+	t.Run("corrupted state", func(t *testing.T) {
+		out, err := replay(func(logger log.Logger, db corestore.KVStoreWithBatch, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+			executor := &corruptingTxExecutor{}
+			app := simapp.NewSimApp(logger, db, traceStore, true, appOpts,
+				append(server.DefaultBaseappOptions(appOpts), baseapp.SetTxExecutor(executor))...)
+			executor.storeKey = app.CommitMultiStore().(*rootmulti.Store).StoreKeysByName()[banktypes.StoreKey]
+
+			return app
+		})
+		require.ErrorContains(t, err, "replayed app hash does not match the committed one: 1 store(s) diverge")
+		require.Regexp(t, `(?m)^bank\s+[0-9A-F]+\s+[0-9A-F]+\s+MISMATCH$`, out)
+		require.Regexp(t, `(?m)^staking\s+([0-9A-F]+)\s+([0-9A-F]+)\s+$`, out)
+	})
+}
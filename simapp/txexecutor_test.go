@@ -0,0 +1,299 @@
+package simapp
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const bankSendChainID = "bank-send"
+
+// bankSendDeltaKeys are the delta keys of the bank store updated by bank sends
+// paying fees: the balances of the fee collector and the denom holder counts.
+var bankSendDeltaKeys = []baseapp.DeltaKeys{
+	{
+		StoreName: banktypes.StoreKey,
+		Prefix:    banktypes.CreateAccountBalancesPrefix(authtypes.NewModuleAddress(authtypes.FeeCollectorName)),
+		Merge:     banktypes.MergeBalanceDelta,
+	},
+	{
+		StoreName: banktypes.StoreKey,
+		Prefix:    banktypes.DenomHolderCountsPrefix.Bytes(),
+		Merge:     banktypes.MergeDenomHolderCountDelta,
+	},
+}
+
+// bankSendFixture holds the genesis of a chain whose senders send coins to
+// their own receiver, each paying a fee to the fee collector.
+type bankSendFixture struct {
+	valSet    *cmttypes.ValidatorSet
+	privs     []cryptotypes.PrivKey
+	receivers []sdk.AccAddress
+	accNums   []uint64
+	seqs      []uint64
+}
+
+func newBankSendFixture(tb testing.TB, numAccounts int) *bankSendFixture {
+	tb.Helper()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(tb, err)
+
+	f := &bankSendFixture{
+		valSet: cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)}),
+		seqs:   make([]uint64, numAccounts),
+	}
+	for i := 0; i < numAccounts; i++ {
+		f.privs = append(f.privs, secp256k1.GenPrivKey())
+		f.receivers = append(f.receivers, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	}
+
+	return f
+}
+
+// newApp returns a SimApp running the block transactions with executor, at the
+// genesis of the fixture.
+func (f *bankSendFixture) newApp(tb testing.TB, executor baseapp.TxExecutor) *SimApp {
+	tb.Helper()
+
+	app := NewSimApp(log.NewNopLogger(), coretesting.NewMemDB(), nil, true,
+		simtestutil.NewAppOptionsWithFlagHome(tb.TempDir()),
+		baseapp.SetChainID(bankSendChainID), baseapp.SetTxExecutor(executor))
+	addrCodec := app.InterfaceRegistry().SigningContext().AddressCodec()
+
+	var (
+		genAccs  []authtypes.GenesisAccount
+		balances []banktypes.Balance
+	)
+	for i, priv := range f.privs {
+		for _, addr := range []sdk.AccAddress{sdk.AccAddress(priv.PubKey().Address()), f.receivers[i]} {
+			addrStr, err := addrCodec.BytesToString(addr)
+			require.NoError(tb, err)
+
+			genAccs = append(genAccs, authtypes.NewBaseAccount(addr, nil, 0, 0))
+			balances = append(balances, banktypes.Balance{
+				Address: addrStr,
+				Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000_000))),
+			})
+		}
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), f.valSet, genAccs, balances...)
+	require.NoError(tb, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(tb, err)
+
+	_, err = app.InitChain(&abci.InitChainRequest{
+		ChainId:         bankSendChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(tb, err)
+	_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, NextValidatorsHash: f.valSet.Hash()})
+	require.NoError(tb, err)
+	_, err = app.Commit()
+	require.NoError(tb, err)
+
+	if f.accNums == nil {
+		ctx := app.NewContext(true)
+		for _, priv := range f.privs {
+			acc := app.AuthKeeper.GetAccount(ctx, sdk.AccAddress(priv.PubKey().Address()))
+			f.accNums = append(f.accNums, acc.GetAccountNumber())
+		}
+	}
+
+	return app
+}
+
+// nextBlock returns the txs of the next block, in which every sender sends
+// coins to its receiver.
+func (f *bankSendFixture) nextBlock(tb testing.TB, app *SimApp) [][]byte {
+	tb.Helper()
+
+	addrCodec := app.InterfaceRegistry().SigningContext().AddressCodec()
+	txConfig := app.TxConfig()
+	r := rand.New(rand.NewSource(int64(f.seqs[0])))
+
+	txs := make([][]byte, len(f.privs))
+	for i, priv := range f.privs {
+		from, err := addrCodec.BytesToString(priv.PubKey().Address())
+		require.NoError(tb, err)
+		to, err := addrCodec.BytesToString(f.receivers[i])
+		require.NoError(tb, err)
+
+		tx, err := simtestutil.GenSignedMockTx(r, txConfig,
+			[]sdk.Msg{banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))},
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), 200_000, bankSendChainID,
+			[]uint64{f.accNums[i]}, []uint64{f.seqs[i]}, priv)
+		require.NoError(tb, err)
+
+		txs[i], err = txConfig.TxEncoder()(tx)
+		require.NoError(tb, err)
+		f.seqs[i]++
+	}
+
+	return txs
+}
+
+// unorderedSendTx returns an unordered tx in which sender i sends coins to its
+// receiver, timing out at timeout.
+func (f *bankSendFixture) unorderedSendTx(tb testing.TB, app *SimApp, i int, timeout time.Time) []byte {
+	tb.Helper()
+
+	addrCodec := app.InterfaceRegistry().SigningContext().AddressCodec()
+	txConfig := app.TxConfig()
+	priv := f.privs[i]
+	from, err := addrCodec.BytesToString(priv.PubKey().Address())
+	require.NoError(tb, err)
+	to, err := addrCodec.BytesToString(f.receivers[i])
+	require.NoError(tb, err)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(tb, builder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	builder.SetGasLimit(200_000)
+	builder.SetUnordered(true)
+	builder.SetTimeoutTimestamp(timeout)
+
+	signMode, err := authsign.APISignModeToInternal(txConfig.SignModeHandler().DefaultMode())
+	require.NoError(tb, err)
+	require.NoError(tb, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: f.seqs[i],
+	}))
+	sig, err := clienttx.SignWithPrivKey(context.Background(), signMode, authsign.SignerData{
+		Address:       from,
+		ChainID:       bankSendChainID,
+		AccountNumber: f.accNums[i],
+		Sequence:      f.seqs[i],
+		PubKey:        priv.PubKey(),
+	}, builder, priv, txConfig, f.seqs[i])
+	require.NoError(tb, err)
+	require.NoError(tb, builder.SetSignatures(sig))
+
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(tb, err)
+
+	return bz
+}
+
+func TestParallelTxExecutorBankSends(t *testing.T) {
+	f := newBankSendFixture(t, 50)
+	sequential := f.newApp(t, nil)
+	parallel := f.newApp(t, baseapp.NewParallelTxExecutor(4, bankSendDeltaKeys...))
+
+	for height := int64(2); height <= 4; height++ {
+		txs := f.nextBlock(t, sequential)
+
+		expected, err := sequential.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Txs: txs})
+		require.NoError(t, err)
+		actual, err := parallel.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Txs: txs})
+		require.NoError(t, err)
+
+		for _, res := range expected.TxResults {
+			require.Zero(t, res.Code, res.Log)
+		}
+		require.Equal(t, expected.TxResults, actual.TxResults)
+		require.Equal(t, expected.AppHash, actual.AppHash)
+
+		_, err = sequential.Commit()
+		require.NoError(t, err)
+		_, err = parallel.Commit()
+		require.NoError(t, err)
+	}
+}
+
+// TestParallelTxExecutorUnorderedTxs checks that unordered txs executed again
+// because of a conflict, here with the tx of the same sender before them, are
+// not rejected as duplicated, while duplicated unordered txs still are.
+func TestParallelTxExecutorUnorderedTxs(t *testing.T) {
+	f := newBankSendFixture(t, 10)
+	sequential := f.newApp(t, nil)
+	parallel := f.newApp(t, baseapp.NewParallelTxExecutor(4, bankSendDeltaKeys...))
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	for height := int64(2); height <= 3; height++ {
+		blockTime = blockTime.Add(5 * time.Second)
+		txs := f.nextBlock(t, sequential)
+		unordered := f.unorderedSendTx(t, sequential, int(height), blockTime.Add(time.Minute))
+		txs = append(txs, f.unorderedSendTx(t, sequential, 0, blockTime.Add(time.Minute)), unordered, unordered)
+
+		req := &abci.FinalizeBlockRequest{Height: height, Time: blockTime, Txs: txs}
+		expected, err := sequential.FinalizeBlock(req)
+		require.NoError(t, err)
+		actual, err := parallel.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		for _, res := range expected.TxResults[:len(txs)-1] {
+			require.Zero(t, res.Code, res.Log)
+		}
+		require.Contains(t, expected.TxResults[len(txs)-1].Log, "duplicated")
+		require.Equal(t, expected.TxResults, actual.TxResults)
+		require.Equal(t, expected.AppHash, actual.AppHash)
+
+		_, err = sequential.Commit()
+		require.NoError(t, err)
+		_, err = parallel.Commit()
+		require.NoError(t, err)
+	}
+}
+
+// BenchmarkBankSendTxs measures the execution of blocks of independent bank
+// sends paying fees, each one conflicting with the previous one through the
+// balance of the fee collector unless it is declared as a delta key.
+func BenchmarkBankSendTxs(b *testing.B) {
+	benchmarks := []struct {
+		name     string
+		executor func() baseapp.TxExecutor
+	}{
+		{"sequential", func() baseapp.TxExecutor { return nil }},
+		{"parallel", func() baseapp.TxExecutor { return baseapp.NewParallelTxExecutor(8) }},
+		{"parallel with delta keys", func() baseapp.TxExecutor { return baseapp.NewParallelTxExecutor(8, bankSendDeltaKeys...) }},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			f := newBankSendFixture(b, 200)
+			app := f.newApp(b, bm.executor())
+
+			blocks := make([][][]byte, b.N)
+			for i := range blocks {
+				blocks[i] = f.nextBlock(b, app)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i, txs := range blocks {
+				_, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: int64(i) + 2, Txs: txs})
+				require.NoError(b, err)
+				_, err = app.Commit()
+				require.NoError(b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package simapp
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTraceUnorderedTx checks that tracing an unordered tx leaves the unordered
+// tx manager untouched, so the tx can still be included in a block.
+func TestTraceUnorderedTx(t *testing.T) {
+	f := newBankSendFixture(t, 1)
+	app := f.newApp(t, nil)
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	_, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 2, Time: blockTime})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	tx := f.unorderedSendTx(t, app, 0, blockTime.Add(time.Minute))
+	for i := 0; i < 2; i++ {
+		trace, err := app.TraceTx(tx, 0)
+		require.NoError(t, err)
+		require.Zero(t, trace.Code, trace.Log)
+	}
+
+	res, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 3, Time: blockTime.Add(5 * time.Second), Txs: [][]byte{tx}})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 1)
+	require.Zero(t, res.TxResults[0].Code, res.TxResults[0].Log)
+}
@@ -19,11 +19,13 @@ import (
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/stf"
 	serverv2store "cosmossdk.io/server/v2/store"
 	"cosmossdk.io/store/v2/db"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -155,3 +157,10 @@ func TestSimAppExportAndBlockedAddrs_NoBlocksProduced(t *testing.T) {
 	_, err := app.ExportAppStateAndValidators(nil)
 	require.NoError(t, err)
 }
+
+// TestMsgTelemetryLabels checks that the STF and baseapp label the metrics of
+// messages the same way.
+func TestMsgTelemetryLabels(t *testing.T) {
+	require.Equal(t, telemetry.MetricLabelNameTypeURL, stf.MetricLabelNameTypeURL)
+	require.Equal(t, telemetry.MetricLabelNameCodespace, stf.MetricLabelNameCodespace)
+}
@@ -395,6 +395,31 @@ func (st *Store) TraverseStateChanges(startVersion, endVersion int64, fn func(ve
 	return st.tree.TraverseStateChanges(startVersion, endVersion, fn)
 }
 
+// SaveChangeSet applies the given changes to the tree and saves them as a new
+// version, returning its CommitID.
+func (st *Store) SaveChangeSet(changeSet *iavl.ChangeSet) (types.CommitID, error) {
+	for _, pair := range changeSet.Pairs {
+		if pair.Delete {
+			_, removed, err := st.tree.Remove(pair.Key)
+			if err != nil {
+				return types.CommitID{}, err
+			}
+			if !removed {
+				return types.CommitID{}, fmt.Errorf("cannot delete non-existent key %X", pair.Key)
+			}
+		} else if _, err := st.tree.Set(pair.Key, pair.Value); err != nil {
+			return types.CommitID{}, err
+		}
+	}
+
+	hash, version, err := st.tree.SaveVersion()
+	if err != nil {
+		return types.CommitID{}, err
+	}
+
+	return types.CommitID{Version: version, Hash: hash}, nil
+}
+
 // Takes a MutableTree, a key, and a flag for creating existence or absence proof and returns the
 // appropriate merkle.Proof. Since this must be called after querying for the value, this function should never error
 // Thus, it will panic on error rather than returning it
@@ -3,8 +3,10 @@ package pruning
 import (
 	"encoding/binary"
 	"fmt"
+	"slices"
 	"sort"
 	"sync"
+	"time"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/pruning/types"
@@ -26,6 +28,12 @@ type Manager struct {
 	// These are the heights that are multiples of snapshotInterval and kept for state sync snapshots.
 	// The heights are added to be pruned when a snapshot is complete.
 	pruneSnapshotHeights []int64
+	// These are the sorted heights of the snapshots retained by the snapshot manager. The heights
+	// since the oldest of them are kept if the KeepSnapshots option is set.
+	retainedSnapshotHeights []int64
+	// blockTimeFn returns the block time of a committed height, it is required by the
+	// KeepTime option.
+	blockTimeFn func(height int64) (time.Time, error)
 }
 
 // NegativeHeightsError is returned when a negative height is provided to the manager.
@@ -98,6 +106,22 @@ func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// SetRetainedSnapshotHeights sets the heights of the snapshots retained by the snapshot manager.
+// If the KeepSnapshots option is set, the heights since the oldest of them are not pruned, so
+// that the state of the snapshots remains available.
+func (m *Manager) SetRetainedSnapshotHeights(heights []int64) {
+	m.pruneSnapshotHeightsMx.Lock()
+	defer m.pruneSnapshotHeightsMx.Unlock()
+
+	m.retainedSnapshotHeights = slices.Sorted(slices.Values(heights))
+}
+
+// SetBlockTimeFn sets the function returning the block time of a committed height, which is
+// used by the KeepTime option. If it is not set, the KeepTime option prevents any pruning.
+func (m *Manager) SetBlockTimeFn(fn func(height int64) (time.Time, error)) {
+	m.blockTimeFn = fn
+}
+
 // GetPruningHeight returns the height which can prune up to if it is able to prune at the given height.
 func (m *Manager) GetPruningHeight(height int64) int64 {
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
@@ -111,12 +135,22 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 		return 0
 	}
 
-	// Consider the snapshot height
 	pruneHeight := height - 1 - int64(m.opts.KeepRecent) // we should keep the current height at least
+	if m.opts.KeepTime > 0 {
+		pruneHeight = m.getTimePruningHeight(height, pruneHeight)
+	}
 
+	// Consider the snapshot height
 	m.pruneSnapshotHeightsMx.RLock()
 	defer m.pruneSnapshotHeightsMx.RUnlock()
 
+	if m.opts.KeepSnapshots && len(m.retainedSnapshotHeights) > 0 {
+		pruneHeight = min(pruneHeight, m.retainedSnapshotHeights[0]-1)
+	}
+	if pruneHeight <= 0 {
+		return 0
+	}
+
 	// snapshotInterval is zero, indicating that all heights can be pruned
 	if m.snapshotInterval <= 0 {
 		return pruneHeight
@@ -135,6 +169,28 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 	return pruneHeight
 }
 
+// getTimePruningHeight returns the highest height, up to maxHeight, whose block time is at least
+// KeepTime older than the block time of the given height, or 0 if there is none. Since the block
+// times are monotonic, the height is found with a binary search, the heights whose block time is
+// unknown, e.g. before the initial height, being considered old.
+func (m *Manager) getTimePruningHeight(height, maxHeight int64) int64 {
+	if m.blockTimeFn == nil || maxHeight <= 0 {
+		return 0
+	}
+
+	blockTime, err := m.blockTimeFn(height)
+	if err != nil {
+		m.logger.Error("failed to get block time, skipping pruning", "height", height, "err", err)
+		return 0
+	}
+	keepFrom := blockTime.Add(-m.opts.KeepTime)
+
+	return int64(sort.Search(int(maxHeight), func(i int) bool {
+		t, err := m.blockTimeFn(int64(i) + 1)
+		return err == nil && t.After(keepFrom)
+	}))
+}
+
 // LoadSnapshotHeights loads the snapshot heights from the database as a crash recovery.
 func (m *Manager) LoadSnapshotHeights(db corestore.KVStoreWithBatch) error {
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -196,6 +197,90 @@ func TestPruningHeight_Inputs(t *testing.T) {
 	}
 }
 
+func TestPruningHeight_KeepTime(t *testing.T) {
+	genesis := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// a block every minute from height 5, the heights below it being unknown
+	blockTime := func(height int64) (time.Time, error) {
+		if height < 5 {
+			return time.Time{}, errors.New("no commit info found")
+		}
+		return genesis.Add(time.Duration(height) * time.Minute), nil
+	}
+
+	testcases := map[string]struct {
+		keepRecent     uint64
+		keepTime       time.Duration
+		blockTimeFn    func(height int64) (time.Time, error)
+		height         int64
+		expectedResult int64
+	}{
+		"keep time retains more than keep recent": {
+			keepRecent:     2,
+			keepTime:       time.Hour,
+			blockTimeFn:    blockTime,
+			height:         100,
+			expectedResult: 40,
+		},
+		"keep recent retains more than keep time": {
+			keepRecent:     80,
+			keepTime:       time.Hour,
+			blockTimeFn:    blockTime,
+			height:         100,
+			expectedResult: 19,
+		},
+		"unknown block times are old": {
+			keepRecent:     2,
+			keepTime:       time.Hour,
+			blockTimeFn:    blockTime,
+			height:         60,
+			expectedResult: 4,
+		},
+		"no block time": {
+			keepRecent:     2,
+			keepTime:       time.Hour,
+			height:         100,
+			expectedResult: 0,
+		},
+		"no keep time": {
+			keepRecent:     2,
+			height:         100,
+			expectedResult: 97,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			manager := pruning.NewManager(coretesting.NewMemDB(), log.NewNopLogger())
+			opts := types.NewCustomPruningOptions(tc.keepRecent, 10)
+			opts.KeepTime = tc.keepTime
+			manager.SetOptions(opts)
+			manager.SetBlockTimeFn(tc.blockTimeFn)
+
+			require.Equal(t, tc.expectedResult, manager.GetPruningHeight(tc.height))
+		})
+	}
+}
+
+func TestPruningHeight_KeepSnapshots(t *testing.T) {
+	manager := pruning.NewManager(coretesting.NewMemDB(), log.NewNopLogger())
+	opts := types.NewCustomPruningOptions(2, 10)
+	manager.SetOptions(opts)
+	manager.SetRetainedSnapshotHeights([]int64{50, 30})
+	require.Equal(t, int64(97), manager.GetPruningHeight(100))
+
+	opts.KeepSnapshots = true
+	manager.SetOptions(opts)
+	require.Equal(t, int64(29), manager.GetPruningHeight(100))
+	require.Equal(t, int64(17), manager.GetPruningHeight(20))
+
+	// the heights are pruned up to the oldest snapshot once the older ones are deleted
+	manager.SetRetainedSnapshotHeights([]int64{50, 70})
+	require.Equal(t, int64(49), manager.GetPruningHeight(100))
+
+	manager.SetRetainedSnapshotHeights(nil)
+	require.Equal(t, int64(97), manager.GetPruningHeight(100))
+}
+
 func TestHandleSnapshotHeight_DbErr_Panic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // PruningOptions defines the pruning strategy used when determining which
@@ -16,6 +17,14 @@ type PruningOptions struct {
 
 	// Strategy defines the kind of pruning strategy. See below for more information on each.
 	Strategy PruningStrategy
+
+	// KeepTime defines for how long the heights are kept on disk, based on their block
+	// time, on top of the KeepRecent heights. 0 disables the time based retention.
+	KeepTime time.Duration
+
+	// KeepSnapshots defines whether the heights since the oldest state sync snapshot
+	// retained by the snapshot manager are kept on disk.
+	KeepSnapshots bool
 }
 
 type PruningStrategy int
@@ -56,6 +65,7 @@ var (
 	ErrPruningIntervalZero       = errors.New("'pruning-interval' must not be 0. If you want to disable pruning, select pruning = \"nothing\"")
 	ErrPruningIntervalTooSmall   = fmt.Errorf("'pruning-interval' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingInterval)
 	ErrPruningKeepRecentTooSmall = fmt.Errorf("'pruning-keep-recent' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingKeepRecent)
+	ErrPruningKeepTimeNegative   = errors.New("'pruning-keep-time' must not be negative")
 )
 
 func NewPruningOptions(pruningStrategy PruningStrategy) PruningOptions {
@@ -110,6 +120,9 @@ func (po PruningOptions) Validate() error {
 	if po.KeepRecent < pruneEverythingKeepRecent {
 		return ErrPruningKeepRecentTooSmall
 	}
+	if po.KeepTime < 0 {
+		return ErrPruningKeepTimeNegative
+	}
 	return nil
 }
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +22,8 @@ func TestPruningOptions_Validate(t *testing.T) {
 		{NewCustomPruningOptions(2, 9), ErrPruningIntervalTooSmall},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
+		{PruningOptions{KeepRecent: 2, Interval: 10, KeepTime: time.Hour, Strategy: PruningCustom}, nil},
+		{PruningOptions{KeepRecent: 2, Interval: 10, KeepTime: -time.Hour, Strategy: PruningCustom}, ErrPruningKeepTimeNegative},
 	}
 
 	for _, tc := range testCases {
@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"testing"
 
+	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -232,6 +233,59 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestoreDelta(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(coretesting.NewMemDB())
+	target := newMultiStoreWithMixedMounts(coretesting.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+	require.EqualValues(t, 3, version)
+
+	snapshot := func(write func(protoio.Writer) error) *snapshots.StreamReader {
+		chunks := make(chan io.ReadCloser, 100)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			require.NotNil(t, streamWriter)
+			defer streamWriter.Close()
+			require.NoError(t, write(streamWriter))
+		}()
+
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		require.NoError(t, err)
+		return streamReader
+	}
+
+	// a delta snapshot can't be restored without the state at its base height
+	_, err := target.Restore(version, snapshottypes.DeltaFormat, snapshot(func(w protoio.Writer) error {
+		return source.SnapshotDelta(1, version, w)
+	}))
+	require.Error(t, err)
+
+	target = newMultiStoreWithMixedMounts(coretesting.NewMemDB())
+	_, err = target.Restore(1, snapshottypes.CurrentFormat, snapshot(func(w protoio.Writer) error {
+		return source.Snapshot(1, w)
+	}))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, target.LastCommitID().Version)
+
+	_, err = target.Restore(version, snapshottypes.DeltaFormat, snapshot(func(w protoio.Writer) error {
+		return source.SnapshotDelta(1, version, w)
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+
+	// the base height must be available
+	require.Error(t, source.SnapshotDelta(0, version, nil))
+	require.Error(t, source.SnapshotDelta(version, version, nil))
+	require.Error(t, source.SnapshotDelta(1, version+1, nil))
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	protoio "github.com/cosmos/gogoproto/io"
@@ -78,8 +80,10 @@ type Store struct {
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore             = (*Store)(nil)
+	_ types.Queryable                    = (*Store)(nil)
+	_ snapshottypes.DeltaSnapshotter     = (*Store)(nil)
+	_ snapshottypes.RetainingSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -87,7 +91,7 @@ var (
 // a store is created, KVStores must be mounted and finally LoadLatestVersion or
 // LoadVersion must be called.
 func NewStore(db corestore.KVStoreWithBatch, logger iavltree.Logger, metricGatherer metrics.StoreMetrics) *Store {
-	return &Store{
+	rs := &Store{
 		db:                  db,
 		logger:              logger,
 		iavlCacheSize:       iavl.DefaultIAVLCacheSize,
@@ -100,6 +104,9 @@ func NewStore(db corestore.KVStoreWithBatch, logger iavltree.Logger, metricGathe
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
 	}
+	rs.pruningManager.SetBlockTimeFn(rs.getBlockTime)
+
+	return rs
 }
 
 // GetPruning fetches the pruning strategy from the root store.
@@ -349,6 +356,12 @@ func (rs *Store) PruneSnapshotHeight(height int64) {
 	rs.pruningManager.HandleSnapshotHeight(height)
 }
 
+// SetRetainedSnapshotHeights sets the heights of the snapshots retained in the snapshot store.
+// If the KeepSnapshots pruning option is set, the heights since the oldest of them are not pruned.
+func (rs *Store) SetRetainedSnapshotHeights(heights []int64) {
+	rs.pruningManager.SetRetainedSnapshotHeights(heights)
+}
+
 // SetInterBlockCache sets the Store's internal inter-block (persistent) cache.
 // When this is defined, all CommitKVStores will be wrapped with their respective
 // inter-block cache.
@@ -843,28 +856,10 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
-	keys := keysFromStoreKeyMap(rs.stores)
-	for _, key := range keys {
-		switch store := rs.GetCommitKVStore(key).(type) {
-		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
-		case *transient.Store, *mem.Store:
-			// Non-persisted stores shouldn't be snapshotted
-			continue
-		default:
-			return errorsmod.Wrapf(types.ErrLogic,
-				"don't know how to snapshot store %q of type %T", key.Name(), store)
-		}
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
 	}
-	sort.Slice(stores, func(i, j int) bool {
-		return strings.Compare(stores[i].name, stores[j].name) == -1
-	})
 
 	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
@@ -928,16 +923,144 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	return nil
 }
 
+// namedStore is an IAVL store along with its name.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores returns the stores to snapshot (only IAVL stores are supported), sorted by name.
+func (rs *Store) snapshotStores() ([]namedStore, error) {
+	stores := []namedStore{}
+	keys := keysFromStoreKeyMap(rs.stores)
+	for _, key := range keys {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			stores = append(stores, namedStore{name: key.Name(), Store: store})
+		case *transient.Store, *mem.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+		default:
+			return nil, errorsmod.Wrapf(types.ErrLogic,
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return strings.Compare(stores[i].name, stores[j].name) == -1
+	})
+
+	return stores, nil
+}
+
+// SnapshotDelta implements snapshottypes.DeltaSnapshotter. Each IAVL store is serialized as a
+// SnapshotStoreItem followed, for each version after baseHeight up to height, by the changes made
+// at that version as SnapshotChangeItems and a SnapshotVersionItem holding the resulting store
+// hash. All the versions from baseHeight to height must still be available, i.e. not pruned.
+func (rs *Store) SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	if baseHeight == 0 || baseHeight >= height {
+		return errorsmod.Wrapf(types.ErrLogic, "invalid delta snapshot base height %v for height %v", baseHeight, height)
+	}
+	if height > uint64(GetLatestVersion(rs.db)) {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		rs.logger.Debug("starting delta snapshot", "store", store.name, "base", baseHeight, "height", height)
+		if !store.VersionExists(int64(baseHeight)) {
+			return errorsmod.Wrapf(types.ErrLogic, "version %v of store %q is not available", baseHeight, store.name)
+		}
+
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: store.name,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		changeCount := 0
+		err = store.TraverseStateChanges(int64(baseHeight)+1, int64(height), func(version int64, changeSet *iavltree.ChangeSet) error {
+			for _, pair := range changeSet.Pairs {
+				err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_Change{
+						Change: &snapshottypes.SnapshotChangeItem{
+							Key:    pair.Key,
+							Value:  pair.Value,
+							Delete: pair.Delete,
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+			changeCount += len(changeSet.Pairs)
+
+			tree, err := store.GetImmutable(version)
+			if err != nil {
+				return err
+			}
+
+			return protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_Version{
+					Version: &snapshottypes.SnapshotVersionItem{
+						Version: version,
+						Hash:    tree.LastCommitID().Hash,
+					},
+				},
+			})
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to export changes of store %q", store.name)
+		}
+		rs.logger.Debug("delta snapshot done", "store", store.name, "changeCount", changeCount)
+	}
+
+	return nil
+}
+
 // Restore implements snapshottypes.Snapshotter.
 // returns next snapshot item and error.
 func (rs *Store) Restore(
 	height uint64, format uint32, protoReader protoio.Reader,
 ) (snapshottypes.SnapshotItem, error) {
+	if format == snapshottypes.DeltaFormat {
+		return rs.restoreDelta(height, protoReader)
+	}
+
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
-	var importer *iavltree.Importer
-	var snapshotItem snapshottypes.SnapshotItem
+	// Each store is imported by its own goroutine, so that the nodes of a store are imported while
+	// the following stores are read from the stream.
+	var (
+		nodes        chan *iavltree.ExportNode
+		snapshotItem snapshottypes.SnapshotItem
+		imports      = newParallelImports()
+	)
+	// finish waits for the imports to end, committing them unless an import failed.
+	finish := func() error {
+		if nodes != nil {
+			close(nodes)
+			nodes = nil
+		}
+		return imports.wait()
+	}
+	// abort aborts the imports with the given error.
+	abort := func(err error) (snapshottypes.SnapshotItem, error) {
+		imports.fail(err)
+		_ = finish()
+		return snapshottypes.SnapshotItem{}, err
+	}
+
 loop:
 	for {
 		snapshotItem = snapshottypes.SnapshotItem{}
@@ -945,38 +1068,40 @@ loop:
 		if errors.Is(err, io.EOF) {
 			break
 		} else if err != nil {
-			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+			return abort(errorsmod.Wrap(err, "invalid protobuf message"))
 		}
 
 		switch item := snapshotItem.Item.(type) {
 		case *snapshottypes.SnapshotItem_Store:
-			if importer != nil {
-				err = importer.Commit()
-				if err != nil {
-					return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
-				}
-				importer.Close()
+			if nodes != nil {
+				close(nodes)
+				nodes = nil
 			}
 			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
 			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
+				return abort(errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name))
+			}
+			if !imports.acquire() {
+				return snapshottypes.SnapshotItem{}, finish()
 			}
-			importer, err = store.Import(int64(height))
+			importer, err := store.Import(int64(height))
 			if err != nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
+				imports.release()
+				return abort(errorsmod.Wrap(err, "import failed"))
 			}
-			defer importer.Close()
 			// Importer height must reflect the node height (which usually matches the block height, but not always)
 			rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
+			nodes = make(chan *iavltree.ExportNode, snapshotImportBufferSize)
+			go imports.run(item.Store.Name, importer, nodes)
 
 		case *snapshottypes.SnapshotItem_IAVL:
-			if importer == nil {
+			if nodes == nil {
 				rs.logger.Error("failed to restore; received IAVL node item before store item")
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item")
+				return abort(errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item"))
 			}
 			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
+				return abort(errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
+					item.IAVL.Height, math.MaxInt8))
 			}
 			node := &iavltree.ExportNode{
 				Key:     item.IAVL.Key,
@@ -992,22 +1117,197 @@ loop:
 			if node.Height == 0 && node.Value == nil {
 				node.Value = []byte{}
 			}
-			err := importer.Add(node)
+			select {
+			case nodes <- node:
+			case <-imports.failed:
+				return snapshottypes.SnapshotItem{}, finish()
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	if err := finish(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return snapshotItem, rs.LoadLatestVersion()
+}
+
+const (
+	// snapshotImportWorkers is the maximum number of stores imported concurrently.
+	snapshotImportWorkers = 8
+	// snapshotImportBufferSize is the number of nodes buffered for each store import.
+	snapshotImportBufferSize = 4096
+)
+
+// parallelImports imports the nodes of snapshotted stores concurrently, up to
+// snapshotImportWorkers stores at a time.
+type parallelImports struct {
+	workers chan struct{}
+	wg      sync.WaitGroup
+
+	mtx    sync.Mutex
+	err    error
+	failed chan struct{}
+}
+
+func newParallelImports() *parallelImports {
+	return &parallelImports{
+		workers: make(chan struct{}, snapshotImportWorkers),
+		failed:  make(chan struct{}),
+	}
+}
+
+// acquire waits for a store import to be allowed to start, returning false if an import failed.
+func (p *parallelImports) acquire() bool {
+	select {
+	case p.workers <- struct{}{}:
+		p.wg.Add(1)
+		return true
+	case <-p.failed:
+		return false
+	}
+}
+
+// release ends a store import.
+func (p *parallelImports) release() {
+	<-p.workers
+	p.wg.Done()
+}
+
+// fail records the first import error, and signals it through the failed channel.
+func (p *parallelImports) fail(err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.err == nil {
+		p.err = err
+		close(p.failed)
+	}
+}
+
+// run imports the nodes received from the channel until it is closed, then commits the import
+// unless an import failed. The reader must stop sending nodes once an import failed.
+func (p *parallelImports) run(name string, importer *iavltree.Importer, nodes <-chan *iavltree.ExportNode) {
+	defer p.release()
+	defer importer.Close()
+
+	for node := range nodes {
+		if err := importer.Add(node); err != nil {
+			p.fail(errorsmod.Wrapf(err, "IAVL node import failed for store %q", name))
+			return
+		}
+	}
+
+	select {
+	case <-p.failed:
+		// another import failed, this one must not be committed
+		return
+	default:
+	}
+	if err := importer.Commit(); err != nil {
+		p.fail(errorsmod.Wrapf(err, "IAVL commit failed for store %q", name))
+	}
+}
+
+// wait waits for all the store imports to end, returning the first error.
+func (p *parallelImports) wait() error {
+	p.wg.Wait()
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.err
+}
+
+// restoreDelta restores a delta snapshot, written by SnapshotDelta, on top of the current state,
+// which must be at the base height of the snapshot. The hash of each store is checked against the
+// snapshot after applying the changes of each version.
+func (rs *Store) restoreDelta(height uint64, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	var (
+		store        *iavl.Store
+		storeName    string
+		changeSet    iavltree.ChangeSet
+		snapshotItem snapshottypes.SnapshotItem
+	)
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if len(changeSet.Pairs) > 0 {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "changes of store %q are not followed by a version item", storeName)
+			}
+			var ok bool
+			store, ok = rs.GetStoreByName(item.Store.Name).(*iavl.Store)
+			if !ok || store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot restore into non-IAVL store %q", item.Store.Name)
+			}
+			storeName = item.Store.Name
+			rs.logger.Debug("restoring delta snapshot", "store", storeName)
+
+		case *snapshottypes.SnapshotItem_Change:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received change item before store item")
+			}
+			pair := &iavltree.KVPair{
+				Key:    item.Change.Key,
+				Value:  item.Change.Value,
+				Delete: item.Change.Delete,
+			}
+			// Protobuf does not differentiate between []byte{} and nil, while IAVL does not allow
+			// nil keys nor nil values, so we can always set them to empty.
+			if pair.Key == nil {
+				pair.Key = []byte{}
+			}
+			if !pair.Delete && pair.Value == nil {
+				pair.Value = []byte{}
+			}
+			changeSet.Pairs = append(changeSet.Pairs, pair)
+
+		case *snapshottypes.SnapshotItem_Version:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received version item before store item")
+			}
+			if last := store.LastCommitID().Version; item.Version.Version != last+1 {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+					"cannot restore version %v of store %q at version %v", item.Version.Version, storeName, last)
+			}
+			commitID, err := store.SaveChangeSet(&changeSet)
 			if err != nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL node import failed")
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(err, "failed to apply changes of store %q", storeName)
+			}
+			if !bytes.Equal(commitID.Hash, item.Version.Hash) {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+					"hash mismatch of store %q at version %v: expected %X, got %X", storeName, commitID.Version, item.Version.Hash, commitID.Hash)
 			}
+			changeSet.Pairs = nil
 
 		default:
 			break loop
 		}
 	}
 
-	if importer != nil {
-		err := importer.Commit()
-		if err != nil {
-			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
+	if len(changeSet.Pairs) > 0 {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "changes of store %q are not followed by a version item", storeName)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	for _, store := range stores {
+		if version := store.LastCommitID().Version; version != int64(height) {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+				"store %q is at version %v after restoring delta snapshot at height %v", store.name, version, height)
 		}
-		importer.Close()
 	}
 
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
@@ -1242,6 +1542,21 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 	}
 }
 
+// getBlockTime returns the block time of a committed version, recorded in its commit info.
+func (rs *Store) getBlockTime(version int64) (time.Time, error) {
+	// the commit info of the version being committed is only flushed after pruning
+	if version == rs.lastCommitInfo.GetVersion() {
+		return rs.lastCommitInfo.Timestamp, nil
+	}
+
+	cInfo, err := rs.GetCommitInfo(version)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cInfo.Timestamp, nil
+}
+
 func flushCommitInfo(batch corestore.Batch, version int64, cInfo *types.CommitInfo) {
 	bz, err := cInfo.Marshal()
 	if err != nil {
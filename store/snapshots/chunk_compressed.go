@@ -0,0 +1,290 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"runtime"
+
+	"cosmossdk.io/errors"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// snapshotWorkers is the number of chunks compressed or decompressed concurrently.
+var snapshotWorkers = min(runtime.NumCPU(), 8)
+
+// compressedChunk is a chunk independently compressed by a Compressor, along with the hash of
+// its uncompressed content.
+type compressedChunk struct {
+	*bytes.Reader
+	compression string
+	contentHash []byte
+}
+
+// Close implements io.Closer.
+func (c *compressedChunk) Close() error {
+	return nil
+}
+
+// errChunk is a chunk whose reads fail with the given error, used to propagate errors to the
+// consumer of a chunk channel.
+type errChunk struct {
+	err error
+}
+
+func (c errChunk) Read([]byte) (int, error) {
+	return 0, c.err
+}
+
+func (c errChunk) Close() error {
+	return nil
+}
+
+// chunkResult is the result of the compression or decompression of a chunk.
+type chunkResult struct {
+	chunk []byte
+	hash  []byte
+	err   error
+}
+
+// CompressedChunkWriter splits an input stream into fixed-size chunks, compresses each one
+// independently and concurrently, and writes them in order to a channel. The SHA-256 hash of
+// the uncompressed content of each chunk is recorded along with it, see Store.Save.
+type CompressedChunkWriter struct {
+	ch         chan<- io.ReadCloser
+	compressor Compressor
+	chunkSize  int
+	buf        []byte
+	pending    chan chan chunkResult
+	done       chan struct{}
+	closed     bool
+}
+
+// NewCompressedChunkWriter creates a new CompressedChunkWriter. The chunks are written to the
+// channel, which is closed once the writer is closed.
+func NewCompressedChunkWriter(ch chan<- io.ReadCloser, compressor Compressor, chunkSize uint64) *CompressedChunkWriter {
+	w := &CompressedChunkWriter{
+		ch:         ch,
+		compressor: compressor,
+		chunkSize:  int(chunkSize),
+		pending:    make(chan chan chunkResult, snapshotWorkers),
+		done:       make(chan struct{}),
+	}
+	go w.forward()
+
+	return w
+}
+
+// forward writes the compressed chunks to the channel, in order.
+func (w *CompressedChunkWriter) forward() {
+	defer close(w.done)
+	defer close(w.ch)
+
+	var err error
+	for pending := range w.pending {
+		result := <-pending
+		if err != nil {
+			continue
+		}
+		if result.err != nil {
+			err = result.err
+			w.ch <- errChunk{err: err}
+			continue
+		}
+		w.ch <- &compressedChunk{
+			Reader:      bytes.NewReader(result.chunk),
+			compression: w.compressor.Name(),
+			contentHash: result.hash,
+		}
+	}
+}
+
+// flush compresses the buffered content as a new chunk.
+func (w *CompressedChunkWriter) flush() {
+	content := w.buf
+	w.buf = nil
+
+	pending := make(chan chunkResult, 1)
+	w.pending <- pending
+	go func() {
+		hash := sha256.Sum256(content)
+		var buf bytes.Buffer
+		zWriter, err := w.compressor.NewWriter(&buf)
+		if err == nil {
+			if _, err = zWriter.Write(content); err == nil {
+				err = zWriter.Close()
+			}
+		}
+		if err != nil {
+			pending <- chunkResult{err: errors.Wrapf(err, "%s compression failure", w.compressor.Name())}
+			return
+		}
+		pending <- chunkResult{chunk: buf.Bytes(), hash: hash[:]}
+	}()
+}
+
+// Write implements io.Writer.
+func (w *CompressedChunkWriter) Write(data []byte) (int, error) {
+	if w.closed {
+		return 0, errors.Wrap(storetypes.ErrLogic, "cannot write to closed CompressedChunkWriter")
+	}
+	nTotal := 0
+	for len(data) > 0 {
+		if w.buf == nil {
+			w.buf = make([]byte, 0, w.chunkSize)
+		}
+		n := min(w.chunkSize-len(w.buf), len(data))
+		w.buf = append(w.buf, data[:n]...)
+		nTotal += n
+		data = data[n:]
+		if len(w.buf) == w.chunkSize {
+			w.flush()
+		}
+	}
+	return nTotal, nil
+}
+
+// Close implements io.Closer. It waits for all the chunks to be written to the channel.
+func (w *CompressedChunkWriter) Close() error {
+	if !w.closed {
+		w.closed = true
+		if len(w.buf) > 0 {
+			w.flush()
+		}
+		close(w.pending)
+		<-w.done
+	}
+	return nil
+}
+
+// CloseWithError closes the writer and sends an error to the reader.
+func (w *CompressedChunkWriter) CloseWithError(err error) {
+	if !w.closed {
+		w.closed = true
+		pending := make(chan chunkResult, 1)
+		pending <- chunkResult{err: err}
+		w.pending <- pending
+		close(w.pending)
+		<-w.done
+	}
+}
+
+// CompressedChunkReader reads independently compressed chunks from a channel, decompresses them
+// concurrently, checks the hash of their content and outputs it in order as an io.Reader.
+type CompressedChunkReader struct {
+	pending <-chan chan chunkResult
+	quit    chan struct{}
+	reader  *bytes.Reader
+	err     error
+}
+
+// NewCompressedChunkReader creates a new CompressedChunkReader, expecting one chunk per content hash.
+func NewCompressedChunkReader(ch <-chan io.ReadCloser, compressor Compressor, contentHashes [][]byte) *CompressedChunkReader {
+	pending := make(chan chan chunkResult, snapshotWorkers)
+	quit := make(chan struct{})
+	go func() {
+		defer close(pending)
+
+		index := 0
+		for chunk := range ch {
+			select {
+			case <-quit:
+				_ = chunk.Close()
+				continue
+			default:
+			}
+
+			result := make(chan chunkResult, 1)
+			if index >= len(contentHashes) {
+				_ = chunk.Close()
+				result <- chunkResult{err: errors.Wrapf(snapshottypes.ErrInvalidMetadata, "unexpected chunk %d", index)}
+			} else {
+				go func(chunk io.ReadCloser, expected []byte) {
+					result <- decompressChunk(chunk, compressor, expected)
+				}(chunk, contentHashes[index])
+			}
+
+			select {
+			case pending <- result:
+			case <-quit:
+			}
+			index++
+		}
+
+		if index < len(contentHashes) {
+			result := make(chan chunkResult, 1)
+			result <- chunkResult{err: errors.Wrapf(snapshottypes.ErrInvalidMetadata, "expected %d chunks, got %d", len(contentHashes), index)}
+			select {
+			case pending <- result:
+			case <-quit:
+			}
+		}
+	}()
+
+	return &CompressedChunkReader{pending: pending, quit: quit}
+}
+
+// decompressChunk decompresses a chunk, checking the hash of its content.
+func decompressChunk(chunk io.ReadCloser, compressor Compressor, expected []byte) chunkResult {
+	defer chunk.Close()
+
+	zReader, err := compressor.NewReader(chunk)
+	if err != nil {
+		return chunkResult{err: errors.Wrapf(err, "%s decompression failure", compressor.Name())}
+	}
+	defer zReader.Close()
+
+	// chunks can't be larger than snapshotChunkSize once decompressed
+	content, err := io.ReadAll(io.LimitReader(zReader, int64(snapshotChunkSize)+1))
+	if err != nil {
+		return chunkResult{err: errors.Wrapf(err, "%s decompression failure", compressor.Name())}
+	}
+	if uint64(len(content)) > snapshotChunkSize {
+		return chunkResult{err: errors.Wrapf(storetypes.ErrLogic, "chunk content exceeds %d bytes", snapshotChunkSize)}
+	}
+
+	hash := sha256.Sum256(content)
+	if !bytes.Equal(hash[:], expected) {
+		return chunkResult{err: errors.Wrapf(snapshottypes.ErrChunkHashMismatch, "expected content hash %x, got %x", expected, hash)}
+	}
+
+	return chunkResult{chunk: content}
+}
+
+// Read implements io.Reader.
+func (r *CompressedChunkReader) Read(p []byte) (int, error) {
+	for r.reader == nil || r.reader.Len() == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		pending, ok := <-r.pending
+		if !ok {
+			r.err = io.EOF
+			continue
+		}
+		result := <-pending
+		if result.err != nil {
+			r.err = result.err
+			continue
+		}
+		r.reader = bytes.NewReader(result.chunk)
+	}
+
+	return r.reader.Read(p)
+}
+
+// Close implements io.Closer. The remaining chunks are closed without being decompressed.
+func (r *CompressedChunkReader) Close() error {
+	select {
+	case <-r.quit:
+	default:
+		close(r.quit)
+	}
+	for pending := range r.pending {
+		<-pending
+	}
+	r.reader = nil
+	return nil
+}
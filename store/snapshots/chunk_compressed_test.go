@@ -0,0 +1,146 @@
+package snapshots_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/snapshots"
+	"cosmossdk.io/store/snapshots/types"
+)
+
+func contentHashes(contents ...[]byte) [][]byte {
+	hashes := make([][]byte, len(contents))
+	for i, content := range contents {
+		hash := sha256.Sum256(content)
+		hashes[i] = hash[:]
+	}
+	return hashes
+}
+
+func TestCompressedChunks(t *testing.T) {
+	for _, name := range []string{snapshots.CompressionZlib, snapshots.CompressionZstd} {
+		t.Run(name, func(t *testing.T) {
+			compressor, err := snapshots.GetCompressor(name)
+			require.NoError(t, err)
+
+			ch := make(chan io.ReadCloser, 100)
+			go func() {
+				chunkWriter := snapshots.NewCompressedChunkWriter(ch, compressor, 4)
+				for _, data := range [][]byte{{1, 2, 3}, {4, 5, 6, 7, 8, 9}, {10}} {
+					n, err := chunkWriter.Write(data)
+					require.NoError(t, err)
+					assert.Equal(t, len(data), n)
+				}
+				require.NoError(t, chunkWriter.Close())
+
+				// closed writer should error
+				_, err := chunkWriter.Write([]byte{11})
+				require.Error(t, err)
+			}()
+			chunks := readChunks(ch)
+			require.Len(t, chunks, 3)
+
+			// each chunk is compressed independently
+			for i, expected := range [][]byte{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10}} {
+				zReader, err := compressor.NewReader(bytes.NewReader(chunks[i]))
+				require.NoError(t, err)
+				content, err := io.ReadAll(zReader)
+				require.NoError(t, err)
+				assert.Equal(t, expected, content)
+			}
+
+			hashes := contentHashes([]byte{1, 2, 3, 4}, []byte{5, 6, 7, 8}, []byte{9, 10})
+			chunkReader := snapshots.NewCompressedChunkReader(makeChunks(chunks), compressor, hashes)
+			content, err := io.ReadAll(chunkReader)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, content)
+			require.NoError(t, chunkReader.Close())
+
+			// a content hash mismatch should error
+			hashes[1] = hashes[0]
+			chunkReader = snapshots.NewCompressedChunkReader(makeChunks(chunks), compressor, hashes)
+			_, err = io.ReadAll(chunkReader)
+			require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+			require.NoError(t, chunkReader.Close())
+
+			// missing or unexpected chunks should error
+			chunkReader = snapshots.NewCompressedChunkReader(makeChunks(chunks[:2]), compressor, hashes)
+			_, err = io.ReadAll(chunkReader)
+			require.Error(t, err)
+			require.NoError(t, chunkReader.Close())
+
+			chunkReader = snapshots.NewCompressedChunkReader(makeChunks(chunks), compressor, hashes[:2])
+			_, err = io.ReadAll(chunkReader)
+			require.Error(t, err)
+			require.NoError(t, chunkReader.Close())
+
+			// closing before reading everything should be fine
+			chunkReader = snapshots.NewCompressedChunkReader(makeChunks(chunks), compressor, hashes)
+			require.NoError(t, chunkReader.Close())
+		})
+	}
+}
+
+func TestCompressedChunkWriter_CloseWithError(t *testing.T) {
+	compressor, err := snapshots.GetCompressor(snapshots.CompressionZstd)
+	require.NoError(t, err)
+
+	theErr := errors.New("boom")
+	ch := make(chan io.ReadCloser, 100)
+	go func() {
+		chunkWriter := snapshots.NewCompressedChunkWriter(ch, compressor, 2)
+		_, err := chunkWriter.Write([]byte{1, 2, 3})
+		require.NoError(t, err)
+		chunkWriter.CloseWithError(theErr)
+	}()
+
+	_, err = io.ReadAll(<-ch)
+	require.NoError(t, err)
+	_, err = io.ReadAll(<-ch)
+	require.Equal(t, theErr, err)
+	_, ok := <-ch
+	require.False(t, ok)
+}
+
+func TestCompressedStream(t *testing.T) {
+	compressor, err := snapshots.GetCompressor(snapshots.CompressionZstd)
+	require.NoError(t, err)
+	store := setupStore(t)
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := snapshots.NewCompressedStreamWriter(ch, compressor)
+		for _, payload := range [][]byte{{1, 2, 3}, {4, 5, 6}} {
+			require.NoError(t, types.WriteExtensionPayload(streamWriter, payload))
+		}
+		require.NoError(t, streamWriter.Close())
+	}()
+
+	// the compression and content hashes are recorded in the snapshot metadata
+	snapshot, err := store.Save(8, types.CurrentFormat, ch)
+	require.NoError(t, err)
+	require.Equal(t, snapshots.CompressionZstd, snapshot.Metadata.Compression)
+	require.Len(t, snapshot.Metadata.ContentHashes, int(snapshot.Chunks))
+
+	_, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	streamReader := snapshots.NewCompressedStreamReader(chunks, compressor, snapshot.Metadata.ContentHashes)
+	var payloads [][]byte
+	for {
+		var item types.SnapshotItem
+		err := streamReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		payloads = append(payloads, item.GetExtensionPayload().Payload)
+	}
+	require.NoError(t, streamReader.Close())
+	require.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}}, payloads)
+}
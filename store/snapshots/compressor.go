@@ -0,0 +1,96 @@
+package snapshots
+
+import (
+	"compress/zlib"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionZlib is the name of the zlib Compressor.
+	CompressionZlib = "zlib"
+	// CompressionZstd is the name of the zstd Compressor.
+	CompressionZstd = "zstd"
+)
+
+// Compressor compresses the chunks of snapshots. Chunks from different nodes must fit together,
+// so a given compressor must always produce the same output for the same input.
+type Compressor interface {
+	// Name returns the name of the compressor, recorded in the metadata of the snapshots
+	// it compressed. It must be unique.
+	Name() string
+
+	// NewWriter returns a writer compressing its input into w.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+
+	// NewReader returns a reader decompressing r.
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+var compressors = map[string]Compressor{}
+
+func init() {
+	RegisterCompressor(zlibCompressor{})
+	RegisterCompressor(zstdCompressor{})
+}
+
+// RegisterCompressor registers a compressor, which can then be used to take snapshots and
+// restore the snapshots it compressed. It panics if a compressor with the same name is
+// already registered, and must only be called at initialization time.
+func RegisterCompressor(compressor Compressor) {
+	name := compressor.Name()
+	if name == "" {
+		panic("compressor name cannot be empty")
+	}
+	if _, ok := compressors[name]; ok {
+		panic(fmt.Sprintf("compressor %s already registered", name))
+	}
+	compressors[name] = compressor
+}
+
+// GetCompressor returns the compressor registered with the given name.
+func GetCompressor(name string) (Compressor, error) {
+	compressor, ok := compressors[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown snapshot compressor %q", name)
+	}
+	return compressor, nil
+}
+
+// zlibCompressor compresses chunks with zlib.
+type zlibCompressor struct{}
+
+func (zlibCompressor) Name() string {
+	return CompressionZlib
+}
+
+func (zlibCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return zlib.NewWriterLevel(w, snapshotCompressionLevel)
+}
+
+func (zlibCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return zlib.NewReader(r)
+}
+
+// zstdCompressor compresses chunks with zstd, which is much faster than zlib for a similar
+// compression ratio.
+type zstdCompressor struct{}
+
+func (zstdCompressor) Name() string {
+	return CompressionZstd
+}
+
+func (zstdCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	// the output of a single-threaded encoder only depends on its input
+	return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedDefault), zstd.WithEncoderConcurrency(1))
+}
+
+func (zstdCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return decoder.IOReadCloser(), nil
+}
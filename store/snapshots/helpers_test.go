@@ -171,6 +171,38 @@ func (m *mockSnapshotter) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// mockDeltaSnapshotter is a mockSnapshotter which also takes delta snapshots, and records the
+// heights of the snapshots it restores.
+type mockDeltaSnapshotter struct {
+	mockSnapshotter
+	latest   int64
+	restored []uint64
+}
+
+var _ snapshottypes.DeltaSnapshotter = (*mockDeltaSnapshotter)(nil)
+
+func (m *mockDeltaSnapshotter) SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	return m.Snapshot(height, protoWriter)
+}
+
+func (m *mockDeltaSnapshotter) LatestVersion() int64 {
+	return m.latest
+}
+
+func (m *mockDeltaSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	m.items = nil
+	item, err := m.mockSnapshotter.Restore(height, format, protoReader)
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	m.latest = int64(height)
+	m.restored = append(m.restored, height)
+	return item, nil
+}
+
 type mockErrorSnapshotter struct{}
 
 var _ snapshottypes.Snapshotter = (*mockErrorSnapshotter)(nil)
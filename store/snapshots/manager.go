@@ -11,6 +11,8 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -74,13 +76,16 @@ func NewManager(store *Store, opts types.SnapshotOptions, multistore types.Snaps
 	if extensions == nil {
 		extensions = map[string]types.ExtensionSnapshotter{}
 	}
-	return &Manager{
+	m := &Manager{
 		store:      store,
 		opts:       opts,
 		multistore: multistore,
 		extensions: extensions,
 		logger:     logger,
 	}
+	m.retainSnapshotHeights()
+
+	return m
 }
 
 // RegisterExtensions register extension snapshotters to manager
@@ -180,19 +185,88 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if m.opts.Compression != "" {
+		if _, err := GetCompressor(m.opts.Compression); err != nil {
+			return nil, err
+		}
+	}
+
+	if base := m.deltaBase(latest); base != nil {
+		snapshot, err := m.createDelta(base, height)
+		if err == nil {
+			return snapshot, nil
+		}
+		m.logger.Error("failed to create delta snapshot, creating a full snapshot instead",
+			"height", height, "base", base.Height, "err", err)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, func(w protoio.Writer) error {
+		return m.multistore.Snapshot(height, w)
+	}, ch)
 
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// deltaBase returns the snapshot the next snapshot should be a delta of, or nil if a full
+// snapshot should be taken, i.e. if delta snapshots are disabled or if MaxDeltas delta
+// snapshots have been taken since the latest full snapshot.
+func (m *Manager) deltaBase(latest *types.Snapshot) *types.Snapshot {
+	if _, ok := m.multistore.(types.DeltaSnapshotter); !ok || m.opts.MaxDeltas == 0 || latest == nil {
+		return nil
+	}
+
+	deltas := uint32(0)
+	for snapshot := latest; snapshot.Format == types.DeltaFormat; {
+		deltas++
+		if deltas >= m.opts.MaxDeltas {
+			return nil
+		}
+
+		base, err := m.store.Get(snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		if err != nil || base == nil {
+			// the chain of snapshots is broken, start a new one
+			return nil
+		}
+		snapshot = base
+	}
+
+	return latest
+}
+
+// createDelta creates a delta snapshot of the changes made since the base snapshot, removing
+// its chunks on failure.
+func (m *Manager) createDelta(base *types.Snapshot, height uint64) (*types.Snapshot, error) {
+	multistore := m.multistore.(types.DeltaSnapshotter)
+
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshot(height, func(w protoio.Writer) error {
+		return multistore.SnapshotDelta(base.Height, height, w)
+	}, ch)
+
+	snapshot, err := m.store.SaveDelta(height, base, ch)
+	if err != nil {
+		_ = os.RemoveAll(m.store.pathSnapshot(height, types.DeltaFormat))
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
-	if streamWriter == nil {
-		return
+// the produced chunks are written to the channel. The multistore items are written by snapshot.
+func (m *Manager) createSnapshot(height uint64, snapshot func(protoio.Writer) error, ch chan<- io.ReadCloser) {
+	var streamWriter *StreamWriter
+	if m.opts.Compression == "" {
+		streamWriter = NewStreamWriter(ch)
+		if streamWriter == nil {
+			return
+		}
+	} else {
+		// the compressor is checked before the snapshot is started
+		compressor, _ := GetCompressor(m.opts.Compression)
+		streamWriter = NewCompressedStreamWriter(ch, compressor)
 	}
 	defer func() {
 		if err := streamWriter.Close(); err != nil {
@@ -200,7 +274,7 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	if err := snapshot(streamWriter); err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
@@ -270,11 +344,27 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 			uint32(len(snapshot.Metadata.ChunkHashes)),
 			snapshot.Chunks)
 	}
+	if snapshot.Metadata.Compression != "" {
+		if _, err := GetCompressor(snapshot.Metadata.Compression); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+		}
+		if uint32(len(snapshot.Metadata.ContentHashes)) != snapshot.Chunks {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "snapshot has %v content hashes, but %v chunks",
+				uint32(len(snapshot.Metadata.ContentHashes)),
+				snapshot.Chunks)
+		}
+	}
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	switch snapshot.Format {
+	case types.CurrentFormat:
+	case types.DeltaFormat:
+		if err := m.checkDeltaBase(snapshot); err != nil {
+			return err
+		}
+	default:
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -343,7 +433,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 	}
 
 	var nextItem types.SnapshotItem
-	streamReader, err := NewStreamReader(chChunks)
+	streamReader, err := newSnapshotStreamReader(snapshot, chChunks)
 	if err != nil {
 		return err
 	}
@@ -398,6 +488,21 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 	return nil
 }
 
+// newSnapshotStreamReader sets up the restore stream pipeline of the snapshot, depending on
+// how its chunks are compressed.
+func newSnapshotStreamReader(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) (*StreamReader, error) {
+	if snapshot.Metadata.Compression == "" {
+		return NewStreamReader(chChunks)
+	}
+
+	compressor, err := GetCompressor(snapshot.Metadata.Compression)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+
+	return NewCompressedStreamReader(chChunks, compressor, snapshot.Metadata.ContentHashes), nil
+}
+
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
 // Chunks must be given until the restore is complete, returning true, or a chunk errors.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
@@ -462,17 +567,30 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// checkDeltaBase returns an error if the delta snapshot cannot be restored on top of the current
+// state of the multistore, i.e. if the latest height isn't the base height of the snapshot.
+func (m *Manager) checkDeltaBase(snapshot types.Snapshot) error {
+	multistore, ok := m.multistore.(types.DeltaSnapshotter)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	if latest := multistore.LatestVersion(); latest < 0 || uint64(latest) != snapshot.Metadata.BaseHeight {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata,
+			"delta snapshot applies to height %v, but the latest height is %v", snapshot.Metadata.BaseHeight, latest)
+	}
+
+	return nil
+}
+
+// RestoreLocalSnapshot restores app state from a local snapshot. When restoring a delta
+// snapshot, the snapshots it applies to are restored first, up to a full snapshot or to a
+// snapshot at the latest height of the state.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	chain, err := m.snapshotChain(height, format)
 	if err != nil {
 		return err
 	}
 
-	if snapshot == nil {
-		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
-	}
-
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
@@ -482,7 +600,55 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	for _, s := range chain {
+		if s.Format == types.DeltaFormat {
+			if err := m.checkDeltaBase(*s); err != nil {
+				return err
+			}
+		}
+
+		snapshot, ch, err := m.store.Load(s.Height, s.Format)
+		if err != nil {
+			return err
+		}
+		if err := m.doRestoreSnapshot(*snapshot, ch); err != nil {
+			return errorsmod.Wrapf(err, "failed to restore snapshot at height %d, format %d", s.Height, s.Format)
+		}
+	}
+
+	return nil
+}
+
+// snapshotChain returns the snapshots to restore, in order, to restore the given snapshot.
+func (m *Manager) snapshotChain(height uint64, format uint32) ([]*types.Snapshot, error) {
+	snapshot, err := m.store.Get(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+	}
+
+	var latest int64 = -1
+	if multistore, ok := m.multistore.(types.DeltaSnapshotter); ok {
+		latest = multistore.LatestVersion()
+	}
+
+	chain := []*types.Snapshot{snapshot}
+	for snapshot.Format == types.DeltaFormat && int64(snapshot.Metadata.BaseHeight) != latest {
+		base, err := m.store.Get(snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			return nil, fmt.Errorf("base snapshot doesn't exist, height: %d, format: %d",
+				snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		}
+		snapshot = base
+		chain = append([]*types.Snapshot{snapshot}, chain...)
+	}
+
+	return chain, nil
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -540,6 +706,7 @@ func (m *Manager) snapshot(height int64) {
 	}
 
 	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+	defer m.retainSnapshotHeights()
 
 	if m.opts.KeepRecent > 0 {
 		m.logger.Debug("pruning state snapshots")
@@ -554,6 +721,27 @@ func (m *Manager) snapshot(height int64) {
 	}
 }
 
+// retainSnapshotHeights sets the heights of the snapshots in the snapshot store as the retained
+// snapshot heights of the multistore, if it is a RetainingSnapshotter.
+func (m *Manager) retainSnapshotHeights() {
+	multistore, ok := m.multistore.(types.RetainingSnapshotter)
+	if !ok {
+		return
+	}
+
+	snapshots, err := m.store.List()
+	if err != nil {
+		m.logger.Error("failed to list state snapshots", "err", err)
+		return
+	}
+
+	heights := make([]int64, len(snapshots))
+	for i, snapshot := range snapshots {
+		heights[i] = int64(snapshot.Height)
+	}
+	multistore.SetRetainedSnapshotHeights(heights)
+}
+
 // Close the snapshot database.
 func (m *Manager) Close() error {
 	return m.store.db.Close()
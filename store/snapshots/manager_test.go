@@ -115,6 +115,110 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_TakeDelta(t *testing.T) {
+	store, err := snapshots.NewStore(coretesting.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+	snapshotter := &mockDeltaSnapshotter{
+		mockSnapshotter: mockSnapshotter{
+			items:         [][]byte{{1, 2, 3}},
+			prunedHeights: make(map[int64]struct{}),
+		},
+	}
+	deltaOpts := types.NewSnapshotOptions(5, 0)
+	deltaOpts.MaxDeltas = 2
+	manager := snapshots.NewManager(store, deltaOpts, snapshotter, nil, log.NewNopLogger())
+
+	// two delta snapshots are taken after each full snapshot
+	expectFormats := []uint32{types.CurrentFormat, types.DeltaFormat, types.DeltaFormat, types.CurrentFormat, types.DeltaFormat}
+	var previous *types.Snapshot
+	for i, format := range expectFormats {
+		height := uint64(i+1) * 5
+		snapshot, err := manager.Create(height)
+		require.NoError(t, err)
+		require.Equal(t, format, snapshot.Format, "height %d", height)
+		if format == types.DeltaFormat {
+			require.Equal(t, previous.Height, snapshot.Metadata.BaseHeight)
+			require.Equal(t, previous.Format, snapshot.Metadata.BaseFormat)
+		} else {
+			require.Zero(t, snapshot.Metadata.BaseHeight)
+		}
+		previous = snapshot
+	}
+
+	// restoring a delta snapshot restores the snapshots it applies to first
+	target := &mockDeltaSnapshotter{
+		mockSnapshotter: mockSnapshotter{prunedHeights: make(map[int64]struct{})},
+	}
+	manager = snapshots.NewManager(store, deltaOpts, target, nil, log.NewNopLogger())
+	require.NoError(t, manager.RestoreLocalSnapshot(15, types.DeltaFormat))
+	require.Equal(t, []uint64{5, 10, 15}, target.restored)
+	require.Equal(t, [][]byte{{1, 2, 3}}, target.items)
+
+	// only the snapshots after the latest height are restored
+	target.restored = nil
+	target.latest = 20
+	require.NoError(t, manager.RestoreLocalSnapshot(25, types.DeltaFormat))
+	require.Equal(t, []uint64{25}, target.restored)
+
+	// a delta snapshot can't be offered if the state isn't at its base height
+	snapshot, err := store.Get(25, types.DeltaFormat)
+	require.NoError(t, err)
+	target.latest = 15
+	err = manager.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	target.latest = 20
+	require.NoError(t, manager.Restore(*snapshot))
+}
+
+func TestManager_TakeCompressed(t *testing.T) {
+	store, err := snapshots.NewStore(coretesting.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+	items := [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	snapshotter := &mockSnapshotter{
+		items:         items,
+		prunedHeights: make(map[int64]struct{}),
+	}
+	compressedOpts := types.NewSnapshotOptions(5, 0)
+	compressedOpts.Compression = snapshots.CompressionZstd
+	manager := snapshots.NewManager(store, compressedOpts, snapshotter, nil, log.NewNopLogger())
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, snapshots.CompressionZstd, snapshot.Metadata.Compression)
+	require.Len(t, snapshot.Metadata.ContentHashes, int(snapshot.Chunks))
+	require.Len(t, snapshot.Metadata.ChunkHashes, int(snapshot.Chunks))
+
+	// the snapshot can be restored from its chunks
+	source := manager
+	target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+	manager = snapshots.NewManager(setupStore(t), opts, target, nil, log.NewNopLogger())
+
+	// an unknown compression or a content hash per chunk mismatch should error
+	invalid := *snapshot
+	invalid.Metadata.Compression = "lz4"
+	require.ErrorIs(t, manager.Restore(invalid), types.ErrInvalidMetadata)
+	invalid = *snapshot
+	invalid.Metadata.ContentHashes = append(invalid.Metadata.ContentHashes, []byte{1})
+	require.ErrorIs(t, manager.Restore(invalid), types.ErrInvalidMetadata)
+
+	require.NoError(t, manager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := source.LoadChunk(5, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	require.Equal(t, items, target.items)
+
+	// taking snapshots with an unknown compression should error
+	compressedOpts.Compression = "lz4"
+	manager = snapshots.NewManager(store, compressedOpts, snapshotter, nil, log.NewNopLogger())
+	_, err = manager.Create(10)
+	require.Error(t, err)
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	snapshotter := &mockSnapshotter{}
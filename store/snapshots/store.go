@@ -188,7 +188,8 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// along with the snapshots the retained delta snapshots apply to, directly or not.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
 	if err != nil {
@@ -199,6 +200,9 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	// bases are the heights of the snapshots retained delta snapshots apply to, which are
+	// always lower than theirs, so they are reached later during the reverse iteration.
+	bases := make(map[uint64]bool)
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
@@ -206,6 +210,15 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 		}
 		if skip[height] || uint32(len(skip)) < retain {
 			skip[height] = true
+		}
+		if skip[height] || bases[height] {
+			if format == types.DeltaFormat {
+				snapshot := &types.Snapshot{}
+				if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+					return 0, errors.Wrap(err, "failed to decode snapshot info")
+				}
+				bases[snapshot.Metadata.BaseHeight] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
@@ -232,7 +245,67 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{Height: height, Format: format}, chunks)
+}
+
+// SaveDelta saves a delta snapshot applying to the given base snapshot to disk, returning it.
+func (s *Store) SaveDelta(
+	height uint64, base *types.Snapshot, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if base.Height >= height {
+		DrainChunks(chunks)
+		return nil, errors.Wrapf(storetypes.ErrLogic,
+			"delta snapshot height %v must be greater than its base height %v", height, base.Height)
+	}
+
+	return s.save(&types.Snapshot{
+		Height: height,
+		Format: types.DeltaFormat,
+		Metadata: types.Metadata{
+			BaseHeight: base.Height,
+			BaseFormat: base.Format,
+		},
+	}, chunks)
+}
+
+// Import saves a snapshot taken by another node to disk, keeping its metadata. The chunks must
+// match the hash and chunk hashes of the snapshot, otherwise nothing is saved.
+func (s *Store) Import(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
+	exists, err := s.db.Has(encodeKey(snapshot.Height, snapshot.Format))
+	if err != nil {
+		DrainChunks(chunks)
+		return nil, err
+	}
+	if exists {
+		DrainChunks(chunks)
+		return nil, errors.Wrapf(storetypes.ErrConflict,
+			"snapshot already exists for height %v format %v", snapshot.Height, snapshot.Format)
+	}
+
+	imported := &types.Snapshot{
+		Height:   snapshot.Height,
+		Format:   snapshot.Format,
+		Metadata: snapshot.Metadata,
+	}
+	imported.Metadata.ChunkHashes = nil
+	if _, err := s.save(imported, chunks); err != nil {
+		_ = s.Delete(snapshot.Height, snapshot.Format)
+		return nil, err
+	}
+
+	if !proto.Equal(imported, snapshot) {
+		_ = s.Delete(snapshot.Height, snapshot.Format)
+		return nil, errors.Wrapf(types.ErrChunkHashMismatch,
+			"imported snapshot for height %v format %v does not match its hashes", snapshot.Height, snapshot.Format)
+	}
+
+	return imported, nil
+}
+
+// save saves the chunks of the given snapshot to disk, completing its metadata.
+func (s *Store) save(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
+	height, format := snapshot.Height, snapshot.Format
 	if height == 0 {
 		return nil, errors.Wrap(storetypes.ErrLogic, "snapshot height cannot be 0")
 	}
@@ -260,11 +333,6 @@ func (s *Store) Save(
 			"snapshot already exists for height %v format %v", height, format)
 	}
 
-	snapshot := &types.Snapshot{
-		Height: height,
-		Format: format,
-	}
-
 	dirCreated := false
 	index := uint32(0)
 	snapshotHasher := sha256.New()
@@ -293,7 +361,8 @@ func (s *Store) Save(
 }
 
 // saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
-// The hash of the chunk is appended to the snapshot's metadata,
+// The hash of the chunk is appended to the snapshot's metadata, along with the hash of its
+// uncompressed content for independently compressed chunks,
 // and the overall snapshot hash is updated with the chunk content too.
 func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types.Snapshot, chunkHasher, snapshotHasher hash.Hash) error {
 	defer chunkBody.Close()
@@ -319,6 +388,10 @@ func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types
 	}
 
 	snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
+	if chunk, ok := chunkBody.(*compressedChunk); ok {
+		snapshot.Metadata.Compression = chunk.compression
+		snapshot.Metadata.ContentHashes = append(snapshot.Metadata.ContentHashes, chunk.contentHash)
+	}
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -262,6 +263,44 @@ func TestStore_Prune(t *testing.T) {
 	assert.Empty(t, snapshots)
 }
 
+func TestStore_PruneDeltas(t *testing.T) {
+	store, err := snapshots.NewStore(coretesting.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+
+	_, err = store.Save(1, types.CurrentFormat, makeChunks([][]byte{{1}}))
+	require.NoError(t, err)
+	full, err := store.Save(2, types.CurrentFormat, makeChunks([][]byte{{2}}))
+	require.NoError(t, err)
+	delta, err := store.SaveDelta(3, full, makeChunks([][]byte{{3}}))
+	require.NoError(t, err)
+	require.Equal(t, types.DeltaFormat, delta.Format)
+	require.Equal(t, types.Metadata{
+		ChunkHashes: checksums([][]byte{{3}}),
+		BaseHeight:  2,
+		BaseFormat:  types.CurrentFormat,
+	}, delta.Metadata)
+	_, err = store.SaveDelta(4, delta, makeChunks([][]byte{{4}}))
+	require.NoError(t, err)
+
+	// a delta snapshot must be taken after its base
+	_, err = store.SaveDelta(4, delta, makeChunks([][]byte{{4}}))
+	require.Error(t, err)
+	_, err = store.SaveDelta(2, delta, makeChunks([][]byte{{2}}))
+	require.Error(t, err)
+
+	// the snapshots the retained delta snapshots apply to are retained too
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	for i, height := range []uint64{4, 3, 2} {
+		require.Equal(t, height, snapshots[i].Height)
+	}
+}
+
 func TestStore_Save(t *testing.T) {
 	store := setupStore(t)
 	// Saving a snapshot should work
@@ -331,3 +370,34 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_Import(t *testing.T) {
+	source := setupStore(t)
+	snapshot, err := source.SaveDelta(8, &types.Snapshot{Height: 4, Format: 1}, makeChunks([][]byte{{1}, {2}}))
+	require.NoError(t, err)
+
+	// importing a snapshot keeps its metadata
+	store := setupStore(t)
+	imported, err := store.Import(snapshot, makeChunks([][]byte{{1}, {2}}))
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, imported)
+	loaded, err := store.Get(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+
+	// importing an existing snapshot should error, and keep it
+	_, err = store.Import(snapshot, makeChunks([][]byte{{1}, {2}}))
+	require.Error(t, err)
+	loaded, err = store.Get(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+
+	// importing chunks not matching the snapshot hashes should error, and save nothing
+	require.NoError(t, store.Delete(snapshot.Height, snapshot.Format))
+	_, err = store.Import(snapshot, makeChunks([][]byte{{1}, {3}}))
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+	loaded, err = store.Get(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+	assert.NoDirExists(t, filepath.Dir(store.PathChunk(snapshot.Height, snapshot.Format, 0)))
+}
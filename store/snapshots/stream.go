@@ -19,12 +19,17 @@ const (
 	snapshotCompressionLevel = 7
 )
 
+// chunkWriter is a writer splitting its input into chunks.
+type chunkWriter interface {
+	io.WriteCloser
+	CloseWithError(err error)
+}
+
 // StreamWriter set up a stream pipeline to serialize snapshot nodes:
 // Exported Items -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
 type StreamWriter struct {
-	chunkWriter *ChunkWriter
+	chunkWriter chunkWriter
 	bufWriter   *bufio.Writer
-	zWriter     *zlib.Writer
 	protoWriter protoio.WriteCloser
 }
 
@@ -41,11 +46,23 @@ func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
 	return &StreamWriter{
 		chunkWriter: chunkWriter,
 		bufWriter:   bufWriter,
-		zWriter:     zWriter,
 		protoWriter: protoWriter,
 	}
 }
 
+// NewCompressedStreamWriter set up a stream pipeline to serialize snapshot DB records into
+// chunks independently compressed by the given compressor:
+// Exported Items -> delimited Protobuf -> buffer -> CompressedChunkWriter -> chan io.ReadCloser
+func NewCompressedStreamWriter(ch chan<- io.ReadCloser, compressor Compressor) *StreamWriter {
+	chunkWriter := NewCompressedChunkWriter(ch, compressor, snapshotChunkSize)
+	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+	return &StreamWriter{
+		chunkWriter: chunkWriter,
+		bufWriter:   bufWriter,
+		protoWriter: protoio.NewDelimitedWriter(bufWriter),
+	}
+}
+
 // WriteMsg implements protoio.Write interface
 func (sw *StreamWriter) WriteMsg(msg proto.Message) error {
 	return sw.protoWriter.WriteMsg(msg)
@@ -72,7 +89,7 @@ func (sw *StreamWriter) CloseWithError(err error) {
 // StreamReader set up a restore stream pipeline
 // chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
 type StreamReader struct {
-	chunkReader *ChunkReader
+	chunkReader io.ReadCloser
 	zReader     io.ReadCloser
 	protoReader protoio.ReadCloser
 }
@@ -92,6 +109,17 @@ func NewStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
 	}, nil
 }
 
+// NewCompressedStreamReader set up a restore stream pipeline for chunks independently
+// compressed by the given compressor, whose content hashes are checked.
+// chan io.ReadCloser -> CompressedChunkReader -> delimited Protobuf -> ExportNode
+func NewCompressedStreamReader(chunks <-chan io.ReadCloser, compressor Compressor, contentHashes [][]byte) *StreamReader {
+	chunkReader := NewCompressedChunkReader(chunks, compressor, contentHashes)
+	return &StreamReader{
+		chunkReader: chunkReader,
+		protoReader: protoio.NewDelimitedReader(chunkReader, snapshotMaxItemSize),
+	}
+}
+
 // ReadMsg implements protoio.Reader interface
 func (sr *StreamReader) ReadMsg(msg proto.Message) error {
 	return sr.protoReader.ReadMsg(msg)
@@ -103,8 +131,10 @@ func (sr *StreamReader) Close() error {
 	if err1 := sr.protoReader.Close(); err1 != nil {
 		err = err1
 	}
-	if err2 := sr.zReader.Close(); err2 != nil {
-		err = err2
+	if sr.zReader != nil {
+		if err2 := sr.zReader.Close(); err2 != nil {
+			err = err2
+		}
 	}
 	if err3 := sr.chunkReader.Close(); err3 != nil {
 		err = err3
@@ -4,3 +4,8 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// DeltaFormat is the format of delta snapshots, which only contain the changes made to the
+// state since the snapshot at Metadata.BaseHeight. Restoring a delta snapshot requires the
+// state at the base height, e.g. by restoring the base snapshot first.
+const DeltaFormat uint32 = 4
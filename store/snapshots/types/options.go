@@ -8,6 +8,16 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// MaxDeltas defines how many delta snapshots are taken in a row, each one
+	// containing the changes since the previous snapshot, before a full snapshot
+	// is taken again. 0 disables delta snapshots.
+	MaxDeltas uint32
+
+	// Compression is the name of the compressor each snapshot chunk is independently
+	// compressed with, e.g. "zstd". If empty, the chunks are split from a single
+	// zlib stream, which is the only encoding supported by older nodes.
+	Compression string
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
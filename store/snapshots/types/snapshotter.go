@@ -26,6 +26,30 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// RetainingSnapshotter is a Snapshotter which can keep the state of the snapshots retained in
+// the snapshot store from being pruned.
+type RetainingSnapshotter interface {
+	Snapshotter
+
+	// SetRetainedSnapshotHeights sets the heights of the snapshots retained in the snapshot store.
+	// It is called when the snapshot manager is created and after each snapshot is taken.
+	SetRetainedSnapshotHeights(heights []int64)
+}
+
+// DeltaSnapshotter is a Snapshotter which can also create delta snapshots, containing only the
+// changes made to the state since a base height. Its Restore method must support DeltaFormat.
+type DeltaSnapshotter interface {
+	Snapshotter
+
+	// SnapshotDelta writes the snapshot items of the changes made after baseHeight up to height
+	// into the protobuf writer.
+	SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error
+
+	// LatestVersion returns the latest height of the state, which a restored delta snapshot
+	// must apply to.
+	LatestVersion() int64
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
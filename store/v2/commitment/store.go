@@ -43,6 +43,9 @@ type CommitStore struct {
 	// oldTrees is a map of store keys to old trees that have been deleted or renamed.
 	// It is used to get the proof for the old store keys.
 	oldTrees map[string]Tree
+	// retainCommitInfo reflects whether the commit infos of the pruned versions are kept,
+	// so that the roots of the pruned versions can still be proven against.
+	retainCommitInfo bool
 }
 
 // NewCommitStore creates a new CommitStore instance.
@@ -55,6 +58,11 @@ func NewCommitStore(trees, oldTrees map[string]Tree, db corestore.KVStoreWithBat
 	}, nil
 }
 
+// SetRetainCommitInfo sets whether the commit infos are kept when the trees are pruned.
+func (c *CommitStore) SetRetainCommitInfo(retain bool) {
+	c.retainCommitInfo = retain
+}
+
 func (c *CommitStore) WriteChangeset(cs *corestore.Changeset) error {
 	for _, pairs := range cs.Changes {
 		key := conv.UnsafeBytesToStr(pairs.Actor)
@@ -292,10 +300,12 @@ func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte,
 
 // Prune implements store.Pruner.
 func (c *CommitStore) Prune(version uint64) error {
-	// prune the metadata
-	for v := version; v > 0; v-- {
-		if err := c.metadata.deleteCommitInfo(v); err != nil {
-			return err
+	// prune the metadata, unless the commit infos are retained
+	if !c.retainCommitInfo {
+		for v := version; v > 0; v-- {
+			if err := c.metadata.deleteCommitInfo(v); err != nil {
+				return err
+			}
 		}
 	}
 	// prune the trees
@@ -403,6 +413,7 @@ func (c *CommitStore) Restore(
 		importer     Importer
 		snapshotItem snapshotstypes.SnapshotItem
 		storeKey     []byte
+		skipStore    bool
 	)
 
 loop:
@@ -431,6 +442,19 @@ loop:
 			if tree == nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("store %s not found", item.Store.Name)
 			}
+			// the tree may already be restored by an interrupted restore, in which
+			// case its nodes are skipped, while its leaves are still written to the
+			// storage
+			latestVersion, err := tree.GetLatestVersion()
+			if err != nil {
+				return snapshotstypes.SnapshotItem{}, err
+			}
+			if latestVersion == version {
+				importer = nil
+				skipStore = true
+				continue
+			}
+			skipStore = false
 			importer, err = tree.Import(version)
 			if err != nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to import tree for version %d: %w", version, err)
@@ -438,7 +462,7 @@ loop:
 			defer importer.Close()
 
 		case *snapshotstypes.SnapshotItem_IAVL:
-			if importer == nil {
+			if importer == nil && !skipStore {
 				return snapshotstypes.SnapshotItem{}, errors.New("received IAVL node item before store item")
 			}
 			node := item.IAVL
@@ -467,6 +491,9 @@ loop:
 					},
 				}
 			}
+			if skipStore {
+				continue
+			}
 			err := importer.Add(node)
 			if err != nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to add node to importer: %w", err)
@@ -43,6 +43,38 @@ type UpgradableDatabase interface {
 	PruneStoreKeys(storeKeys []string, version uint64) error
 }
 
+// VersionIterator iterates over the versions at which a single key was written,
+// in ascending order.
+type VersionIterator interface {
+	// Valid returns whether the iterator is positioned at a version.
+	Valid() bool
+
+	// Next moves the iterator to the next version.
+	Next()
+
+	// Version returns the version at which the key was written.
+	Version() uint64
+
+	// Value returns the value written at the version, or nil if the key was
+	// deleted at the version.
+	Value() []byte
+
+	// Error returns the last error encountered by the iterator, if any.
+	Error() error
+
+	// Close releases associated resources.
+	Close() error
+}
+
+// HistoryReader defines an API for reading the history of a single key.
+type HistoryReader interface {
+	// VersionIterator returns an iterator over the versions in [start, end] at
+	// which the key was written in the given store. It must return ErrVersionPruned
+	// if start is lower than the earliest version, since the history below it is
+	// incomplete.
+	VersionIterator(storeKey, key []byte, start, end uint64) (VersionIterator, error)
+}
+
 // Committer defines an API for committing state.
 type Committer interface {
 	// WriteChangeset writes the changeset to the commitment state.
@@ -33,6 +33,9 @@ var (
 	ErrKeyEmpty        = errors.New("key empty")
 	ErrStartAfterEnd   = errors.New("start key after end key")
 
+	// ErrStartVersionAfterEnd is returned when a version range starts after its end.
+	ErrStartVersionAfterEnd = errors.New("start version after end version")
+
 	// ErrBatchClosed is returned when a closed or written batch is used.
 	ErrBatchClosed = errors.New("batch has been written or closed")
 
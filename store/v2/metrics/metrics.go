@@ -12,6 +12,8 @@ var _ StoreMetrics = Metrics{}
 // StoreMetrics defines the set of supported metric APIs for the store package.
 type StoreMetrics interface {
 	MeasureSince(start time.Time, keys ...string)
+	IncrCounter(val float32, keys ...string)
+	SetGauge(val float32, keys ...string)
 }
 
 // Metrics defines a default StoreMetrics implementation.
@@ -45,3 +47,15 @@ func NewMetrics(labels [][]string) (Metrics, error) {
 func (m Metrics) MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.Labels)
 }
+
+// IncrCounter provides a wrapper functionality for emitting a counter metric with
+// global labels (if any).
+func (m Metrics) IncrCounter(val float32, keys ...string) {
+	metrics.IncrCounterWithLabels(keys, val, m.Labels)
+}
+
+// SetGauge provides a wrapper functionality for emitting a gauge metric with
+// global labels (if any).
+func (m Metrics) SetGauge(val float32, keys ...string) {
+	metrics.SetGaugeWithLabels(keys, val, m.Labels)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -14,6 +15,7 @@ import (
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/internal/encoding"
+	"cosmossdk.io/store/v2/metrics"
 	"cosmossdk.io/store/v2/snapshots"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 	"cosmossdk.io/store/v2/storage"
@@ -25,7 +27,11 @@ const (
 	// defaultStorageBufferSize is the default buffer size for the storage snapshotter.
 	defaultStorageBufferSize = 1024
 
+	// progressLogInterval is the interval at which the progress of the migration is logged.
+	progressLogInterval = 10 * time.Second
+
 	migrateChangesetKeyFmt = "m/cs_%x" // m/cs_<version>
+	migratedVersionKey     = "m/migrated_version"
 )
 
 // VersionedChangeset is a pair of version and Changeset.
@@ -46,6 +52,11 @@ type Manager struct {
 	mtx             sync.Mutex // mutex for migratedVersion
 	migratedVersion uint64
 
+	// telemetry reflects a telemetry agent responsible for emitting metrics (if any)
+	telemetry metrics.StoreMetrics
+	// restoredKeys reflects the number of keys restored by Migrate
+	restoredKeys atomic.Uint64
+
 	chChangeset <-chan *VersionedChangeset
 	chDone      <-chan struct{}
 }
@@ -63,13 +74,37 @@ func NewManager(db corestore.KVStoreWithBatch, sm *snapshots.Manager, ss *storag
 	}
 }
 
+// SetMetrics sets the telemetry handler reporting the progress of the migration.
+func (m *Manager) SetMetrics(telemetry metrics.StoreMetrics) {
+	m.telemetry = telemetry
+}
+
 // Start starts the whole migration process.
 // It migrates the whole state at the given version to the new store/v2 (both SC and SS).
 // It also catches up the Changesets which are committed while the migration is in progress.
 // `chChangeset` is the channel to receive the committed Changesets from the RootStore.
 // `chDone` is the channel to receive the done signal from the RootStore.
+// If a previous run of the migration was interrupted after migrating the whole state,
+// it resumes catching up the Changesets from the persisted migrated version.
 // NOTE: It should be called by the RootStore, running in the background.
 func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset, chDone <-chan struct{}) error {
+	migratedVersion, err := m.LoadMigratedVersion()
+	if err != nil {
+		return err
+	}
+	if migratedVersion > 0 {
+		// the Changesets committed before the restart must have been stored to resume
+		for v := migratedVersion + 1; v <= version; v++ {
+			csBytes, err := m.db.Get(changesetKey(v))
+			if err != nil {
+				return fmt.Errorf("failed to get changeset from db: %w", err)
+			}
+			if csBytes == nil {
+				return fmt.Errorf("cannot resume the migration from version %d, the changeset of version %d is missing", migratedVersion, v)
+			}
+		}
+	}
+
 	m.chChangeset = chChangeset
 	m.chDone = chDone
 
@@ -79,13 +114,80 @@ func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset,
 		}
 	}()
 
-	if err := m.Migrate(version); err != nil {
-		return fmt.Errorf("failed to migrate state: %w", err)
+	if migratedVersion == 0 {
+		if err := m.Migrate(version); err != nil {
+			return fmt.Errorf("failed to migrate state: %w", err)
+		}
+	} else {
+		m.logger.Info("resuming migration", "migrated_version", migratedVersion, "version", version)
+		if m.stateCommitment != nil {
+			if err := m.stateCommitment.LoadVersion(migratedVersion); err != nil {
+				return fmt.Errorf("failed to load migrated version %d: %w", migratedVersion, err)
+			}
+		}
 	}
 
 	return m.Sync()
 }
 
+// LoadMigratedVersion loads the version migrated by a previous run of the migration,
+// which is 0 if the whole state has not been migrated yet.
+func (m *Manager) LoadMigratedVersion() (uint64, error) {
+	bz, err := m.db.Get([]byte(migratedVersionKey))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get migrated version from db: %w", err)
+	}
+	if bz == nil {
+		return 0, nil
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid migrated version %X", bz)
+	}
+
+	version := binary.BigEndian.Uint64(bz)
+	m.mtx.Lock()
+	m.migratedVersion = version
+	m.mtx.Unlock()
+
+	return version, nil
+}
+
+// setMigratedVersion persists the migrated version, deleting the changeset of the
+// version if it was caught up.
+func (m *Manager) setMigratedVersion(version uint64, csKey []byte) (err error) {
+	batch := m.db.NewBatch()
+	defer func() {
+		err = errors.Join(err, batch.Close())
+	}()
+
+	if err := batch.Set([]byte(migratedVersionKey), binary.BigEndian.AppendUint64(nil, version)); err != nil {
+		return fmt.Errorf("failed to write migrated version to db.Batch: %w", err)
+	}
+	if csKey != nil {
+		if err := batch.Delete(csKey); err != nil {
+			return fmt.Errorf("failed to delete changeset from db.Batch: %w", err)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to write migrated version to db: %w", err)
+	}
+
+	m.mtx.Lock()
+	m.migratedVersion = version
+	m.mtx.Unlock()
+
+	if m.telemetry != nil {
+		m.telemetry.SetGauge(float32(version), "migration", "migrated_version")
+	}
+
+	return nil
+}
+
+// RestoredKeys returns the number of keys restored by Migrate.
+func (m *Manager) RestoredKeys() uint64 {
+	return m.restoredKeys.Load()
+}
+
 // GetStateCommitment returns the state commitment.
 func (m *Manager) GetStateCommitment() *commitment.CommitStore {
 	return m.stateCommitment
@@ -101,12 +203,21 @@ func (m *Manager) Migrate(height uint64) error {
 		return err
 	}
 
+	start := time.Now()
+	m.logger.Info("migrating state", "height", height)
+
 	// restore the snapshot
 	chStorage := make(chan *corestore.StateChanges, defaultStorageBufferSize)
+	chRestore := make(chan *corestore.StateChanges, defaultStorageBufferSize)
 
 	eg := new(errgroup.Group)
 	eg.Go(func() error {
-		return m.stateStorage.Restore(height, chStorage)
+		return m.stateStorage.Restore(height, chRestore)
+	})
+	eg.Go(func() error {
+		defer close(chRestore)
+		m.trackProgress(start, chStorage, chRestore)
+		return nil
 	})
 	eg.Go(func() error {
 		defer close(chStorage)
@@ -161,20 +272,42 @@ func (m *Manager) Migrate(height uint64) error {
 		return err
 	}
 
-	m.mtx.Lock()
-	m.migratedVersion = height
-	m.mtx.Unlock()
+	if err := m.setMigratedVersion(height, nil); err != nil {
+		return err
+	}
+
+	if m.telemetry != nil {
+		m.telemetry.MeasureSince(start, "migration", "migrate")
+	}
+	m.logger.Info("migrated state", "height", height, "keys", m.RestoredKeys(), "duration", time.Since(start))
 
 	return nil
 }
 
+// trackProgress forwards the restored state changes to the state storage, counting
+// the restored keys and logging the throughput of the migration.
+func (m *Manager) trackProgress(start time.Time, chStorage <-chan *corestore.StateChanges, chRestore chan<- *corestore.StateChanges) {
+	lastLog := start
+	for changes := range chStorage {
+		chRestore <- changes
+
+		count := uint64(len(changes.StateChanges))
+		keys := m.restoredKeys.Add(count)
+		if m.telemetry != nil {
+			m.telemetry.IncrCounter(float32(count), "migration", "restored_keys")
+		}
+		if now := time.Now(); now.Sub(lastLog) >= progressLogInterval {
+			lastLog = now
+			m.logger.Info("migrating state", "keys", keys, "keys_per_second", float64(keys)/now.Sub(start).Seconds())
+		}
+	}
+}
+
 // writeChangeset writes the Changeset to the db.
 func (m *Manager) writeChangeset() error {
 	for vc := range m.chChangeset {
 		cs := vc.Changeset
-		buf := make([]byte, 8)
-		binary.BigEndian.PutUint64(buf, vc.Version)
-		csKey := []byte(fmt.Sprintf(migrateChangesetKeyFmt, buf))
+		csKey := changesetKey(vc.Version)
 		csBytes, err := encoding.MarshalChangeset(cs)
 		if err != nil {
 			return fmt.Errorf("failed to marshal changeset: %w", err)
@@ -204,6 +337,11 @@ func (m *Manager) writeChangeset() error {
 	return nil
 }
 
+// changesetKey returns the key of the changeset of the given version.
+func changesetKey(version uint64) []byte {
+	return []byte(fmt.Sprintf(migrateChangesetKeyFmt, binary.BigEndian.AppendUint64(nil, version)))
+}
+
 // GetMigratedVersion returns the migrated version.
 // It is used to check the migrated version in the RootStore.
 func (m *Manager) GetMigratedVersion() uint64 {
@@ -226,9 +364,7 @@ func (m *Manager) Sync() error {
 		case <-m.chDone:
 			return nil
 		default:
-			buf := make([]byte, 8)
-			binary.BigEndian.PutUint64(buf, version)
-			csKey := []byte(fmt.Sprintf(migrateChangesetKeyFmt, buf))
+			csKey := changesetKey(version)
 			csBytes, err := m.db.Get(csKey)
 			if err != nil {
 				return fmt.Errorf("failed to get changeset from db: %w", err)
@@ -239,6 +375,7 @@ func (m *Manager) Sync() error {
 				continue
 			}
 
+			start := time.Now()
 			cs := corestore.NewChangeset()
 			if err := encoding.UnmarshalChangeset(cs, csBytes); err != nil {
 				return fmt.Errorf("failed to unmarshal changeset: %w", err)
@@ -255,9 +392,12 @@ func (m *Manager) Sync() error {
 				return fmt.Errorf("failed to write changeset to storage: %w", err)
 			}
 
-			m.mtx.Lock()
-			m.migratedVersion = version
-			m.mtx.Unlock()
+			if err := m.setMigratedVersion(version, csKey); err != nil {
+				return err
+			}
+			if m.telemetry != nil {
+				m.telemetry.MeasureSince(start, "migration", "sync")
+			}
 
 			version += 1
 		}
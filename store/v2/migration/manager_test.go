@@ -218,3 +218,51 @@ func TestStartMigrateState(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateResume(t *testing.T) {
+	m, orgCommitStore := setupMigrationManager(t, false)
+
+	toVersion := uint64(10)
+	for version := uint64(1); version <= toVersion; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d", version)), []byte(fmt.Sprintf("value-%d", version)), false)
+		}
+		require.NoError(t, orgCommitStore.WriteChangeset(cs))
+		_, err := orgCommitStore.Commit(version)
+		require.NoError(t, err)
+	}
+
+	migratedVersion, err := m.LoadMigratedVersion()
+	require.NoError(t, err)
+	require.Zero(t, migratedVersion)
+
+	require.NoError(t, m.Migrate(toVersion-1))
+	require.Equal(t, uint64(len(storeKeys))*(toVersion-1), m.RestoredKeys())
+
+	// the migrated version is persisted
+	m1 := NewManager(m.db, m.snapshotsManager, m.stateStorage, m.stateCommitment, coretesting.NewNopLogger())
+	migratedVersion, err = m1.LoadMigratedVersion()
+	require.NoError(t, err)
+	require.Equal(t, toVersion-1, migratedVersion)
+	require.Equal(t, toVersion-1, m1.GetMigratedVersion())
+
+	// resuming requires the changesets committed since the migrated version
+	err = m1.Start(toVersion+1, make(chan *VersionedChangeset), make(chan struct{}))
+	require.ErrorContains(t, err, "the changeset of version 10 is missing")
+
+	// migrating again skips the trees already restored, and restores the storage
+	storageDB, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+	m.stateStorage = storage.NewStorageStore(storageDB, coretesting.NewNopLogger())
+	m.snapshotsManager.EndMigration(orgCommitStore)
+	require.NoError(t, m.Migrate(toVersion-1))
+	for _, storeKey := range storeKeys {
+		val, err := m.stateStorage.Get([]byte(storeKey), toVersion-1, []byte("key-9"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-9"), val)
+		val, err = m.stateCommitment.Get([]byte(storeKey), toVersion-1, []byte("key-9"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-9"), val)
+	}
+}
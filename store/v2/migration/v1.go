@@ -0,0 +1,219 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/snapshots"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// The layout of the state committed by the store/v1 root multi store.
+const (
+	v1LatestVersionKey = "s/latest"
+	v1CommitInfoKeyFmt = "s/%d"    // s/<version>
+	v1StorePrefixFmt   = "s/k:%s/" // s/k:<storeKey>/
+	v1IavlCacheSize    = 100_000
+)
+
+var _ snapshots.CommitSnapshotter = (*V1Store)(nil)
+
+// V1Store is a read-only view of the IAVL trees committed by the store/v1 root
+// multi store, used as the source of the migration to store/v2.
+type V1Store struct {
+	version   uint64
+	storeKeys []string
+	trees     map[string]commitment.Tree
+}
+
+// NewV1Store returns the V1Store of the state committed by store/v1 in db. The
+// latest version is 0 if store/v1 has not committed any state.
+func NewV1Store(db corestore.KVStoreWithBatch, logger log.Logger) (*V1Store, error) {
+	version, err := loadV1LatestVersion(db)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &V1Store{version: version, trees: make(map[string]commitment.Tree)}
+	if version == 0 {
+		return s, nil
+	}
+
+	s.storeKeys, err = loadV1StoreKeys(db, version)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &iavl.Config{CacheSize: v1IavlCacheSize, SkipFastStorageUpgrade: true}
+	for _, storeKey := range s.storeKeys {
+		prefixDB := dbm.NewPrefixDB(db, []byte(fmt.Sprintf(v1StorePrefixFmt, storeKey)))
+		s.trees[storeKey] = iavl.NewIavlTree(prefixDB, logger, cfg)
+	}
+
+	return s, nil
+}
+
+// LatestVersion returns the latest version committed by store/v1.
+func (s *V1Store) LatestVersion() uint64 {
+	return s.version
+}
+
+// StoreKeys returns the sorted store keys committed by store/v1 at the latest version.
+func (s *V1Store) StoreKeys() []string {
+	return s.storeKeys
+}
+
+// Snapshot implements snapshots.CommitSnapshotter.
+func (s *V1Store) Snapshot(version uint64, protoWriter protoio.Writer) error {
+	if version == 0 || version > s.version {
+		return fmt.Errorf("the snapshot version %d must be between 1 and the latest version %d", version, s.version)
+	}
+
+	for _, storeKey := range s.storeKeys {
+		if err := func() error {
+			exporter, err := s.trees[storeKey].Export(version)
+			if err != nil {
+				return fmt.Errorf("failed to export tree %s for version %d: %w", storeKey, version, err)
+			}
+			defer exporter.Close()
+
+			err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+				Item: &snapshotstypes.SnapshotItem_Store{
+					Store: &snapshotstypes.SnapshotStoreItem{
+						Name: storeKey,
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to write store name: %w", err)
+			}
+
+			for {
+				item, err := exporter.Next()
+				if errors.Is(err, commitment.ErrorExportDone) {
+					return nil
+				} else if err != nil {
+					return fmt.Errorf("failed to get the next export node: %w", err)
+				}
+
+				if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+					Item: &snapshotstypes.SnapshotItem_IAVL{
+						IAVL: item,
+					},
+				}); err != nil {
+					return fmt.Errorf("failed to write iavl node: %w", err)
+				}
+			}
+		}(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Restore implements snapshots.CommitSnapshotter, V1Store being read-only it always
+// returns an error.
+func (s *V1Store) Restore(uint64, uint32, protoio.Reader, chan<- *corestore.StateChanges) (snapshotstypes.SnapshotItem, error) {
+	return snapshotstypes.SnapshotItem{}, errors.New("cannot restore a snapshot to store/v1")
+}
+
+// Close closes the trees of the store.
+func (s *V1Store) Close() error {
+	var err error
+	for _, tree := range s.trees {
+		err = errors.Join(err, tree.Close())
+	}
+	return err
+}
+
+// loadV1LatestVersion decodes the latest version, stored as a protobuf Int64Value.
+func loadV1LatestVersion(db corestore.KVStore) (uint64, error) {
+	bz, err := db.Get([]byte(v1LatestVersionKey))
+	if err != nil {
+		return 0, err
+	}
+
+	var version uint64
+	err = consumeV1Fields(bz, func(num protowire.Number, typ protowire.Type, bz []byte) (int, error) {
+		if num != 1 || typ != protowire.VarintType {
+			return protowire.ConsumeFieldValue(num, typ, bz), nil
+		}
+		v, n := protowire.ConsumeVarint(bz)
+		version = v
+		return n, nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("invalid store/v1 latest version: %w", err)
+	}
+
+	return version, nil
+}
+
+// loadV1StoreKeys decodes the names of the stores of the commit info of the given
+// version, stored as a protobuf CommitInfo.
+func loadV1StoreKeys(db corestore.KVStore, version uint64) ([]string, error) {
+	bz, err := db.Get([]byte(fmt.Sprintf(v1CommitInfoKeyFmt, version)))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("store/v1 commit info of version %d not found", version)
+	}
+
+	var storeKeys []string
+	err = consumeV1Fields(bz, func(num protowire.Number, typ protowire.Type, bz []byte) (int, error) {
+		if num != 2 || typ != protowire.BytesType {
+			return protowire.ConsumeFieldValue(num, typ, bz), nil
+		}
+		storeInfo, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return n, nil
+		}
+		return n, consumeV1Fields(storeInfo, func(num protowire.Number, typ protowire.Type, bz []byte) (int, error) {
+			if num != 1 || typ != protowire.BytesType {
+				return protowire.ConsumeFieldValue(num, typ, bz), nil
+			}
+			name, n := protowire.ConsumeString(bz)
+			storeKeys = append(storeKeys, name)
+			return n, nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid store/v1 commit info of version %d: %w", version, err)
+	}
+	sort.Strings(storeKeys)
+
+	return storeKeys, nil
+}
+
+// consumeV1Fields calls fn for each field of the protobuf message bz, fn returning
+// the length of the consumed field value.
+func consumeV1Fields(bz []byte, fn func(num protowire.Number, typ protowire.Type, bz []byte) (int, error)) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		n, err := fn(num, typ, bz)
+		if err != nil {
+			return err
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+	}
+
+	return nil
+}
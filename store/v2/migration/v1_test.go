@@ -0,0 +1,104 @@
+package migration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+// commitV1 commits a version of the given trees with the store/v1 metadata layout.
+func commitV1(t *testing.T, db corestore.KVStoreWithBatch, trees map[string]commitment.Tree, version uint64) {
+	t.Helper()
+
+	var commitInfo []byte
+	commitInfo = protowire.AppendTag(commitInfo, 1, protowire.VarintType)
+	commitInfo = protowire.AppendVarint(commitInfo, version)
+	for name, tree := range trees {
+		_, v, err := tree.Commit()
+		require.NoError(t, err)
+		require.Equal(t, version, v)
+
+		var storeInfo []byte
+		storeInfo = protowire.AppendTag(storeInfo, 1, protowire.BytesType)
+		storeInfo = protowire.AppendString(storeInfo, name)
+		commitInfo = protowire.AppendTag(commitInfo, 2, protowire.BytesType)
+		commitInfo = protowire.AppendBytes(commitInfo, storeInfo)
+	}
+	require.NoError(t, db.Set([]byte(fmt.Sprintf(v1CommitInfoKeyFmt, version)), commitInfo))
+
+	var latest []byte
+	latest = protowire.AppendTag(latest, 1, protowire.VarintType)
+	latest = protowire.AppendVarint(latest, version)
+	require.NoError(t, db.Set([]byte(v1LatestVersionKey), latest))
+}
+
+func TestV1Store(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	v1Store, err := NewV1Store(db, coretesting.NewNopLogger())
+	require.NoError(t, err)
+	require.Zero(t, v1Store.LatestVersion())
+
+	trees := make(map[string]commitment.Tree)
+	for _, storeKey := range []string{"store2", "store1"} {
+		prefixDB := dbm.NewPrefixDB(db, []byte(fmt.Sprintf(v1StorePrefixFmt, storeKey)))
+		trees[storeKey] = iavl.NewIavlTree(prefixDB, coretesting.NewNopLogger(), iavl.DefaultConfig())
+	}
+	toVersion := uint64(5)
+	for version := uint64(1); version <= toVersion; version++ {
+		for storeKey, tree := range trees {
+			require.NoError(t, tree.Set([]byte(fmt.Sprintf("key-%d", version)), []byte(fmt.Sprintf("%s-%d", storeKey, version))))
+		}
+		commitV1(t, db, trees, version)
+	}
+
+	v1Store, err = NewV1Store(db, coretesting.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, toVersion, v1Store.LatestVersion())
+	require.Equal(t, []string{"store1", "store2"}, v1Store.StoreKeys())
+
+	// migrate the store/v1 state
+	snapshotsStore, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	storageDB, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(storageDB, coretesting.NewNopLogger())
+	snapshotsManager := snapshots.NewManager(snapshotsStore, snapshots.NewSnapshotOptions(0, 0), v1Store, ss, nil, coretesting.NewNopLogger())
+
+	scDB := dbm.NewMemDB()
+	newTrees := make(map[string]commitment.Tree)
+	for _, storeKey := range v1Store.StoreKeys() {
+		newTrees[storeKey] = iavl.NewIavlTree(dbm.NewPrefixDB(scDB, []byte(storeKey)), coretesting.NewNopLogger(), iavl.DefaultConfig())
+	}
+	sc, err := commitment.NewCommitStore(newTrees, nil, scDB, coretesting.NewNopLogger())
+	require.NoError(t, err)
+
+	m := NewManager(dbm.NewMemDB(), snapshotsManager, ss, sc, coretesting.NewNopLogger())
+	require.NoError(t, m.Migrate(toVersion))
+	require.Equal(t, uint64(10), m.RestoredKeys())
+
+	for storeKey, tree := range trees {
+		require.Equal(t, tree.Hash(), newTrees[storeKey].Hash())
+		for version := uint64(1); version <= toVersion; version++ {
+			val, err := ss.Get([]byte(storeKey), toVersion, []byte(fmt.Sprintf("key-%d", version)))
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("%s-%d", storeKey, version)), val)
+		}
+	}
+
+	// store/v1 is read-only
+	_, err = v1Store.Restore(toVersion, 0, nil, nil)
+	require.Error(t, err)
+	require.Error(t, v1Store.Snapshot(toVersion+1, nil))
+}
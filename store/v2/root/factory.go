@@ -15,6 +15,7 @@ import (
 	"cosmossdk.io/store/v2/internal"
 	"cosmossdk.io/store/v2/pruning"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/boltdb"
 	"cosmossdk.io/store/v2/storage/pebbledb"
 	"cosmossdk.io/store/v2/storage/rocksdb"
 	"cosmossdk.io/store/v2/storage/sqlite"
@@ -29,17 +30,21 @@ const (
 	SSTypeSQLite SSType = "sqlite"
 	SSTypePebble SSType = "pebble"
 	SSTypeRocks  SSType = "rocksdb"
+	SSTypeBolt   SSType = "bolt"
 	SCTypeIavl   SCType = "iavl"
 	SCTypeIavlV2 SCType = "iavl-v2"
 )
 
 // Options are the options for creating a root store.
 type Options struct {
-	SSType          SSType               `mapstructure:"ss-type" toml:"ss-type" comment:"State storage database type. Currently we support: \"sqlite\", \"pebble\" and \"rocksdb\""`
-	SCType          SCType               `mapstructure:"sc-type" toml:"sc-type" comment:"State commitment database type. Currently we support: \"iavl\" and \"iavl-v2\""`
-	SSPruningOption *store.PruningOption `mapstructure:"ss-pruning-option" toml:"ss-pruning-option" comment:"Pruning options for state storage"`
-	SCPruningOption *store.PruningOption `mapstructure:"sc-pruning-option" toml:"sc-pruning-option" comment:"Pruning options for state commitment"`
-	IavlConfig      *iavl.Config         `mapstructure:"iavl-config" toml:"iavl-config"`
+	SSType                    SSType               `mapstructure:"ss-type" toml:"ss-type" comment:"State storage database type. Currently we support: \"sqlite\", \"pebble\", \"rocksdb\" and \"bolt\""`
+	SCType                    SCType               `mapstructure:"sc-type" toml:"sc-type" comment:"State commitment database type. Currently we support: \"iavl\" and \"iavl-v2\""`
+	HistoricalProofs          bool                 `mapstructure:"historical-proofs" toml:"historical-proofs" comment:"HistoricalProofs records the changeset of each version in state storage and retains the state commitment roots, so that keys can be proven at pruned heights. The recorded changesets are never pruned, so state storage grows by the size of every changeset committed."`
+	HistoricalProofsMaxAge    uint64               `mapstructure:"historical-proofs-max-age" toml:"historical-proofs-max-age" comment:"HistoricalProofsMaxAge is the maximum number of heights behind the latest one at which keys can be proven once pruned from state commitment, 0 means no limit."`
+	HistoricalProofsCacheSize int                  `mapstructure:"historical-proofs-cache-size" toml:"historical-proofs-cache-size" comment:"HistoricalProofsCacheSize is the maximum number of state commitment trees rebuilt for historical proofs kept in memory, one per store."`
+	SSPruningOption           *store.PruningOption `mapstructure:"ss-pruning-option" toml:"ss-pruning-option" comment:"Pruning options for state storage"`
+	SCPruningOption           *store.PruningOption `mapstructure:"sc-pruning-option" toml:"sc-pruning-option" comment:"Pruning options for state commitment"`
+	IavlConfig                *iavl.Config         `mapstructure:"iavl-config" toml:"iavl-config"`
 }
 
 // FactoryOptions are the options for creating a root store.
@@ -54,8 +59,10 @@ type FactoryOptions struct {
 // DefaultStoreOptions returns the default options for creating a root store.
 func DefaultStoreOptions() Options {
 	return Options{
-		SSType: SSTypeSQLite,
-		SCType: SCTypeIavl,
+		SSType:                    SSTypeSQLite,
+		SCType:                    SCTypeIavl,
+		HistoricalProofsMaxAge:    DefaultHistoricalProofsConfig().MaxAge,
+		HistoricalProofsCacheSize: DefaultHistoricalProofsConfig().CacheSize,
 		SCPruningOption: &store.PruningOption{
 			KeepRecent: 2,
 			Interval:   100,
@@ -109,6 +116,12 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 			return nil, err
 		}
 		ssDb, err = rocksdb.New(dir)
+	case SSTypeBolt:
+		dir := fmt.Sprintf("%s/data/ss/bolt", opts.RootDir)
+		if err = ensureDir(dir); err != nil {
+			return nil, err
+		}
+		ssDb, err = boltdb.New(dir)
 	default:
 		return nil, fmt.Errorf("unknown storage type: %s", opts.Options.SSType)
 	}
@@ -177,5 +190,16 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 	}
 
 	pm := pruning.NewManager(sc, ss, storeOpts.SCPruningOption, storeOpts.SSPruningOption)
-	return New(opts.SCRawDB, opts.Logger, ss, sc, pm, nil, nil)
+	rs, err := New(opts.SCRawDB, opts.Logger, ss, sc, pm, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if storeOpts.HistoricalProofs {
+		rs.(*Store).SetHistoricalProofs(&HistoricalProofsConfig{
+			MaxAge:    storeOpts.HistoricalProofsMaxAge,
+			CacheSize: storeOpts.HistoricalProofsCacheSize,
+		})
+	}
+
+	return rs, nil
 }
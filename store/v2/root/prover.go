@@ -0,0 +1,295 @@
+package root
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	corelog "cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/db"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/internal/encoding"
+	"cosmossdk.io/store/v2/proof"
+)
+
+// changelogStoreKey is the SS store key under which the changeset of each version
+// is recorded when historical proofs are enabled. Each entry is written once, so
+// the entries are kept when the SS backend is pruned: every changeset is stored a
+// second time and never deleted, so the SS backend grows by the size of all the
+// changesets committed since historical proofs were enabled.
+const changelogStoreKey = "_changelog"
+
+// changelogKey returns the key of the changelog entry of the given version.
+func changelogKey(version uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, version)
+}
+
+// withChangelog returns the changeset to apply to the SS backend, along with the
+// changelog entry of the given version.
+func withChangelog(version uint64, cs *corestore.Changeset) (*corestore.Changeset, error) {
+	bz, err := encoding.MarshalChangeset(cs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode changeset: %w", err)
+	}
+
+	changes := make([]corestore.StateChanges, len(cs.Changes), len(cs.Changes)+1)
+	copy(changes, cs.Changes)
+	changes = append(changes, corestore.StateChanges{
+		Actor:        []byte(changelogStoreKey),
+		StateChanges: []corestore.KVPair{{Key: changelogKey(version), Value: bz}},
+	})
+
+	return &corestore.Changeset{Changes: changes}, nil
+}
+
+// HistoricalProofsConfig configures the proofs at the versions pruned from the SC
+// backend.
+type HistoricalProofsConfig struct {
+	// MaxAge is the maximum number of versions behind the latest one that can be
+	// proven from a rebuilt tree, 0 means no limit. Rebuilding a tree replays the
+	// changelog from the first version, so the older versions are the most
+	// expensive to prove once the cached trees are ahead of them.
+	MaxAge uint64
+	// CacheSize is the maximum number of rebuilt trees kept in memory, at most one
+	// per store. The least recently used tree is evicted first, at least one tree
+	// is kept.
+	CacheSize int
+}
+
+// DefaultHistoricalProofsConfig returns the default configuration of the historical
+// proofs.
+func DefaultHistoricalProofsConfig() HistoricalProofsConfig {
+	return HistoricalProofsConfig{
+		MaxAge:    100_000,
+		CacheSize: 2,
+	}
+}
+
+// rebuiltTree is an in-memory SC tree rebuilt from the changelog.
+type rebuiltTree struct {
+	// mtx guards the tree while it is replayed and proven
+	mtx  sync.Mutex
+	tree commitment.Tree
+	// version is the latest version committed to the tree, 0 if none
+	version uint64
+	// lastUsed is the counter of the prover when the tree was last used
+	lastUsed uint64
+}
+
+// reset discards the tree, which is rebuilt from scratch on its next use.
+func (t *rebuiltTree) reset() {
+	t.tree = nil
+	t.version = 0
+}
+
+// historicalProver proves keys at the versions pruned from the SC backend. The SC
+// tree of the store is rebuilt in memory by replaying the changelog recorded in the
+// SS backend, and its root is checked against the retained commit info of the
+// version. The last rebuilt tree of the most recently used stores is cached, so that
+// proving keys at increasing versions only replays the versions in between. The
+// trees of different stores are rebuilt concurrently.
+type historicalProver struct {
+	logger corelog.Logger
+	ss     store.VersionedReader
+	cfg    HistoricalProofsConfig
+
+	// mtx guards trees and uses, not the trees themselves
+	mtx   sync.Mutex
+	trees map[string]*rebuiltTree
+	uses  uint64
+}
+
+func newHistoricalProver(logger corelog.Logger, ss store.VersionedReader, cfg HistoricalProofsConfig) *historicalProver {
+	cfg.CacheSize = max(cfg.CacheSize, 1)
+
+	return &historicalProver{
+		logger: logger,
+		ss:     ss,
+		cfg:    cfg,
+		trees:  make(map[string]*rebuiltTree),
+	}
+}
+
+// prove returns the value of the key in the given store at the given version, along
+// with its proof. The value is read from the rebuilt tree, so that the versions
+// pruned from the SS backend can be proven as well.
+func (p *historicalProver) prove(cInfo *proof.CommitInfo, storeKey []byte, version uint64, key []byte) ([]byte, []proof.CommitmentOp, error) {
+	storeHash := cInfo.GetStoreCommitID(storeKey).Hash
+	if storeHash == nil {
+		return nil, nil, fmt.Errorf("store %s not found in commit info of version %d", storeKey, version)
+	}
+
+	latestVersion, err := p.ss.GetLatestVersion()
+	if err != nil {
+		return nil, nil, err
+	}
+	if p.cfg.MaxAge > 0 && version+p.cfg.MaxAge < latestVersion {
+		return nil, nil, storeerrors.ErrVersionPruned{RequestedVersion: version, EarliestVersion: latestVersion - p.cfg.MaxAge}
+	}
+
+	t := p.acquire(storeKey)
+	defer t.mtx.Unlock()
+
+	if err := p.rebuild(t, storeKey, version, latestVersion); err != nil {
+		return nil, nil, err
+	}
+	if hash := t.tree.Hash(); !bytes.Equal(hash, storeHash) {
+		t.reset()
+		return nil, nil, fmt.Errorf("rebuilt tree of store %s at version %d has hash %X, expected %X", storeKey, version, hash, storeHash)
+	}
+
+	value, err := t.tree.Get(version, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	iProof, err := t.tree.GetProof(version, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return value, []proof.CommitmentOp{proof.NewIAVLCommitmentOp(key, iProof), *storeCommitmentOp}, nil
+}
+
+// acquire returns the locked cached tree of the given store, evicting the least
+// recently used trees beyond the cache size. The trees are evicted even if they are
+// in use, in which case they are released once their proof is done.
+func (p *historicalProver) acquire(storeKey []byte) *rebuiltTree {
+	p.mtx.Lock()
+	t, ok := p.trees[string(storeKey)]
+	if !ok {
+		t = &rebuiltTree{}
+		p.trees[string(storeKey)] = t
+	}
+	p.uses++
+	t.lastUsed = p.uses
+
+	for len(p.trees) > p.cfg.CacheSize {
+		var lru string
+		for name, other := range p.trees {
+			if other != t && (lru == "" || other.lastUsed < p.trees[lru].lastUsed) {
+				lru = name
+			}
+		}
+		delete(p.trees, lru)
+	}
+	p.mtx.Unlock()
+
+	t.mtx.Lock()
+	return t
+}
+
+// rebuild brings the tree of the given store to the given version, replaying the
+// changelog from the current tree if it is not ahead of the version.
+func (p *historicalProver) rebuild(t *rebuiltTree, storeKey []byte, version, latestVersion uint64) error {
+	if t.tree != nil && t.version == version {
+		return nil
+	}
+	if t.tree == nil || t.version > version {
+		t.tree = iavl.NewIavlTree(db.NewMemDB(), p.logger, iavl.DefaultConfig())
+		t.version = 0
+	}
+
+	if err := p.replay(t, storeKey, version, latestVersion); err != nil {
+		// the tree may be partially written, so it is discarded
+		t.reset()
+		return err
+	}
+
+	return nil
+}
+
+// replay commits the changes of the given store recorded in the changelog up to the
+// given version to the tree. The changelog is read at the latest SS version, since
+// the version itself may be pruned from the SS backend.
+func (p *historicalProver) replay(t *rebuiltTree, storeKey []byte, version, latestVersion uint64) error {
+	iter, err := p.ss.Iterator([]byte(changelogStoreKey), latestVersion, changelogKey(t.version+1), changelogKey(version+1))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		v := binary.BigEndian.Uint64(iter.Key())
+
+		var cs corestore.Changeset
+		if err := encoding.UnmarshalChangeset(&cs, iter.Value()); err != nil {
+			return fmt.Errorf("failed to decode changelog of version %d: %w", v, err)
+		}
+		var pairs []corestore.KVPair
+		for _, changes := range cs.Changes {
+			if bytes.Equal(changes.Actor, storeKey) {
+				pairs = append(pairs, changes.StateChanges...)
+			}
+		}
+
+		// the versions before the first change of the store don't alter its tree
+		if t.version == 0 {
+			if len(pairs) == 0 {
+				continue
+			}
+			if err := t.tree.SetInitialVersion(v); err != nil {
+				return err
+			}
+		} else if v != t.version+1 {
+			return fmt.Errorf("changelog of version %d not found", t.version+1)
+		}
+
+		if err := p.commit(t, v, pairs); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	switch {
+	case t.version == version:
+		return nil
+	case t.version == 0:
+		// the store has no changes up to the version
+		if err := t.tree.SetInitialVersion(version); err != nil {
+			return err
+		}
+		return p.commit(t, version, nil)
+	default:
+		return fmt.Errorf("changelog of version %d not found", t.version+1)
+	}
+}
+
+// commit writes the changes of a version to the tree, pruning the previous version.
+func (p *historicalProver) commit(t *rebuiltTree, version uint64, pairs []corestore.KVPair) error {
+	for _, kv := range pairs {
+		if kv.Remove {
+			if err := t.tree.Remove(kv.Key); err != nil {
+				return err
+			}
+		} else if err := t.tree.Set(kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+
+	_, committed, err := t.tree.Commit()
+	if err != nil {
+		return err
+	}
+	if committed != version {
+		return fmt.Errorf("commit version %d does not match the target version %d", committed, version)
+	}
+
+	previous := t.version
+	t.version = version
+	if previous > 0 {
+		return t.tree.Prune(previous)
+	}
+
+	return nil
+}
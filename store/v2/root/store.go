@@ -24,6 +24,8 @@ import (
 var (
 	_ store.RootStore        = (*Store)(nil)
 	_ store.UpgradeableStore = (*Store)(nil)
+	_ store.Prover           = (*Store)(nil)
+	_ store.HistoryReader    = (*Store)(nil)
 )
 
 // Store defines the SDK's default RootStore implementation. It contains a single
@@ -56,6 +58,10 @@ type Store struct {
 	// pruningManager reflects the pruning manager used to prune state of the SS and SC backends
 	pruningManager *pruning.Manager
 
+	// historicalProver reflects the prover of the versions pruned from the SC backend,
+	// it is nil unless historical proofs are enabled
+	historicalProver *historicalProver
+
 	// Migration related fields
 	// migrationManager reflects the migration manager used to migrate state from v1 to v2
 	migrationManager *migration.Manager
@@ -112,6 +118,24 @@ func (s *Store) SetMetrics(m metrics.Metrics) {
 	s.telemetry = m
 }
 
+// SetHistoricalProofs enables the proofs at the versions pruned from the SC backend
+// with the given configuration, or disables them if it is nil. When enabled, the
+// changeset of each committed version is recorded in the SS backend and the commit
+// infos of the pruned versions are retained, so that ProveKey can rebuild the SC
+// trees at the pruned versions. Rebuilding a tree requires the changelog of every
+// version since its first change, so historical proofs should be enabled from the
+// initial version of the store. The changelog is never pruned.
+func (s *Store) SetHistoricalProofs(cfg *HistoricalProofsConfig) {
+	if retainer, ok := s.stateCommitment.(interface{ SetRetainCommitInfo(bool) }); ok {
+		retainer.SetRetainCommitInfo(cfg != nil)
+	}
+
+	s.historicalProver = nil
+	if cfg != nil {
+		s.historicalProver = newHistoricalProver(s.logger, s.stateStorage, *cfg)
+	}
+}
+
 func (s *Store) SetInitialVersion(v uint64) error {
 	s.initialVersion = v
 
@@ -226,6 +250,64 @@ func (s *Store) Query(storeKey []byte, version uint64, key []byte, prove bool) (
 	return result, nil
 }
 
+// ProveKey implements store.Prover. The versions still present in the SC backend are
+// proven by it, while the pruned ones are proven from SC trees rebuilt from the
+// changelog recorded in the SS backend, which requires historical proofs to be enabled.
+func (s *Store) ProveKey(storeKey []byte, version uint64, key []byte) (store.QueryResult, error) {
+	if s.telemetry != nil {
+		now := time.Now()
+		defer s.telemetry.MeasureSince(now, "root_store", "prove_key")
+	}
+
+	result := store.QueryResult{
+		Key:     key,
+		Version: version,
+	}
+
+	var err error
+	result.ProofOps, err = s.stateCommitment.GetProof(storeKey, version, key)
+	if err == nil {
+		result.Value, err = s.stateStorage.Get(storeKey, version, key)
+		if err != nil {
+			return store.QueryResult{}, fmt.Errorf("failed to query SS store: %w", err)
+		}
+
+		return result, nil
+	}
+	if s.historicalProver == nil {
+		return store.QueryResult{}, fmt.Errorf("failed to get SC store proof: %w", err)
+	}
+
+	cInfo, err := s.stateCommitment.GetCommitInfo(version)
+	if err != nil {
+		return store.QueryResult{}, fmt.Errorf("failed to get commit info for version %d: %w", version, err)
+	}
+	if cInfo == nil {
+		return store.QueryResult{}, fmt.Errorf("commit info not found for version %d", version)
+	}
+	result.Value, result.ProofOps, err = s.historicalProver.prove(cInfo, storeKey, version, key)
+	if err != nil {
+		return store.QueryResult{}, fmt.Errorf("failed to get historical proof: %w", err)
+	}
+
+	return result, nil
+}
+
+// VersionIterator implements store.HistoryReader. The history of the keys is read
+// from the SS backend, which must implement store.HistoryReader.
+func (s *Store) VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error) {
+	if s.isMigrating {
+		return nil, errors.New("key history is unavailable while migrating")
+	}
+
+	reader, ok := s.stateStorage.(store.HistoryReader)
+	if !ok {
+		return nil, errors.New("SS backend does not support key history")
+	}
+
+	return reader.VersionIterator(storeKey, key, start, end)
+}
+
 func (s *Store) LoadLatestVersion() error {
 	if s.telemetry != nil {
 		now := time.Now()
@@ -387,9 +469,17 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 	// if we're migrating, we don't want to commit to the state storage to avoid
 	// parallel writes
 	if !s.isMigrating {
+		ssChangeset := cs
+		if s.historicalProver != nil {
+			var err error
+			if ssChangeset, err = withChangelog(version, cs); err != nil {
+				return nil, err
+			}
+		}
+
 		// commit SS async
 		eg.Go(func() error {
-			if err := s.stateStorage.ApplyChangeset(version, cs); err != nil {
+			if err := s.stateStorage.ApplyChangeset(version, ssChangeset); err != nil {
 				return fmt.Errorf("failed to commit SS: %w", err)
 			}
 
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/sync/errgroup"
 
 	coreheader "cosmossdk.io/core/header"
 	corestore "cosmossdk.io/core/store"
@@ -15,6 +16,7 @@ import (
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
 	dbm "cosmossdk.io/store/v2/db"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/pruning"
 	"cosmossdk.io/store/v2/storage"
@@ -818,3 +820,168 @@ func (s *RootStoreTestSuite) TestHashStableWithEmptyCommitAndRestart() {
 	s.Require().NoError(err)
 	s.Require().Equal(lastCommitID.Hash, hash)
 }
+
+// prunedCommitStore is a CommitStore which cannot prove the versions before
+// minVersion, as if they were pruned.
+type prunedCommitStore struct {
+	*commitment.CommitStore
+	minVersion uint64
+}
+
+func (c *prunedCommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]proof.CommitmentOp, error) {
+	if version < c.minVersion {
+		return nil, fmt.Errorf("version %d is pruned", version)
+	}
+	return c.CommitStore.GetProof(storeKey, version, key)
+}
+
+// newHistoricalProofsStore returns a root store with historical proofs enabled,
+// whose SC backend prunes the versions below 6, and commits the versions 1 to 6 to
+// it, returning their hashes.
+func (s *RootStoreTestSuite) newHistoricalProofsStore(cfg HistoricalProofsConfig) (*Store, map[uint64][]byte) {
+	noopLog := coretesting.NewNopLogger()
+	pruningOption := &store.PruningOption{KeepRecent: 1, Interval: 1}
+
+	sqliteDB, err := sqlite.New(s.T().TempDir())
+	s.Require().NoError(err)
+	ss := storage.NewStorageStore(sqliteDB, noopLog)
+	trees := make(map[string]commitment.Tree)
+	for _, storeKey := range testStoreKeys {
+		trees[storeKey] = iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+	}
+	commitStore, err := commitment.NewCommitStore(trees, nil, dbm.NewMemDB(), noopLog)
+	s.Require().NoError(err)
+	sc := &prunedCommitStore{CommitStore: commitStore, minVersion: 6}
+	s.newStoreWithBackendMount(ss, sc, pruning.NewManager(sc, ss, pruningOption, pruningOption))
+
+	rs := s.rootStore.(*Store)
+	rs.SetHistoricalProofs(&cfg)
+
+	// testStoreKey3 has no changes until version 4
+	hashes := make(map[uint64][]byte)
+	for v := uint64(1); v <= 6; v++ {
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte("key"), []byte(fmt.Sprintf("value%d", v)), false)
+		cs.Add(testStoreKeyBytes, []byte("const"), []byte("value"), false)
+		if v%2 == 0 {
+			cs.Add(testStoreKey2Bytes, []byte(fmt.Sprintf("key%d", v)), []byte("value"), false)
+			cs.Add(testStoreKey2Bytes, []byte(fmt.Sprintf("key%d", v-2)), nil, true)
+		}
+		if v >= 4 {
+			cs.Add(testStoreKey3Bytes, []byte("key"), []byte(fmt.Sprintf("value%d", v)), false)
+		}
+		hash, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+		hashes[v] = hash
+	}
+
+	return rs, hashes
+}
+
+func (s *RootStoreTestSuite) TestProveKeyHistorical() {
+	rs, hashes := s.newHistoricalProofsStore(DefaultHistoricalProofsConfig())
+
+	verify := func(value []byte, proofOps []proof.CommitmentOp, version uint64) {
+		s.Require().Len(proofOps, 2)
+		args := [][]byte{value}
+		if value == nil {
+			args = nil
+		}
+		storeRoots, err := proofOps[0].Run(args)
+		s.Require().NoError(err)
+		roots, err := proofOps[1].Run(storeRoots)
+		s.Require().NoError(err)
+		s.Require().Equal(hashes[version], roots[0])
+	}
+	prove := func(storeKey []byte, version uint64, key, expValue []byte) {
+		result, err := rs.ProveKey(storeKey, version, key)
+		s.Require().NoError(err)
+		s.Require().Equal(expValue, result.Value)
+		verify(result.Value, result.ProofOps, version)
+	}
+
+	// the pruned versions are proven from the rebuilt trees, increasing versions
+	// reuse the cached trees, and the values of the versions pruned from the SS
+	// backend are read from the rebuilt trees
+	for v := uint64(1); v <= 6; v++ {
+		prove(testStoreKeyBytes, v, []byte("key"), []byte(fmt.Sprintf("value%d", v)))
+		prove(testStoreKeyBytes, v, []byte("const"), []byte("value"))
+	}
+	prove(testStoreKey2Bytes, 4, []byte("key4"), []byte("value"))
+	prove(testStoreKey2Bytes, 4, []byte("key2"), nil)
+	prove(testStoreKey2Bytes, 3, []byte("key2"), []byte("value"))
+	prove(testStoreKey3Bytes, 4, []byte("other"), nil)
+	prove(testStoreKey3Bytes, 5, []byte("key"), []byte("value5"))
+
+	// unknown stores and versions cannot be proven
+	_, err := rs.ProveKey([]byte("unknown"), 2, []byte("key"))
+	s.Require().Error(err)
+	_, err = rs.ProveKey(testStoreKeyBytes, 7, []byte("key"))
+	s.Require().Error(err)
+
+	// the pruned versions cannot be proven once historical proofs are disabled
+	rs.SetHistoricalProofs(nil)
+	_, err = rs.ProveKey(testStoreKeyBytes, 2, []byte("key"))
+	s.Require().Error(err)
+	result, err := rs.ProveKey(testStoreKeyBytes, 6, []byte("key"))
+	s.Require().NoError(err)
+	verify(result.Value, result.ProofOps, 6)
+}
+
+func (s *RootStoreTestSuite) TestProveKeyHistoricalLimits() {
+	rs, _ := s.newHistoricalProofsStore(HistoricalProofsConfig{MaxAge: 3, CacheSize: 1})
+
+	// the versions older than the max age are not proven
+	_, err := rs.ProveKey(testStoreKeyBytes, 2, []byte("key"))
+	s.Require().ErrorAs(err, &storeerrors.ErrVersionPruned{})
+	result, err := rs.ProveKey(testStoreKeyBytes, 3, []byte("key"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("value3"), result.Value)
+
+	// the trees of the least recently used stores are evicted
+	result, err = rs.ProveKey(testStoreKey2Bytes, 4, []byte("key4"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("value"), result.Value)
+	s.Require().Len(rs.historicalProver.trees, 1)
+	s.Require().Contains(rs.historicalProver.trees, testStoreKey2)
+
+	// the trees of different stores are rebuilt concurrently
+	var eg errgroup.Group
+	for _, storeKey := range [][]byte{testStoreKeyBytes, testStoreKey2Bytes, testStoreKey3Bytes} {
+		for v := uint64(4); v <= 5; v++ {
+			eg.Go(func() error {
+				_, err := rs.ProveKey(storeKey, v, []byte("key"))
+				return err
+			})
+		}
+	}
+	s.Require().NoError(eg.Wait())
+	s.Require().Len(rs.historicalProver.trees, 1)
+}
+
+func (s *RootStoreTestSuite) TestProveKeyHistoricalMissingChangelog() {
+	s.newStoreWithPruneConfig(&store.PruningOption{})
+
+	// the changelog is only recorded from version 3
+	for v := uint64(1); v <= 4; v++ {
+		if v == 3 {
+			cfg := DefaultHistoricalProofsConfig()
+			s.rootStore.(*Store).SetHistoricalProofs(&cfg)
+		}
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte("key"), []byte(fmt.Sprintf("value%d", v)), false)
+		if v == 1 {
+			cs.Add(testStoreKeyBytes, []byte("const"), []byte("value"), false)
+		}
+		_, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	rs := s.rootStore.(*Store)
+	cInfo, err := rs.stateCommitment.GetCommitInfo(4)
+	s.Require().NoError(err)
+
+	// the rebuilt tree misses the versions 1 and 2, so its root doesn't match
+	_, _, err = rs.historicalProver.prove(cInfo, testStoreKeyBytes, 4, []byte("key"))
+	s.Require().ErrorContains(err, "rebuilt tree of store")
+}
@@ -0,0 +1,98 @@
+package boltdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	bolt "go.etcd.io/bbolt"
+
+	"cosmossdk.io/store/v2"
+)
+
+var _ store.Batch = (*Batch)(nil)
+
+type batchOp struct {
+	bucket, key, value []byte
+}
+
+// Batch buffers the writes of a version, which are written to BoltDB in a single
+// transaction.
+type Batch struct {
+	storage *bolt.DB
+	ops     []batchOp
+	size    int
+	version uint64
+}
+
+func NewBatch(storage *bolt.DB, version uint64) *Batch {
+	return &Batch{
+		storage: storage,
+		version: version,
+	}
+}
+
+func (b *Batch) Size() int {
+	return b.size
+}
+
+func (b *Batch) Reset() error {
+	b.ops = b.ops[:0]
+	b.size = 0
+	return nil
+}
+
+func (b *Batch) set(storeKey []byte, tombstone bool, key, value []byte) error {
+	op := batchOp{
+		bucket: storePrefix(storeKey),
+		key:    MVCCEncode(key, b.version),
+		value:  encodeValue(value, tombstone),
+	}
+	b.ops = append(b.ops, op)
+	b.size += len(op.key) + len(op.value)
+
+	return nil
+}
+
+func (b *Batch) Set(storeKey, key, value []byte) error {
+	return b.set(storeKey, false, key, value)
+}
+
+func (b *Batch) Delete(storeKey, key []byte) error {
+	return b.set(storeKey, true, key, nil)
+}
+
+// Write writes the buffered operations along with the latest version. The
+// operations are sorted beforehand, since BoltDB inserts sorted keys much faster.
+func (b *Batch) Write() error {
+	slices.SortStableFunc(b.ops, func(x, y batchOp) int {
+		if c := bytes.Compare(x.bucket, y.bucket); c != 0 {
+			return c
+		}
+		return bytes.Compare(x.key, y.key)
+	})
+
+	return b.storage.Update(func(tx *bolt.Tx) error {
+		var versionBz [VersionSize]byte
+		binary.LittleEndian.PutUint64(versionBz[:], b.version)
+		if err := tx.Bucket(metadataBucket).Put([]byte(latestVersionKey), versionBz[:]); err != nil {
+			return fmt.Errorf("failed to write BoltDB batch: %w", err)
+		}
+
+		var bucket *bolt.Bucket
+		for i, op := range b.ops {
+			if i == 0 || !bytes.Equal(op.bucket, b.ops[i-1].bucket) {
+				var err error
+				if bucket, err = tx.CreateBucketIfNotExists(op.bucket); err != nil {
+					return fmt.Errorf("failed to create BoltDB bucket: %w", err)
+				}
+			}
+			if err := bucket.Put(op.key, op.value); err != nil {
+				return fmt.Errorf("failed to write BoltDB batch: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,390 @@
+package boltdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/storage"
+)
+
+const (
+	dbName = "ss.db"
+	// PruneCommitBatchSize defines the size, in number of keys, to prune in a
+	// single transaction.
+	PruneCommitBatchSize = 10_000
+
+	// initialMmapSize is the initial size of the memory map of the database. A
+	// write transaction growing the database beyond the memory map waits for the
+	// read transactions to be released to remap it.
+	initialMmapSize = 1 << 30
+	// openTimeout is the time to wait for the lock of the database file.
+	openTimeout = 5 * time.Second
+
+	StorePrefixTpl   = "s/k:%s/" // s/k:<storeKey>
+	latestVersionKey = "latest_version"
+	pruneHeightKey   = "prune_height"
+)
+
+var (
+	// metadataBucket holds the latest version and the prune height.
+	metadataBucket = []byte("s/_metadata")
+	// removedStoreKeysBucket holds the removed store keys, keyed by <version><storeKey>.
+	removedStoreKeysBucket = []byte("s/_removed_key")
+	storeBucketPrefix      = []byte("s/k:")
+)
+
+var (
+	_ storage.Database         = (*Database)(nil)
+	_ store.UpgradableDatabase = (*Database)(nil)
+)
+
+// Database is a pure-Go state storage backend built on BoltDB (bbolt). Each store
+// is a bucket of MVCC keys, see MVCCEncode, whose values hold a tombstone flag.
+type Database struct {
+	storage *bolt.DB
+
+	// earliestVersion defines the earliest version set in the database, which is
+	// only updated when the database is pruned.
+	earliestVersion uint64
+}
+
+func New(dataDir string) (*Database, error) {
+	db, err := bolt.Open(filepath.Join(dataDir, dbName), 0o600, &bolt.Options{
+		Timeout:         openTimeout,
+		InitialMmapSize: initialMmapSize,
+		FreelistType:    bolt.FreelistMapType,
+		NoFreelistSync:  true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open BoltDB: %w", err)
+	}
+
+	return NewWithDB(db)
+}
+
+func NewWithDB(db *bolt.DB) (*Database, error) {
+	var earliestVersion uint64
+	err := db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{metadataBucket, removedStoreKeysBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+
+		// the earliest version is the prune height + 1, or 0 if never pruned
+		if bz := tx.Bucket(metadataBucket).Get([]byte(pruneHeightKey)); len(bz) > 0 {
+			earliestVersion = binary.LittleEndian.Uint64(bz) + 1
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize BoltDB: %w", err)
+	}
+
+	return &Database{
+		storage:         db,
+		earliestVersion: earliestVersion,
+	}, nil
+}
+
+// SetSync sets whether to fsync the database file after each write transaction.
+// Disabling it is only safe if the database can be rebuilt after a crash.
+func (db *Database) SetSync(sync bool) {
+	db.storage.NoSync = !sync
+}
+
+func (db *Database) Close() error {
+	err := db.storage.Close()
+	db.storage = nil
+	return err
+}
+
+func (db *Database) NewBatch(version uint64) (store.Batch, error) {
+	return NewBatch(db.storage, version), nil
+}
+
+func (db *Database) SetLatestVersion(version uint64) error {
+	return db.setMetadata(latestVersionKey, version)
+}
+
+func (db *Database) GetLatestVersion() (uint64, error) {
+	var version uint64
+	err := db.storage.View(func(tx *bolt.Tx) error {
+		// a fresh database has no latest version
+		if bz := tx.Bucket(metadataBucket).Get([]byte(latestVersionKey)); len(bz) > 0 {
+			version = binary.LittleEndian.Uint64(bz)
+		}
+		return nil
+	})
+
+	return version, err
+}
+
+func (db *Database) VersionExists(version uint64) (bool, error) {
+	latestVersion, err := db.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+
+	return latestVersion >= version && version >= db.earliestVersion, nil
+}
+
+func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
+	val, err := db.Get(storeKey, version, key)
+	if err != nil {
+		return false, err
+	}
+
+	return val != nil, nil
+}
+
+func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]byte, error) {
+	if targetVersion < db.earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: targetVersion}
+	}
+
+	var value []byte
+	err := db.storage.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(storePrefix(storeKey))
+		if bucket == nil {
+			return nil
+		}
+
+		if bz := seekVersion(bucket.Cursor(), key, targetVersion); bz != nil {
+			// the value is considered deleted if the latest version is a tombstone
+			value, _ = decodeValue(bz)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform BoltDB read: %w", err)
+	}
+
+	return value, nil
+}
+
+// Prune removes all versions of all keys that are <= the given version, except the
+// latest one of each key if it is not a tombstone, so that the keys can still be
+// read at the later versions.
+//
+// Note, the implementation iterates over all keys in the database, committing the
+// deletions every PruneCommitBatchSize keys.
+func (db *Database) Prune(version uint64) error {
+	var buckets [][]byte
+	err := db.storage.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			if bytes.HasPrefix(name, storeBucketPrefix) {
+				buckets = append(buckets, bytes.Clone(name))
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, bucket := range buckets {
+		if err := db.pruneBucket(bucket, version, false); err != nil {
+			return err
+		}
+	}
+
+	if err := db.deleteRemovedStoreKeys(version); err != nil {
+		return err
+	}
+
+	if err := db.setMetadata(pruneHeightKey, version); err != nil {
+		return err
+	}
+	db.earliestVersion = version + 1
+
+	return nil
+}
+
+func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	return db.newIterator(storeKey, version, start, end, false)
+}
+
+func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	return db.newIterator(storeKey, version, start, end, true)
+}
+
+func (db *Database) newIterator(storeKey []byte, version uint64, start, end []byte, reverse bool) (corestore.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return nil, storeerrors.ErrStartAfterEnd
+	}
+
+	return newBoltDBIterator(db.storage, storePrefix(storeKey), start, end, version, db.earliestVersion, reverse), nil
+}
+
+// VersionIterator returns an iterator over the versions in [start, end] at which
+// the key was written, the deletions being visited with a nil value.
+func (db *Database) VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error) {
+	if len(key) == 0 {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+
+	if start > end {
+		return nil, storeerrors.ErrStartVersionAfterEnd
+	}
+
+	if start < db.earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: start}
+	}
+
+	return newVersionIterator(db.storage, storePrefix(storeKey), key, start, end), nil
+}
+
+func (db *Database) PruneStoreKeys(storeKeys []string, version uint64) error {
+	return db.storage.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(removedStoreKeysBucket)
+		for _, storeKey := range storeKeys {
+			if err := bucket.Put(removedStoreKey(version, storeKey), []byte{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (db *Database) setMetadata(key string, version uint64) error {
+	var ts [VersionSize]byte
+	binary.LittleEndian.PutUint64(ts[:], version)
+
+	return db.storage.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(metadataBucket).Put([]byte(key), ts[:])
+	})
+}
+
+// pruneBucket removes the versions of the keys of the bucket that are <= the given
+// version. If all is false, the latest of them is kept unless it is a tombstone.
+func (db *Database) pruneBucket(name []byte, version uint64, all bool) error {
+	var (
+		next []byte
+		done bool
+	)
+	for !done {
+		err := db.storage.Update(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket(name)
+			if bucket == nil {
+				done = true
+				return nil
+			}
+
+			c := bucket.Cursor()
+			var k, v []byte
+			if next == nil {
+				k, v = c.First()
+			} else {
+				k, v = c.Seek(next)
+			}
+
+			var deletes [][]byte
+			for k != nil && len(deletes) < PruneCommitBatchSize {
+				key, _, ok := SplitMVCCKey(k)
+				if !ok {
+					return fmt.Errorf("invalid BoltDB MVCC key: %X", k)
+				}
+				prefix := keyPrefix(key)
+
+				var latest []byte
+				for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+					_, keyVersion, ok := SplitMVCCKey(k)
+					if !ok {
+						return fmt.Errorf("invalid BoltDB MVCC key: %X", k)
+					}
+					if keyVersion > version {
+						break
+					}
+
+					if latest != nil {
+						deletes = append(deletes, latest)
+					}
+					latest = bytes.Clone(k)
+					if _, tombstone := decodeValue(v); all || tombstone {
+						deletes = append(deletes, latest)
+						latest = nil
+					}
+				}
+
+				next = nextKeyPrefix(key)
+				k, v = c.Seek(next)
+			}
+			done = k == nil
+
+			for _, key := range deletes {
+				if err := bucket.Delete(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteRemovedStoreKeys removes the versions of the store keys removed at a
+// version <= the given version, which are <= their removal version.
+func (db *Database) deleteRemovedStoreKeys(version uint64) error {
+	storeKeys := make(map[string]uint64)
+	var removed [][]byte
+	err := db.storage.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(removedStoreKeysBucket).Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			v := binary.BigEndian.Uint64(k[:VersionSize])
+			if v > version {
+				break
+			}
+
+			storeKey := string(k[VersionSize:])
+			if ev, ok := storeKeys[storeKey]; !ok || ev < v {
+				storeKeys[storeKey] = v
+			}
+			removed = append(removed, bytes.Clone(k))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for storeKey, v := range storeKeys {
+		if err := db.pruneBucket(storePrefix([]byte(storeKey)), v, true); err != nil {
+			return err
+		}
+	}
+
+	return db.storage.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(removedStoreKeysBucket)
+		for _, k := range removed {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func storePrefix(storeKey []byte) []byte {
+	return []byte(fmt.Sprintf(StorePrefixTpl, storeKey))
+}
+
+func removedStoreKey(version uint64, storeKey string) []byte {
+	return append(binary.BigEndian.AppendUint64(nil, version), storeKey...)
+}
@@ -0,0 +1,100 @@
+package boltdb
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2/storage"
+)
+
+func TestStorageTestSuite(t *testing.T) {
+	s := &storage.StorageTestSuite{
+		NewDB: func(dir string) (*storage.StorageStore, error) {
+			db, err := New(dir)
+			if err == nil && db != nil {
+				// We set sync=false just to speed up CI tests. Operators should take
+				// careful consideration when setting this value in production environments.
+				db.SetSync(false)
+			}
+
+			return storage.NewStorageStore(db, coretesting.NewNopLogger()), err
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func TestMVCCEncode(t *testing.T) {
+	keys := [][]byte{{}, {0x00}, {0x00, 0x00}, {0x00, 0x01}, {0x00, 0xFF}, {0x01}, []byte("a"), []byte("a\x00b"), []byte("ab"), {0xFF}}
+	for i, key := range keys {
+		for _, version := range []uint64{1, 2, 1 << 40} {
+			k, v, ok := SplitMVCCKey(MVCCEncode(key, version))
+			require.True(t, ok)
+			require.Equal(t, key, k)
+			require.Equal(t, version, v)
+
+			// the MVCC keys are ordered by user key, then by version
+			require.Less(t, string(keyPrefix(key)), string(MVCCEncode(key, version)))
+			require.Less(t, string(MVCCEncode(key, version)), string(MVCCEncode(key, version+1)))
+			require.Less(t, string(MVCCEncode(key, version)), string(nextKeyPrefix(key)))
+			if i > 0 {
+				require.Less(t, string(nextKeyPrefix(keys[i-1])), string(MVCCEncode(key, 0)))
+			}
+		}
+	}
+
+	_, _, ok := SplitMVCCKey([]byte{0x00, 0x01})
+	require.False(t, ok)
+	_, _, ok = SplitMVCCKey(append([]byte{0x00, 0x02, 0x00, 0x01}, make([]byte, VersionSize)...))
+	require.False(t, ok)
+}
+
+func TestIteratorBuffer(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	storeKey := []byte("store1")
+	keyCount := 3 * maxIteratorBufferSize
+	for version := uint64(1); version <= 2; version++ {
+		batch, err := db.NewBatch(version)
+		require.NoError(t, err)
+		for i := 0; i < keyCount; i++ {
+			key := []byte(fmt.Sprintf("key%05d", i))
+			if version == 1 {
+				require.NoError(t, batch.Set(storeKey, key, key))
+			} else if i%3 == 0 {
+				require.NoError(t, batch.Delete(storeKey, key))
+			}
+		}
+		require.NoError(t, batch.Write())
+	}
+
+	// the iterators read the keys over several transactions
+	for _, reverse := range []bool{false, true} {
+		var itr corestore.Iterator
+		if reverse {
+			itr, err = db.ReverseIterator(storeKey, 2, []byte("key00001"), nil)
+		} else {
+			itr, err = db.Iterator(storeKey, 2, []byte("key00001"), nil)
+		}
+		require.NoError(t, err)
+
+		var keys []string
+		for ; itr.Valid(); itr.Next() {
+			require.Equal(t, itr.Key(), itr.Value())
+			keys = append(keys, string(itr.Key()))
+		}
+		require.NoError(t, itr.Error())
+		require.NoError(t, itr.Close())
+
+		require.Len(t, keys, 2*keyCount/3)
+		require.True(t, slices.IsSorted(keys) != reverse)
+	}
+}
@@ -0,0 +1,336 @@
+package boltdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	bolt "go.etcd.io/bbolt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+)
+
+const (
+	// minIteratorBufferSize and maxIteratorBufferSize bound the number of key/value
+	// pairs read by an iterator in a single transaction. The buffer size doubles
+	// on each read, so that short iterations read few keys.
+	minIteratorBufferSize = 16
+	maxIteratorBufferSize = 4096
+)
+
+var _ corestore.Iterator = (*iterator)(nil)
+
+type kvPair struct {
+	key, value []byte
+}
+
+// iterator implements the store.Iterator interface. It iterates over the user keys
+// in the provided domain for a given version. For each user key, the latest version
+// <= the given version is visited, unless it is a tombstone.
+//
+// The key/value pairs are read in batches, each in its own read transaction, so
+// that an iterator does not hold a transaction, which would block the writes
+// growing the database and closing it.
+type iterator struct {
+	storage    *bolt.DB
+	bucket     []byte
+	start, end []byte
+	version    uint64
+	reverse    bool
+
+	buffer     []kvPair
+	bufferSize int
+	pos        int
+	// last is the last user key read, nil before the first read
+	last      []byte
+	exhausted bool
+
+	valid bool
+	err   error
+}
+
+func newBoltDBIterator(storage *bolt.DB, bucket, start, end []byte, version, earliestVersion uint64, reverse bool) *iterator {
+	itr := &iterator{
+		storage:    storage,
+		bucket:     bucket,
+		start:      start,
+		end:        end,
+		version:    version,
+		reverse:    reverse,
+		bufferSize: minIteratorBufferSize,
+	}
+	if version < earliestVersion {
+		return itr
+	}
+
+	itr.read()
+	return itr
+}
+
+// Domain returns the domain of the iterator. The caller must not modify the
+// return values.
+func (itr *iterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+func (itr *iterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *iterator) Key() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.buffer[itr.pos].key)
+}
+
+func (itr *iterator) Value() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.buffer[itr.pos].value)
+}
+
+func (itr *iterator) Next() {
+	if !itr.valid {
+		return
+	}
+
+	itr.pos++
+	if itr.pos < len(itr.buffer) {
+		return
+	}
+	if itr.exhausted {
+		itr.valid = false
+		return
+	}
+
+	itr.read()
+}
+
+func (itr *iterator) Error() error {
+	return itr.err
+}
+
+func (itr *iterator) Close() error {
+	itr.buffer = nil
+	itr.valid = false
+
+	return nil
+}
+
+func (itr *iterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
+
+// read reads the next batch of visible key/value pairs after the last user key read.
+func (itr *iterator) read() {
+	itr.buffer = itr.buffer[:0]
+	itr.pos = 0
+
+	err := itr.storage.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(itr.bucket)
+		if bucket == nil {
+			itr.exhausted = true
+			return nil
+		}
+
+		c := bucket.Cursor()
+		var k []byte
+		switch {
+		case itr.last != nil && itr.reverse:
+			k = before(c, keyPrefix(itr.last))
+		case itr.last != nil:
+			k, _ = c.Seek(nextKeyPrefix(itr.last))
+		case itr.reverse && itr.end == nil:
+			k, _ = c.Last()
+		case itr.reverse:
+			k = before(c, keyPrefix(itr.end))
+		case itr.start == nil:
+			k, _ = c.First()
+		default:
+			k, _ = c.Seek(keyPrefix(itr.start))
+		}
+
+		for len(itr.buffer) < itr.bufferSize {
+			if k == nil {
+				itr.exhausted = true
+				return nil
+			}
+
+			key, _, ok := SplitMVCCKey(k)
+			if !ok {
+				return fmt.Errorf("invalid BoltDB MVCC key: %X", k)
+			}
+			if (itr.reverse && itr.start != nil && bytes.Compare(key, itr.start) < 0) ||
+				(!itr.reverse && itr.end != nil && bytes.Compare(key, itr.end) >= 0) {
+				itr.exhausted = true
+				return nil
+			}
+
+			if bz := seekVersion(c, key, itr.version); bz != nil {
+				if value, tombstone := decodeValue(bz); !tombstone {
+					itr.buffer = append(itr.buffer, kvPair{key: key, value: value})
+				}
+			}
+			itr.last = key
+
+			if itr.reverse {
+				k = before(c, keyPrefix(key))
+			} else {
+				k, _ = c.Seek(nextKeyPrefix(key))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		itr.err = err
+		itr.exhausted = true
+		itr.buffer = itr.buffer[:0]
+	}
+
+	itr.bufferSize = min(2*itr.bufferSize, maxIteratorBufferSize)
+	itr.valid = len(itr.buffer) > 0
+}
+
+// before returns the last MVCC key lower than the given one.
+func before(c *bolt.Cursor, mvccKey []byte) []byte {
+	k, _ := c.Seek(mvccKey)
+	if k == nil {
+		k, _ = c.Last()
+		return k
+	}
+
+	k, _ = c.Prev()
+	return k
+}
+
+var _ store.VersionIterator = (*versionIterator)(nil)
+
+// keyVersion is a version at which a key was written, with a nil value if the
+// key was deleted.
+type keyVersion struct {
+	version uint64
+	value   []byte
+}
+
+// versionIterator implements the store.VersionIterator interface. Like iterator,
+// it reads the versions of the key in batches, each in its own read transaction.
+type versionIterator struct {
+	storage *bolt.DB
+	bucket  []byte
+	prefix  []byte
+	// next is the lowest version which is not read yet
+	next, end uint64
+
+	buffer     []keyVersion
+	bufferSize int
+	pos        int
+	exhausted  bool
+
+	err error
+}
+
+func newVersionIterator(storage *bolt.DB, bucket, key []byte, start, end uint64) *versionIterator {
+	itr := &versionIterator{
+		storage:    storage,
+		bucket:     bucket,
+		prefix:     keyPrefix(key),
+		next:       start,
+		end:        end,
+		bufferSize: minIteratorBufferSize,
+	}
+
+	itr.read()
+	return itr
+}
+
+func (itr *versionIterator) Valid() bool {
+	return itr.pos < len(itr.buffer)
+}
+
+func (itr *versionIterator) Next() {
+	if !itr.Valid() {
+		return
+	}
+
+	itr.pos++
+	if itr.pos == len(itr.buffer) && !itr.exhausted {
+		itr.read()
+	}
+}
+
+func (itr *versionIterator) Version() uint64 {
+	itr.assertIsValid()
+	return itr.buffer[itr.pos].version
+}
+
+func (itr *versionIterator) Value() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.buffer[itr.pos].value)
+}
+
+func (itr *versionIterator) Error() error {
+	return itr.err
+}
+
+func (itr *versionIterator) Close() error {
+	itr.buffer = nil
+	itr.pos = 0
+
+	return nil
+}
+
+func (itr *versionIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+// read reads the next batch of versions of the key, from the next version.
+func (itr *versionIterator) read() {
+	itr.buffer = itr.buffer[:0]
+	itr.pos = 0
+
+	err := itr.storage.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(itr.bucket)
+		if bucket == nil {
+			itr.exhausted = true
+			return nil
+		}
+
+		c := bucket.Cursor()
+		k, v := c.Seek(binary.BigEndian.AppendUint64(slices.Clone(itr.prefix), itr.next))
+		for ; len(itr.buffer) < itr.bufferSize; k, v = c.Next() {
+			if k == nil || len(k) != len(itr.prefix)+VersionSize || !bytes.HasPrefix(k, itr.prefix) {
+				itr.exhausted = true
+				return nil
+			}
+
+			version := binary.BigEndian.Uint64(k[len(itr.prefix):])
+			if version > itr.end {
+				itr.exhausted = true
+				return nil
+			}
+
+			value, _ := decodeValue(v)
+			itr.buffer = append(itr.buffer, keyVersion{version: version, value: value})
+
+			if version == itr.end {
+				itr.exhausted = true
+				return nil
+			}
+			itr.next = version + 1
+		}
+
+		return nil
+	})
+	if err != nil {
+		itr.err = err
+		itr.exhausted = true
+		itr.buffer = itr.buffer[:0]
+	}
+
+	itr.bufferSize = min(2*itr.bufferSize, maxIteratorBufferSize)
+}
@@ -0,0 +1,126 @@
+package boltdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// BoltDB orders the keys of a bucket by their bytes, without a custom comparator.
+// So, the user key of an MVCC key is escaped, each 0x00 byte being encoded as
+// 0x00 0xFF, and terminated by 0x00 0x01 before the big-endian version. This keeps
+// the MVCC keys ordered by user key then by version, a user key sorting before the
+// keys it is a prefix of.
+const (
+	escapeByte     = 0x00
+	escapedZero    = 0xFF
+	terminatorByte = 0x01
+	// nextKeyByte follows the terminator of a user key, so that a key ending with it
+	// sorts after all the versions of the user key.
+	nextKeyByte = 0x02
+
+	// VersionSize is the size of the version suffix of an MVCC key.
+	VersionSize = 8
+	// terminatorSize is the size of the terminator of the user key of an MVCC key.
+	terminatorSize = 2
+
+	valueFlag     = 0x00
+	tombstoneFlag = 0x01
+)
+
+// MVCCEncode encodes a key and version into an MVCC key.
+// The format is: <escaped key>\x00\x01<version>
+func MVCCEncode(key []byte, version uint64) []byte {
+	return binary.BigEndian.AppendUint64(keyPrefix(key), version)
+}
+
+// SplitMVCCKey accepts an MVCC key and returns the user key, the MVCC version, and
+// a boolean indicating if the provided key is a valid MVCC key.
+func SplitMVCCKey(mvccKey []byte) (key []byte, version uint64, ok bool) {
+	n := len(mvccKey) - VersionSize - terminatorSize
+	if n < 0 || mvccKey[n] != escapeByte || mvccKey[n+1] != terminatorByte {
+		return nil, 0, false
+	}
+
+	key = make([]byte, 0, n)
+	for i := 0; i < n; i++ {
+		b := mvccKey[i]
+		if b == escapeByte {
+			i++
+			if i == n || mvccKey[i] != escapedZero {
+				return nil, 0, false
+			}
+		}
+		key = append(key, b)
+	}
+
+	return key, binary.BigEndian.Uint64(mvccKey[n+terminatorSize:]), true
+}
+
+// keyPrefix returns the prefix shared by all the MVCC keys of the user key, which
+// is lower than all of them.
+func keyPrefix(key []byte) []byte {
+	dst := make([]byte, 0, len(key)+terminatorSize+VersionSize)
+	for _, b := range key {
+		if b == escapeByte {
+			dst = append(dst, escapeByte, escapedZero)
+		} else {
+			dst = append(dst, b)
+		}
+	}
+
+	return append(dst, escapeByte, terminatorByte)
+}
+
+// nextKeyPrefix returns the lowest key greater than all the MVCC keys of the user key.
+func nextKeyPrefix(key []byte) []byte {
+	dst := keyPrefix(key)
+	dst[len(dst)-1] = nextKeyByte
+	return dst
+}
+
+// encodeValue encodes a value with its tombstone flag.
+func encodeValue(value []byte, tombstone bool) []byte {
+	flag := byte(valueFlag)
+	if tombstone {
+		flag = tombstoneFlag
+	}
+
+	dst := make([]byte, 0, len(value)+1)
+	dst = append(dst, flag)
+	return append(dst, value...)
+}
+
+// decodeValue returns a copy of the encoded value, and whether it is a tombstone.
+func decodeValue(bz []byte) (value []byte, tombstone bool) {
+	if len(bz) == 0 || bz[0] == tombstoneFlag {
+		return nil, true
+	}
+
+	return slices.Clone(bz[1:]), false
+}
+
+// seekVersion moves the cursor to the latest version of the user key which is <=
+// the given version, returning its encoded value, or nil if there is none.
+func seekVersion(c *bolt.Cursor, key []byte, version uint64) []byte {
+	var k, v []byte
+	if version == ^uint64(0) {
+		k, _ = c.Seek(nextKeyPrefix(key))
+	} else {
+		k, _ = c.Seek(MVCCEncode(key, version+1))
+	}
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+
+	prefix := keyPrefix(key)
+	if k == nil || len(k) != len(prefix)+VersionSize || !bytes.HasPrefix(k, prefix) {
+		return nil
+	}
+
+	return v
+}
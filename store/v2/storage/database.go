@@ -20,6 +20,7 @@ type Database interface {
 
 	Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error)
 	ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error)
+	VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error)
 
 	Prune(version uint64) error
 
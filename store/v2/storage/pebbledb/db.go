@@ -342,6 +342,38 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, true), nil
 }
 
+// VersionIterator returns an iterator over the versions in [start, end] at which
+// the key was written, the deletions being visited with a nil value.
+func (db *Database) VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error) {
+	if len(key) == 0 {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+
+	if start > end {
+		return nil, storeerrors.ErrStartVersionAfterEnd
+	}
+
+	if start < db.earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: start}
+	}
+
+	// end domain is exclusive, so we need to increment the version by 1
+	if end < math.MaxUint64 {
+		end++
+	}
+
+	prefixedKey := prependStoreKey(storeKey, key)
+	itr, err := db.storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode(prefixedKey, start),
+		UpperBound: MVCCEncode(prefixedKey, end),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newVersionIterator(itr), nil
+}
+
 func (db *Database) PruneStoreKeys(storeKeys []string, version uint64) (err error) {
 	batch := db.storage.NewBatch()
 	defer func() {
@@ -8,6 +8,7 @@ import (
 	"github.com/cockroachdb/pebble"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
 )
 
 var _ corestore.Iterator = (*iterator)(nil)
@@ -430,3 +431,104 @@ func (itr *iterator) nextReverse() {
 
 	itr.valid = false
 }
+
+var _ store.VersionIterator = (*versionIterator)(nil)
+
+// versionIterator implements the store.VersionIterator interface. It wraps a
+// PebbleDB iterator bounded to the MVCC keys of a single key, which visits its
+// versions in ascending order.
+type versionIterator struct {
+	source  *pebble.Iterator
+	version uint64
+	value   []byte
+	valid   bool
+	err     error
+}
+
+func newVersionIterator(src *pebble.Iterator) *versionIterator {
+	itr := &versionIterator{source: src}
+	itr.valid = src.First()
+	itr.decode()
+
+	return itr
+}
+
+func (itr *versionIterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *versionIterator) Next() {
+	if !itr.valid {
+		return
+	}
+
+	itr.valid = itr.source.Next()
+	itr.decode()
+}
+
+func (itr *versionIterator) Version() uint64 {
+	itr.assertIsValid()
+	return itr.version
+}
+
+func (itr *versionIterator) Value() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.value)
+}
+
+func (itr *versionIterator) Error() error {
+	if itr.err != nil {
+		return itr.err
+	}
+
+	return itr.source.Error()
+}
+
+func (itr *versionIterator) Close() error {
+	err := itr.source.Close()
+	itr.source = nil
+	itr.valid = false
+
+	return err
+}
+
+func (itr *versionIterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
+
+// decode decodes the version and the value of the current MVCC key, the value of
+// a tombstone being nil.
+func (itr *versionIterator) decode() {
+	if !itr.valid {
+		return
+	}
+
+	_, versionBz, ok := SplitMVCCKey(itr.source.Key())
+	if !ok {
+		itr.err = fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.source.Key())
+		itr.valid = false
+		return
+	}
+
+	version, err := decodeUint64Ascending(versionBz)
+	if err != nil {
+		itr.err = fmt.Errorf("failed to decode key version: %w", err)
+		itr.valid = false
+		return
+	}
+
+	value, tombBz, ok := SplitMVCCKey(itr.source.Value())
+	if !ok {
+		itr.err = fmt.Errorf("invalid PebbleDB MVCC value: %s", itr.source.Value())
+		itr.valid = false
+		return
+	}
+
+	itr.version = version
+	itr.value = value
+	if len(tombBz) > 0 {
+		itr.value = nil
+	}
+}
@@ -21,6 +21,13 @@ import (
 const (
 	TimestampSize = 8
 
+	// internalKeyFooterSize is the size of the sequence number and value type
+	// suffix of the keys visited by an iterator reading all the versions.
+	internalKeyFooterSize = 8
+	// valueTypeValue is the value type of the internal keys holding a value, the
+	// other ones being deletions.
+	valueTypeValue = 0x1
+
 	StorePrefixTpl   = "s/k:%s/"
 	latestVersionKey = "s/latest"
 )
@@ -206,6 +213,64 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	return newRocksDBIterator(itr, prefix, start, end, true), nil
 }
 
+// VersionIterator returns an iterator over the versions in [start, end] at which
+// the key was written, the deletions being visited with a nil value. Since the
+// timestamp of the start version is set as iter_start_ts, the iterator visits all
+// the versions of the key, whose internal keys hold their value type.
+func (db *Database) VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error) {
+	if len(key) == 0 {
+		return nil, errors.ErrKeyEmpty
+	}
+
+	if start > end {
+		return nil, errors.ErrStartVersionAfterEnd
+	}
+
+	if start < db.tsLow {
+		return nil, errors.ErrVersionPruned{EarliestVersion: db.tsLow, RequestedVersion: start}
+	}
+
+	var startTS [TimestampSize]byte
+	binary.LittleEndian.PutUint64(startTS[:], start)
+
+	readOpts := newTSReadOptions(end)
+	readOpts.SetIterStartTimestamp(startTS[:])
+	defer readOpts.Destroy()
+
+	itr := db.storage.NewIteratorCF(readOpts, db.cfHandle)
+	defer itr.Close()
+
+	prefixedKey := prependStoreKey(storeKey, key)
+
+	// the versions are visited from the newest, a version being written several
+	// times only if the key was written in several batches of the same version
+	var versions []keyVersion
+	for itr.Seek(prefixedKey); itr.Valid(); itr.Next() {
+		internalKey := readOnlySlice(itr.Key())
+		n := len(internalKey) - TimestampSize - internalKeyFooterSize
+		if n < 0 || !bytes.Equal(internalKey[:n], prefixedKey) {
+			break
+		}
+
+		version := binary.LittleEndian.Uint64(readOnlySlice(itr.Timestamp()))
+		if len(versions) > 0 && versions[len(versions)-1].version == version {
+			continue
+		}
+
+		kv := keyVersion{version: version}
+		if internalKey[n+TimestampSize] == valueTypeValue {
+			kv.value = slices.Clone(readOnlySlice(itr.Value()))
+		}
+		versions = append(versions, kv)
+	}
+	if err := itr.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over RocksDB key versions: %w", err)
+	}
+
+	slices.Reverse(versions)
+	return newVersionIterator(versions), nil
+}
+
 // PruneStoreKeys will do nothing for RocksDB, it will be pruned by compaction
 // when the version is pruned
 func (db *Database) PruneStoreKeys(_ []string, _ uint64) error {
@@ -63,6 +63,10 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	panic("rocksdb requires a build flag")
 }
 
+func (db *Database) VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error) {
+	panic("rocksdb requires a build flag")
+}
+
 // PruneStoreKeys will do nothing for RocksDB, it will be pruned by compaction
 // when the version is pruned
 func (db *Database) PruneStoreKeys(_ []string, _ uint64) error {
@@ -5,10 +5,12 @@ package rocksdb
 
 import (
 	"bytes"
+	"slices"
 
 	"github.com/linxGnu/grocksdb"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
 )
 
 var _ corestore.Iterator = (*iterator)(nil)
@@ -157,3 +159,60 @@ func (itr *iterator) assertIsValid() {
 		panic("iterator is invalid")
 	}
 }
+
+var _ store.VersionIterator = (*versionIterator)(nil)
+
+// keyVersion is a version at which a key was written, with a nil value if the
+// key was deleted.
+type keyVersion struct {
+	version uint64
+	value   []byte
+}
+
+// versionIterator implements the store.VersionIterator interface over the
+// versions of a key read beforehand, since RocksDB visits them from the newest.
+type versionIterator struct {
+	versions []keyVersion
+	pos      int
+}
+
+func newVersionIterator(versions []keyVersion) *versionIterator {
+	return &versionIterator{versions: versions}
+}
+
+func (itr *versionIterator) Valid() bool {
+	return itr.pos < len(itr.versions)
+}
+
+func (itr *versionIterator) Next() {
+	if itr.Valid() {
+		itr.pos++
+	}
+}
+
+func (itr *versionIterator) Version() uint64 {
+	itr.assertIsValid()
+	return itr.versions[itr.pos].version
+}
+
+func (itr *versionIterator) Value() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.versions[itr.pos].value)
+}
+
+func (itr *versionIterator) Error() error {
+	return nil
+}
+
+func (itr *versionIterator) Close() error {
+	itr.versions = nil
+	itr.pos = 0
+
+	return nil
+}
+
+func (itr *versionIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
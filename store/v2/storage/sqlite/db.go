@@ -281,6 +281,24 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	return newIterator(db, storeKey, version, start, end, true)
 }
 
+// VersionIterator returns an iterator over the versions in [start, end] at which
+// the key was written, the deletions being visited with a nil value.
+func (db *Database) VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error) {
+	if len(key) == 0 {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+
+	if start > end {
+		return nil, storeerrors.ErrStartVersionAfterEnd
+	}
+
+	if start < db.earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: start}
+	}
+
+	return newVersionIterator(db, storeKey, key, start, end)
+}
+
 func (db *Database) PruneStoreKeys(storeKeys []string, version uint64) (err error) {
 	tx, err := db.storage.Begin()
 	if err != nil {
@@ -3,11 +3,13 @@ package sqlite
 import (
 	"bytes"
 	"database/sql"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
 )
 
 var _ corestore.Iterator = (*iterator)(nil)
@@ -181,3 +183,112 @@ func (itr *iterator) assertIsValid() {
 		panic("iterator is invalid")
 	}
 }
+
+var _ store.VersionIterator = (*versionIterator)(nil)
+
+// keyVersion is a version at which a key was written, with a nil value if the
+// key was deleted.
+type keyVersion struct {
+	version uint64
+	value   []byte
+}
+
+// versionIterator implements the store.VersionIterator interface. Since a deletion
+// sets the tombstone of the latest row of a key instead of inserting a row, each
+// row yields the version it was written at and its tombstone version, if any.
+type versionIterator struct {
+	statement  *sql.Stmt
+	rows       *sql.Rows
+	start, end uint64
+	// pending holds the versions of the current row, the first one being visited.
+	pending []keyVersion
+	err     error
+}
+
+func newVersionIterator(db *Database, storeKey, key []byte, start, end uint64) (*versionIterator, error) {
+	stmt, err := db.storage.Prepare(`
+	SELECT value, version, tombstone FROM state_storage
+	WHERE store_key = ? AND key = ? AND version <= ? AND (version >= ? OR tombstone >= ?)
+	ORDER BY version ASC;
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+
+	rows, err := stmt.Query(storeKey, key, end, start, start)
+	if err != nil {
+		_ = stmt.Close()
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	itr := &versionIterator{
+		statement: stmt,
+		rows:      rows,
+		start:     start,
+		end:       end,
+	}
+	itr.Next()
+
+	return itr, nil
+}
+
+func (itr *versionIterator) Valid() bool {
+	return len(itr.pending) > 0
+}
+
+func (itr *versionIterator) Next() {
+	if len(itr.pending) > 0 {
+		itr.pending = itr.pending[1:]
+	}
+
+	for len(itr.pending) == 0 && itr.err == nil && itr.rows.Next() {
+		var (
+			value   []byte
+			version uint64
+			tomb    uint64
+		)
+		if err := itr.rows.Scan(&value, &version, &tomb); err != nil {
+			itr.err = fmt.Errorf("failed to scan row: %w", err)
+			return
+		}
+
+		// a key deleted at the version it was written at is only visited as deleted
+		if version >= itr.start && version != tomb {
+			itr.pending = append(itr.pending, keyVersion{version: version, value: value})
+		}
+		if tomb > 0 && tomb >= itr.start && tomb <= itr.end {
+			itr.pending = append(itr.pending, keyVersion{version: tomb})
+		}
+	}
+}
+
+func (itr *versionIterator) Version() uint64 {
+	itr.assertIsValid()
+	return itr.pending[0].version
+}
+
+func (itr *versionIterator) Value() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.pending[0].value)
+}
+
+func (itr *versionIterator) Error() error {
+	if err := itr.rows.Err(); err != nil {
+		return err
+	}
+
+	return itr.err
+}
+
+func (itr *versionIterator) Close() error {
+	err := errors.Join(itr.rows.Close(), itr.statement.Close())
+	itr.pending = nil
+
+	return err
+}
+
+func (itr *versionIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
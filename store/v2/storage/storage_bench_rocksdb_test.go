@@ -0,0 +1,18 @@
+//go:build rocksdb
+// +build rocksdb
+
+package storage_test
+
+import (
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/rocksdb"
+)
+
+func init() {
+	backends["rocksdb_versiondb_opts"] = func(dataDir string) (store.VersionedWriter, error) {
+		db, err := rocksdb.New(dataDir)
+		return storage.NewStorageStore(db, coretesting.NewNopLogger()), err
+	}
+}
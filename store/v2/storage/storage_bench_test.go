@@ -1,6 +1,3 @@
-//go:build rocksdb
-// +build rocksdb
-
 package storage_test
 
 import (
@@ -16,8 +13,8 @@ import (
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/boltdb"
 	"cosmossdk.io/store/v2/storage/pebbledb"
-	"cosmossdk.io/store/v2/storage/rocksdb"
 	"cosmossdk.io/store/v2/storage/sqlite"
 )
 
@@ -25,10 +22,6 @@ var storeKey1 = []byte("store1")
 
 var (
 	backends = map[string]func(dataDir string) (store.VersionedWriter, error){
-		"rocksdb_versiondb_opts": func(dataDir string) (store.VersionedWriter, error) {
-			db, err := rocksdb.New(dataDir)
-			return storage.NewStorageStore(db, coretesting.NewNopLogger()), err
-		},
 		"pebbledb_default_opts": func(dataDir string) (store.VersionedWriter, error) {
 			db, err := pebbledb.New(dataDir)
 			if err == nil && db != nil {
@@ -37,6 +30,14 @@ var (
 
 			return storage.NewStorageStore(db, coretesting.NewNopLogger()), err
 		},
+		"boltdb_default_opts": func(dataDir string) (store.VersionedWriter, error) {
+			db, err := boltdb.New(dataDir)
+			if err == nil && db != nil {
+				db.SetSync(false)
+			}
+
+			return storage.NewStorageStore(db, coretesting.NewNopLogger()), err
+		},
 		"btree_sqlite": func(dataDir string) (store.VersionedWriter, error) {
 			db, err := sqlite.New(dataDir)
 			return storage.NewStorageStore(db, coretesting.NewNopLogger()), err
@@ -184,3 +185,54 @@ func BenchmarkIterate(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkIterateRange(b *testing.B) {
+	numKeyVals := 1_000_000
+	rangeSize := 1_000
+	keys := make([][]byte, numKeyVals)
+	for i := 0; i < numKeyVals; i++ {
+		keys[i] = []byte(fmt.Sprintf("key%08d", i))
+	}
+
+	for ty, fn := range backends {
+		db, err := fn(b.TempDir())
+		require.NoError(b, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		// write each key over several versions, so that the iterators skip the
+		// versions above the iterated one
+		for version := uint64(1); version <= 3; version++ {
+			cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{string(storeKey1): {}})
+			for i := 0; i < numKeyVals; i++ {
+				cs.AddKVPair(storeKey1, corestore.KVPair{Key: keys[i], Value: []byte(fmt.Sprintf("val%08d-%d", i, version))})
+			}
+			require.NoError(b, db.ApplyChangeset(version, cs))
+		}
+
+		b.Run(fmt.Sprintf("backend_%s", ty), func(b *testing.B) {
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				start := rng.Intn(numKeyVals - rangeSize)
+
+				b.StartTimer()
+				itr, err := db.Iterator(storeKey1, 2, keys[start], keys[start+rangeSize])
+				require.NoError(b, err)
+
+				count := 0
+				for ; itr.Valid(); itr.Next() {
+					_ = itr.Key()
+					_ = itr.Value()
+					count++
+				}
+
+				require.NoError(b, itr.Error())
+				require.NoError(b, itr.Close())
+				require.Equal(b, rangeSize, count)
+			}
+		})
+	}
+}
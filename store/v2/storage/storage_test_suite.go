@@ -667,6 +667,69 @@ func (s *StorageTestSuite) TestDatabase_Prune_KeepRecent() {
 	s.Require().Equal([]byte("val200"), bz)
 }
 
+func (s *StorageTestSuite) TestDatabase_VersionIterator() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	key := []byte("key")
+	changes := []corestore.KVPairs{
+		{{Key: key, Value: []byte("val001")}, {Key: []byte("key0"), Value: []byte("other")}},
+		{{Key: []byte("kez"), Value: []byte("other")}},
+		{{Key: key, Value: []byte("val003")}},
+		{{Key: key, Remove: true}},
+		{{Key: key, Value: []byte("val005")}, {Key: []byte("ke"), Value: []byte("other")}},
+	}
+	for i, pairs := range changes {
+		s.Require().NoError(db.ApplyChangeset(uint64(i+1), corestore.NewChangesetWithPairs(
+			map[string]corestore.KVPairs{storeKey1: pairs},
+		)))
+	}
+
+	type keyVersion struct {
+		version uint64
+		value   []byte
+	}
+	collect := func(start, end uint64) []keyVersion {
+		itr, err := db.VersionIterator(storeKey1Bytes, key, start, end)
+		s.Require().NoError(err)
+		defer itr.Close()
+
+		var versions []keyVersion
+		for ; itr.Valid(); itr.Next() {
+			versions = append(versions, keyVersion{version: itr.Version(), value: itr.Value()})
+		}
+		s.Require().NoError(itr.Error())
+		return versions
+	}
+
+	s.Require().Equal([]keyVersion{
+		{1, []byte("val001")},
+		{3, []byte("val003")},
+		{4, nil},
+		{5, []byte("val005")},
+	}, collect(0, 10))
+	s.Require().Equal([]keyVersion{{3, []byte("val003")}, {4, nil}}, collect(2, 4))
+	s.Require().Equal([]keyVersion{{5, []byte("val005")}}, collect(5, 5))
+	s.Require().Empty(collect(6, 10))
+
+	_, err = db.VersionIterator(storeKey1Bytes, key, 4, 3)
+	s.Require().Error(err)
+	_, err = db.VersionIterator(storeKey1Bytes, nil, 0, 10)
+	s.Require().Error(err)
+
+	// the history below the prune height is incomplete
+	s.Require().NoError(db.Prune(2))
+
+	_, err = db.VersionIterator(storeKey1Bytes, key, 2, 10)
+	s.Require().Error(err)
+	s.Require().Equal([]keyVersion{{3, []byte("val003")}, {4, nil}, {5, []byte("val005")}}, collect(3, 10))
+}
+
 func (s *StorageTestSuite) TestDatabase_Restore() {
 	db, err := s.NewDB(s.T().TempDir())
 	s.Require().NoError(err)
@@ -20,6 +20,7 @@ var (
 	_ snapshots.StorageSnapshotter = (*StorageStore)(nil)
 	_ store.Pruner                 = (*StorageStore)(nil)
 	_ store.UpgradableDatabase     = (*StorageStore)(nil)
+	_ store.HistoryReader          = (*StorageStore)(nil)
 )
 
 // StorageStore is a wrapper around the store.VersionedWriter interface.
@@ -99,6 +100,12 @@ func (ss *StorageStore) ReverseIterator(storeKey []byte, version uint64, start,
 	return ss.db.ReverseIterator(storeKey, version, start, end)
 }
 
+// VersionIterator returns an iterator over the versions in [start, end] at which
+// the key was written.
+func (ss *StorageStore) VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error) {
+	return ss.db.VersionIterator(storeKey, key, start, end)
+}
+
 // Prune prunes the store up to the given version.
 func (ss *StorageStore) Prune(version uint64) error {
 	return ss.db.Prune(version)
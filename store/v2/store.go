@@ -76,6 +76,14 @@ type Backend interface {
 	GetStateCommitment() Committer
 }
 
+// Prover defines the interface for proving keys at historical versions, including
+// the versions already pruned from the SC backend.
+type Prover interface {
+	// ProveKey returns the value of the key in the given store at the given version,
+	// along with the proof of the key against the commit hash of that version.
+	ProveKey(storeKey []byte, version uint64, key []byte) (QueryResult, error)
+}
+
 // UpgradeableStore defines the interface for upgrading store keys.
 type UpgradeableStore interface {
 	// LoadVersionAndUpgrade behaves identically to LoadVersion except it also
@@ -11,6 +11,10 @@ const (
 	MetricKeyBeginBlocker = "begin_blocker"
 	MetricKeyEndBlocker   = "end_blocker"
 	MetricLabelNameModule = "module"
+
+	// the labels of message metrics, also used by the server/v2 STF
+	MetricLabelNameTypeURL   = "type_url"
+	MetricLabelNameCodespace = "codespace"
 )
 
 // NewLabel creates a new instance of Label with name and value
@@ -98,6 +102,16 @@ func MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), globalLabels)
 }
 
+// AddSampleWithLabels provides a wrapper functionality for emitting a sample
+// metric with global labels (if any) along with the provided labels.
+func AddSampleWithLabels(keys []string, val float32, labels []metrics.Label) {
+	if !IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.AddSampleWithLabels(keys, val, append(labels, globalLabels...))
+}
+
 // Now return the current time if telemetry is enabled or a zero time if it's not
 func Now() time.Time {
 	if !IsTelemetryEnabled() {
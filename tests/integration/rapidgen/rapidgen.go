@@ -232,7 +232,7 @@ var (
 		),
 
 		GenType(&banktypes.SendAuthorization{}, &bankapi.SendAuthorization{}, GenOpts),
-		GenType(&banktypes.Params{}, &bankapi.Params{}, GenOpts),
+		GenType(&banktypes.Params{}, &bankapi.Params{}, GenOpts.WithDisallowNil()),
 
 		// crypto
 		GenType(&multisig.LegacyAminoPubKey{}, &multisigapi.LegacyAminoPubKey{},
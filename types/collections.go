@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/math"
+	"cosmossdk.io/schema"
 )
 
 var (
@@ -120,6 +121,22 @@ func (a genericAddressKey[T]) SizeNonTerminal(key T) int {
 	return collections.BytesKey.SizeNonTerminal(key)
 }
 
+func (a genericAddressKey[T]) SchemaCodec() (collcodec.SchemaCodec[T], error) {
+	return collcodec.SchemaCodec[T]{
+		Fields: []schema.Field{{Kind: schema.AddressKind}},
+		ToSchemaType: func(key T) (any, error) {
+			return []byte(key), nil
+		},
+		FromSchemaType: func(value any) (T, error) {
+			bz, ok := value.([]byte)
+			if !ok {
+				return nil, fmt.Errorf("expected []byte, got %T", value)
+			}
+			return T(bz), nil
+		},
+	}, nil
+}
+
 // Deprecated: lengthPrefixedAddressKey is a special key codec used to retain state backwards compatibility
 // when a generic address key (be: AccAddress, ValAddress, ConsAddress), is used as an index key.
 // More docs can be found in the LengthPrefixedAddressKey function.
@@ -139,6 +156,10 @@ func (g lengthPrefixedAddressKey[T]) Size(key T) int { return g.SizeNonTerminal(
 
 func (g lengthPrefixedAddressKey[T]) KeyType() string { return "index_key/" + g.KeyCodec.KeyType() }
 
+func (g lengthPrefixedAddressKey[T]) SchemaCodec() (collcodec.SchemaCodec[T], error) {
+	return collcodec.KeySchemaCodec(g.KeyCodec)
+}
+
 // Deprecated: LengthPrefixedAddressKey implements an SDK backwards compatible indexing key encoder
 // for addresses.
 // The status quo in the SDK is that address keys are length prefixed even when they're the
@@ -176,6 +197,10 @@ func (g lengthPrefixedBytesKey) KeyType() string {
 	return "index_key/" + g.KeyCodec.KeyType()
 }
 
+func (g lengthPrefixedBytesKey) SchemaCodec() (collcodec.SchemaCodec[[]byte], error) {
+	return collcodec.KeySchemaCodec(g.KeyCodec)
+}
+
 // Collection Codecs
 
 type intValueCodec struct{}
@@ -329,6 +354,12 @@ func (t timeKeyCodec) DecodeNonTerminal(buffer []byte) (int, time.Time, error) {
 }
 func (t timeKeyCodec) SizeNonTerminal(key time.Time) int { return t.Size(key) }
 
+func (timeKeyCodec) SchemaCodec() (collcodec.SchemaCodec[time.Time], error) {
+	return collcodec.SchemaCodec[time.Time]{
+		Fields: []schema.Field{{Kind: schema.TimeKind}},
+	}, nil
+}
+
 type leUint64Key struct{}
 
 func (l leUint64Key) Encode(buffer []byte, key uint64) (int, error) {
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"pgregory.net/rapid"
 
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/schema"
 )
 
 func TestCollectionsCorrectness(t *testing.T) {
@@ -36,6 +38,35 @@ func TestCollectionsCorrectness(t *testing.T) {
 	})
 }
 
+func TestCollectionsSchemaCodec(t *testing.T) {
+	t.Run("AccAddress", func(t *testing.T) {
+		schemaCdc, err := collcodec.KeySchemaCodec(AccAddressKey)
+		require.NoError(t, err)
+		require.Equal(t, []schema.Field{{Kind: schema.AddressKind}}, schemaCdc.Fields)
+
+		value, err := schemaCdc.ToSchemaType(AccAddress{0x1, 0x2})
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x1, 0x2}, value)
+		require.NoError(t, schemaCdc.Fields[0].ValidateValue(value, nil))
+
+		addr, err := schemaCdc.FromSchemaType(value)
+		require.NoError(t, err)
+		require.Equal(t, AccAddress{0x1, 0x2}, addr)
+	})
+
+	t.Run("AddressIndexingKey", func(t *testing.T) {
+		schemaCdc, err := collcodec.KeySchemaCodec(LengthPrefixedAddressKey(ValAddressKey))
+		require.NoError(t, err)
+		require.Equal(t, []schema.Field{{Kind: schema.AddressKind}}, schemaCdc.Fields)
+	})
+
+	t.Run("Time", func(t *testing.T) {
+		schemaCdc, err := collcodec.KeySchemaCodec(TimeKey)
+		require.NoError(t, err)
+		require.Equal(t, []schema.Field{{Kind: schema.TimeKind}}, schemaCdc.Fields)
+	})
+}
+
 func TestLEUint64Key(t *testing.T) {
 	t.Run("conformance", rapid.MakeCheck(func(r *rapid.T) {
 		colltest.TestKeyCodec(t, LEUint64Key, rapid.Uint64().Draw(r, "uint64"))
@@ -406,6 +406,37 @@ func TryUnwrapSDKContext(ctx context.Context) (Context, bool) {
 	return c, ok
 }
 
+// uncommittedExecutionKey is the context key under which the
+// UncommittedExecution of an execution is stored.
+type uncommittedExecutionKey struct{}
+
+// UncommittedExecution is attached to the context of the executions whose
+// outcome is not committed as is, such as the trace of a transaction or its
+// speculative execution by a parallel TxExecutor. Code keeping state outside of the
+// multistore, e.g. in memory, must leave it untouched during such executions.
+type UncommittedExecution interface {
+	// Invalidate reports that the outcome of the execution depends on state
+	// living outside of the multistore. It is then discarded, and the
+	// transaction executed again once the ones before it are committed.
+	Invalidate()
+}
+
+// WithUncommittedExecution returns a Context attached to exec.
+func (c Context) WithUncommittedExecution(exec UncommittedExecution) Context {
+	return c.WithValue(uncommittedExecutionKey{}, exec)
+}
+
+// UncommittedExecutionFromContext returns the UncommittedExecution ctx is
+// attached to, if any.
+func UncommittedExecutionFromContext(ctx context.Context) (UncommittedExecution, bool) {
+	if sdkCtx, ok := ctx.(Context); ok && sdkCtx.baseCtx == nil {
+		return nil, false
+	}
+
+	exec, ok := ctx.Value(uncommittedExecutionKey{}).(UncommittedExecution)
+	return exec, ok
+}
+
 // ToSDKEvidence takes comet evidence and returns sdk evidence
 func ToSDKEvidence(ev []abci.Misbehavior) []comet.Evidence {
 	evidence := make([]comet.Evidence, len(ev))
@@ -0,0 +1,308 @@
+package mempool
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Mempool          = (*FeeMarketMempool)(nil)
+	_ TxPriorityGetter = (*FeeMarketMempool)(nil)
+	_ Iterator         = (*feeMarketIterator)(nil)
+)
+
+type (
+	// BaseFeeFn returns the current base fee, that is the minimum price per unit
+	// of gas a transaction must pay in order to be accepted into the mempool and
+	// selected for a block proposal. It is typically backed by the x/feemarket
+	// keeper.
+	BaseFeeFn func(ctx context.Context) (sdk.DecCoin, error)
+
+	// FeeMarketMempoolConfig defines the configuration used to configure the
+	// FeeMarketMempool.
+	FeeMarketMempoolConfig struct {
+		// BaseFee returns the current base fee. It is called on every Insert and
+		// Select, so it must be cheap to evaluate.
+		BaseFee BaseFeeFn
+
+		// MaxTx sets the maximum number of transactions allowed in the mempool.
+		// It follows the semantics of PriorityNonceMempoolConfig.MaxTx.
+		MaxTx int
+
+		// TxReplacement is a callback to be called when duplicated transaction nonce
+		// detected during mempool insert. The priorities passed in are the gas
+		// prices of the old and new transaction. If nil, a transaction with the
+		// same sender and nonce always replaces the existing one.
+		TxReplacement func(op, np math.LegacyDec, oTx, nTx sdk.Tx) bool
+
+		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
+		SignerExtractor SignerExtractionAdapter
+	}
+
+	// FeeMarketMempool is a mempool implementation for chains running an
+	// EIP-1559 style fee market. Transactions are ordered by their gas price in
+	// the base fee denomination and sender-nonce, using a PriorityNonceMempool
+	// internally. Because every transaction in a block is charged the same base
+	// fee, ordering by gas price is equivalent to ordering by effective tip
+	// (gas price - base fee).
+	//
+	// Transactions whose fee does not cover the base fee at insertion time are
+	// rejected, and transactions that fall below the base fee after it has risen
+	// are skipped on Select until the base fee drops again or they are evicted
+	// on recheck.
+	FeeMarketMempool struct {
+		mp      *PriorityNonceMempool[gasPrice]
+		baseFee BaseFeeFn
+	}
+
+	// feeMarketIterator wraps a PriorityNonceIterator and skips transactions
+	// which do not pay the base fee.
+	feeMarketIterator struct {
+		Iterator
+		baseFee sdk.DecCoin
+	}
+
+	// gasPrice is a transaction gas price expressed as an unsigned 128-bit fixed
+	// point number with math.LegacyPrecision decimals. Unlike math.LegacyDec it is
+	// comparable, which is required by PriorityNonceMempool priorities. Values
+	// that do not fit in 128 bits saturate to the maximum value.
+	gasPrice struct {
+		hi, lo uint64
+	}
+)
+
+// NewFeeMarketMempool returns a new FeeMarketMempool. It panics if no BaseFee
+// function is provided.
+func NewFeeMarketMempool(cfg FeeMarketMempoolConfig) *FeeMarketMempool {
+	if cfg.BaseFee == nil {
+		panic("fee market mempool requires a base fee function")
+	}
+
+	mp := &FeeMarketMempool{baseFee: cfg.BaseFee}
+
+	pnCfg := PriorityNonceMempoolConfig[gasPrice]{
+		TxPriority:      mp.txPriority(),
+		MaxTx:           cfg.MaxTx,
+		SignerExtractor: cfg.SignerExtractor,
+	}
+	if cfg.TxReplacement != nil {
+		pnCfg.TxReplacement = func(op, np gasPrice, oTx, nTx sdk.Tx) bool {
+			return cfg.TxReplacement(op.dec(), np.dec(), oTx, nTx)
+		}
+	}
+
+	mp.mp = NewPriorityMempool(pnCfg)
+	return mp
+}
+
+// txPriority returns the TxPriority used to order transactions by gas price in
+// the current base fee denomination.
+func (mp *FeeMarketMempool) txPriority() TxPriority[gasPrice] {
+	return TxPriority[gasPrice]{
+		GetTxPriority: func(ctx context.Context, tx sdk.Tx) gasPrice {
+			baseFee, err := mp.baseFee(ctx)
+			if err != nil {
+				return gasPrice{}
+			}
+
+			price, err := TxGasPrice(tx, baseFee.Denom)
+			if err != nil {
+				return gasPrice{}
+			}
+
+			return newGasPrice(price)
+		},
+		Compare: func(a, b gasPrice) int {
+			switch {
+			case a.hi != b.hi:
+				if a.hi < b.hi {
+					return -1
+				}
+				return 1
+			case a.lo != b.lo:
+				if a.lo < b.lo {
+					return -1
+				}
+				return 1
+			default:
+				return 0
+			}
+		},
+		MinValue: gasPrice{},
+	}
+}
+
+// Insert attempts to insert a Tx into the mempool, returning an error if the
+// transaction fee does not cover the current base fee.
+func (mp *FeeMarketMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	baseFee, err := mp.baseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := CheckBaseFee(tx, baseFee); err != nil {
+		return err
+	}
+
+	return mp.mp.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions in the mempool paying at
+// least the current base fee, ordered by effective tip and sender-nonce.
+func (mp *FeeMarketMempool) Select(ctx context.Context, txs []sdk.Tx) Iterator {
+	baseFee, err := mp.baseFee(ctx)
+	if err != nil {
+		return nil
+	}
+
+	iter := mp.mp.Select(ctx, txs)
+	if iter == nil {
+		return nil
+	}
+
+	return (&feeMarketIterator{Iterator: iter, baseFee: baseFee}).skipUnderpriced()
+}
+
+// SelectBy iterates over the transactions in the mempool paying at least the
+// current base fee, ordered by effective tip and sender-nonce. It holds the
+// mempool lock during the iteration and stops when the callback returns false.
+func (mp *FeeMarketMempool) SelectBy(ctx context.Context, txs []sdk.Tx, callback func(sdk.Tx) bool) {
+	baseFee, err := mp.baseFee(ctx)
+	if err != nil {
+		return
+	}
+
+	mp.mp.SelectBy(ctx, txs, func(tx sdk.Tx) bool {
+		if CheckBaseFee(tx, baseFee) != nil {
+			return true
+		}
+
+		return callback(tx)
+	})
+}
+
+// CountTx returns the number of transactions in the mempool, including the
+// ones currently priced below the base fee.
+func (mp *FeeMarketMempool) CountTx() int {
+	return mp.mp.CountTx()
+}
+
+// Remove removes a transaction from the mempool.
+func (mp *FeeMarketMempool) Remove(tx sdk.Tx) error {
+	return mp.mp.Remove(tx)
+}
+
+// GetTxPriority returns the gas price of the transaction, or false if the
+// transaction is not in the mempool.
+func (mp *FeeMarketMempool) GetTxPriority(tx sdk.Tx) (string, bool) {
+	price, ok := mp.mp.txPriority(tx)
+	if !ok {
+		return "", false
+	}
+
+	return price.dec().String(), true
+}
+
+// Next returns the next transaction paying at least the base fee.
+func (i *feeMarketIterator) Next() Iterator {
+	next := i.Iterator.Next()
+	if next == nil {
+		return nil
+	}
+
+	i.Iterator = next
+	return i.skipUnderpriced()
+}
+
+// skipUnderpriced advances the underlying iterator until it points at a
+// transaction paying at least the base fee.
+func (i *feeMarketIterator) skipUnderpriced() Iterator {
+	for CheckBaseFee(i.Tx(), i.baseFee) != nil {
+		next := i.Iterator.Next()
+		if next == nil {
+			return nil
+		}
+
+		i.Iterator = next
+	}
+
+	return i
+}
+
+// TxGasPrice returns the price per unit of gas paid by the transaction in the
+// given denomination. The transaction must implement sdk.FeeTx and declare a
+// positive gas limit.
+func TxGasPrice(tx sdk.Tx, denom string) (math.LegacyDec, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return math.LegacyDec{}, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must implement the FeeTx interface")
+	}
+
+	gas := feeTx.GetGas()
+	if gas == 0 {
+		return math.LegacyDec{}, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	amount := feeTx.GetFee().AmountOf(denom)
+	return math.LegacyNewDecFromInt(amount).QuoInt(math.NewIntFromUint64(gas)), nil
+}
+
+// CheckBaseFee returns an error if the fee of the transaction does not cover its
+// gas limit at the given base fee, i.e. if fee < ceil(baseFee * gasLimit).
+func CheckBaseFee(tx sdk.Tx, baseFee sdk.DecCoin) error {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must implement the FeeTx interface")
+	}
+
+	if baseFee.Amount.IsNil() || !baseFee.IsPositive() {
+		return nil
+	}
+
+	required := baseFee.Amount.MulInt(math.NewIntFromUint64(feeTx.GetGas())).Ceil().TruncateInt()
+	if got := feeTx.GetFee().AmountOf(baseFee.Denom); got.LT(required) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInsufficientFee,
+			"fee does not cover base fee; got: %s%s required: %s%s", got, baseFee.Denom, required, baseFee.Denom,
+		)
+	}
+
+	return nil
+}
+
+// maxUint128 is the largest value representable by gasPrice.
+var maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// newGasPrice converts a non-negative decimal into a gasPrice, saturating on
+// overflow.
+func newGasPrice(d math.LegacyDec) gasPrice {
+	if d.IsNil() || !d.IsPositive() {
+		return gasPrice{}
+	}
+
+	i := d.BigInt()
+	if i.Cmp(maxUint128) > 0 {
+		return gasPrice{hi: ^uint64(0), lo: ^uint64(0)}
+	}
+
+	var buf [16]byte
+	i.FillBytes(buf[:])
+
+	return gasPrice{hi: binary.BigEndian.Uint64(buf[:8]), lo: binary.BigEndian.Uint64(buf[8:])}
+}
+
+// dec converts the gasPrice back into a decimal.
+func (p gasPrice) dec() math.LegacyDec {
+	i := new(big.Int).SetUint64(p.hi)
+	i.Lsh(i, 64)
+	i.Or(i, new(big.Int).SetUint64(p.lo))
+
+	return math.LegacyNewDecFromBigIntWithPrec(i, math.LegacyPrecision)
+}
@@ -0,0 +1,155 @@
+package mempool_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// feeTestTx is a testTx carrying a fee and a gas limit.
+type feeTestTx struct {
+	testTx
+	fee sdk.Coins
+	gas uint64
+}
+
+var _ sdk.FeeTx = feeTestTx{}
+
+func (tx feeTestTx) GetGas() uint64 { return tx.gas }
+
+func (tx feeTestTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx feeTestTx) FeePayer() []byte { return tx.address }
+
+func (tx feeTestTx) FeeGranter() []byte { return nil }
+
+func newFeeTestTx(id int, addr sdk.AccAddress, nonce uint64, fee int64, gas uint64) feeTestTx {
+	return feeTestTx{
+		testTx: testTx{id: id, nonce: nonce, address: addr},
+		fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", fee)),
+		gas:    gas,
+	}
+}
+
+// staticBaseFee returns a BaseFeeFn backed by a pointer, so tests can move the
+// base fee between calls.
+func staticBaseFee(baseFee *math.LegacyDec) mempool.BaseFeeFn {
+	return func(context.Context) (sdk.DecCoin, error) {
+		return sdk.NewDecCoinFromDec("stake", *baseFee), nil
+	}
+}
+
+func fetchFeeTestTxs(iter mempool.Iterator) []int {
+	var ids []int
+	for iter != nil {
+		ids = append(ids, iter.Tx().(feeTestTx).id)
+		iter = iter.Next()
+	}
+
+	return ids
+}
+
+func TestFeeMarketMempool_Insert(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	baseFee := math.LegacyNewDecWithPrec(25, 3) // 0.025stake per unit of gas
+
+	mp := mempool.NewFeeMarketMempool(mempool.FeeMarketMempoolConfig{BaseFee: staticBaseFee(&baseFee)})
+
+	// 100_000 * 0.025 = 2500
+	err := mp.Insert(ctx, newFeeTestTx(0, accounts[0].Address, 0, 2499, 100_000))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	require.Equal(t, 0, mp.CountTx())
+
+	require.NoError(t, mp.Insert(ctx, newFeeTestTx(1, accounts[0].Address, 0, 2500, 100_000)))
+	require.Equal(t, 1, mp.CountTx())
+
+	// a tx which is not a FeeTx cannot be priced
+	err = mp.Insert(ctx, testTx{nonce: 1, address: accounts[0].Address})
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+}
+
+func TestFeeMarketMempool_SelectOrderAndBaseFee(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+	baseFee := math.LegacyNewDecWithPrec(1, 2)
+
+	mp := mempool.NewFeeMarketMempool(mempool.FeeMarketMempoolConfig{BaseFee: staticBaseFee(&baseFee)})
+
+	// gas prices: 0.02, 0.05, 0.03, 0.015 (sub-unit prices must not collapse)
+	txs := []feeTestTx{
+		newFeeTestTx(0, sa, 0, 2000, 100_000),
+		newFeeTestTx(1, sb, 0, 5000, 100_000),
+		newFeeTestTx(2, sc, 0, 3000, 100_000),
+		newFeeTestTx(3, sa, 1, 1500, 100_000),
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	require.Equal(t, []int{1, 2, 0, 3}, fetchFeeTestTxs(mp.Select(ctx, nil)))
+
+	// once the base fee rises above some txs they are skipped, but kept in the pool
+	baseFee = math.LegacyNewDecWithPrec(25, 3)
+	require.Equal(t, []int{1, 2}, fetchFeeTestTxs(mp.Select(ctx, nil)))
+	require.Equal(t, 4, mp.CountTx())
+
+	var selected []int
+	mp.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		selected = append(selected, tx.(feeTestTx).id)
+		return true
+	})
+	require.Equal(t, []int{1, 2}, selected)
+
+	baseFee = math.LegacyNewDec(1)
+	require.Nil(t, mp.Select(ctx, nil))
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestFeeMarketMempool_TxReplacement(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	baseFee := math.LegacyNewDecWithPrec(1, 2)
+
+	mp := mempool.NewFeeMarketMempool(mempool.FeeMarketMempoolConfig{
+		BaseFee: staticBaseFee(&baseFee),
+		// require a 10% gas price bump to replace a tx
+		TxReplacement: func(op, np math.LegacyDec, _, _ sdk.Tx) bool {
+			return np.GTE(op.Mul(math.LegacyNewDecWithPrec(11, 1)))
+		},
+	})
+
+	require.NoError(t, mp.Insert(ctx, newFeeTestTx(0, accounts[0].Address, 0, 2000, 100_000)))
+	require.Error(t, mp.Insert(ctx, newFeeTestTx(1, accounts[0].Address, 0, 2100, 100_000)))
+	require.NoError(t, mp.Insert(ctx, newFeeTestTx(2, accounts[0].Address, 0, 2200, 100_000)))
+
+	require.Equal(t, []int{2}, fetchFeeTestTxs(mp.Select(ctx, nil)))
+}
+
+func TestTxGasPrice(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	price, err := mempool.TxGasPrice(newFeeTestTx(0, accounts[0].Address, 0, 1, 3), "stake")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(1).QuoInt64(3), price)
+
+	price, err = mempool.TxGasPrice(newFeeTestTx(0, accounts[0].Address, 0, 1, 3), "atom")
+	require.NoError(t, err)
+	require.True(t, price.IsZero())
+
+	_, err = mempool.TxGasPrice(newFeeTestTx(0, accounts[0].Address, 0, 1, 0), "stake")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidGasLimit)
+}
@@ -0,0 +1,255 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Mempool          = (*LaneMempool)(nil)
+	_ TxPriorityGetter = (*LaneMempool)(nil)
+	_ Iterator         = (*laneIterator)(nil)
+)
+
+type (
+	// Lane defines a partition of the app-side mempool. Each lane owns its own
+	// sub-mempool, and is guaranteed a fraction of the block space when building
+	// a proposal.
+	Lane struct {
+		// Name is the unique name of the lane.
+		Name string
+
+		// Match returns true if the transaction belongs to the lane. A nil Match
+		// matches every transaction, which is typically used for the last
+		// (default) lane.
+		Match func(sdk.Tx) bool
+
+		// Mempool is the sub-mempool holding the transactions of the lane.
+		Mempool Mempool
+
+		// MaxBlockSpace is the maximum fraction of the block, both in bytes
+		// (MaxBytes) and in gas (MaxGas), the transactions of the lane may use.
+		// It must be in the (0, 1] range.
+		MaxBlockSpace math.LegacyDec
+	}
+
+	// LaneMempool is a composite mempool made of an ordered list of lanes.
+	// Transactions are routed to the first lane matching them.
+	//
+	// When used with the DefaultProposalHandler, lanes are filled in order, each
+	// lane being limited to its MaxBlockSpace. Since a lane is filled before the
+	// lanes that follow it, its share of the block cannot be taken by traffic of
+	// lower lanes, and the space it leaves unused falls through to the next lanes.
+	// In ProcessProposal, the handler verifies that the proposal respects both
+	// the lane ordering and the lane quotas.
+	LaneMempool struct {
+		lanes []Lane
+	}
+
+	// laneIterator iterates over the lanes of a LaneMempool in order.
+	laneIterator struct {
+		Iterator
+
+		ctx   context.Context
+		txs   []sdk.Tx
+		lanes []Lane
+		idx   int
+	}
+)
+
+// NewLaneMempool returns a new LaneMempool made of the given lanes, in priority
+// order. It panics if the lanes are misconfigured.
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	if len(lanes) == 0 {
+		panic("lane mempool requires at least one lane")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if err := lane.Validate(); err != nil {
+			panic(err)
+		}
+
+		if _, ok := names[lane.Name]; ok {
+			panic(fmt.Sprintf("duplicate lane %s", lane.Name))
+		}
+		names[lane.Name] = struct{}{}
+	}
+
+	return &LaneMempool{lanes: lanes}
+}
+
+// Validate performs a basic validation of the lane configuration.
+func (l Lane) Validate() error {
+	if l.Name == "" {
+		return fmt.Errorf("lane name cannot be empty")
+	}
+
+	if l.Mempool == nil {
+		return fmt.Errorf("lane %s: mempool cannot be nil", l.Name)
+	}
+
+	if l.MaxBlockSpace.IsNil() || !l.MaxBlockSpace.IsPositive() || l.MaxBlockSpace.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("lane %s: max block space must be in (0, 1], got %s", l.Name, l.MaxBlockSpace)
+	}
+
+	return nil
+}
+
+// Matches returns true if the transaction belongs to the lane.
+func (l Lane) Matches(tx sdk.Tx) bool {
+	return l.Match == nil || l.Match(tx)
+}
+
+// Limits returns the maximum number of bytes and gas the lane may use in a
+// block with the given limits. A zero maxBlockGas means the block gas is not
+// limited, in which case the lane gas is not limited either.
+func (l Lane) Limits(maxTxBytes, maxBlockGas uint64) (laneMaxTxBytes, laneMaxGas uint64) {
+	fraction := func(v uint64) uint64 {
+		return l.MaxBlockSpace.MulInt(math.NewIntFromUint64(v)).TruncateInt().Uint64()
+	}
+
+	return fraction(maxTxBytes), fraction(maxBlockGas)
+}
+
+// MatchMsgTypeURLs returns a lane Match function selecting the transactions
+// whose messages all have one of the given type URLs. Requiring every message to
+// match prevents bundling unrelated messages with a prioritized one in order to
+// get into its lane.
+func MatchMsgTypeURLs(typeURLs ...string) func(sdk.Tx) bool {
+	allowed := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		allowed[typeURL] = struct{}{}
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+
+		for _, msg := range msgs {
+			if _, ok := allowed[sdk.MsgTypeURL(msg)]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Lanes returns the lanes of the mempool, in priority order.
+func (mp *LaneMempool) Lanes() []Lane {
+	lanes := make([]Lane, len(mp.lanes))
+	copy(lanes, mp.lanes)
+	return lanes
+}
+
+// LaneIndex returns the index of the first lane matching the transaction.
+func (mp *LaneMempool) LaneIndex(tx sdk.Tx) (int, error) {
+	for i, lane := range mp.lanes {
+		if lane.Matches(tx) {
+			return i, nil
+		}
+	}
+
+	return -1, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no lane matches the transaction")
+}
+
+// Insert inserts the transaction into the first lane matching it.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	idx, err := mp.LaneIndex(tx)
+	if err != nil {
+		return err
+	}
+
+	return mp.lanes[idx].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over all the lanes, in lane order. Transactions of
+// a lane are iterated in the order defined by its sub-mempool.
+func (mp *LaneMempool) Select(ctx context.Context, txs []sdk.Tx) Iterator {
+	return (&laneIterator{ctx: ctx, txs: txs, lanes: mp.lanes, idx: -1}).nextLane()
+}
+
+// SelectBy iterates over all the lanes, in lane order, until the callback
+// returns false.
+func (mp *LaneMempool) SelectBy(ctx context.Context, txs []sdk.Tx, callback func(sdk.Tx) bool) {
+	stop := false
+	for _, lane := range mp.lanes {
+		lane.Mempool.SelectBy(ctx, txs, func(tx sdk.Tx) bool {
+			stop = !callback(tx)
+			return !stop
+		})
+
+		if stop {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of transactions in all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes the transaction from the lane matching it.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	idx, err := mp.LaneIndex(tx)
+	if err != nil {
+		return ErrTxNotFound
+	}
+
+	return mp.lanes[idx].Mempool.Remove(tx)
+}
+
+// GetTxPriority returns the priority of the transaction in its lane, provided
+// the lane mempool orders transactions by priority.
+func (mp *LaneMempool) GetTxPriority(tx sdk.Tx) (string, bool) {
+	idx, err := mp.LaneIndex(tx)
+	if err != nil {
+		return "", false
+	}
+
+	getter, ok := mp.lanes[idx].Mempool.(TxPriorityGetter)
+	if !ok {
+		return "", false
+	}
+
+	return getter.GetTxPriority(tx)
+}
+
+// Next returns the next transaction of the current lane, or the first
+// transaction of the next non-empty lane.
+func (i *laneIterator) Next() Iterator {
+	if next := i.Iterator.Next(); next != nil {
+		i.Iterator = next
+		return i
+	}
+
+	return i.nextLane()
+}
+
+// nextLane moves the iterator to the first transaction of the next non-empty
+// lane.
+func (i *laneIterator) nextLane() Iterator {
+	for i.idx++; i.idx < len(i.lanes); i.idx++ {
+		if iter := i.lanes[i.idx].Mempool.Select(i.ctx, i.txs); iter != nil {
+			i.Iterator = iter
+			return i
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// msgsTestTx is a testTx carrying messages.
+type msgsTestTx struct {
+	testTx
+	msgs []sdk.Msg
+}
+
+func (tx msgsTestTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func newTestLanes() (priority, fallback mempool.Lane) {
+	newPool := func() mempool.Mempool {
+		return mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			SignerExtractor: signerExtractionAdapter{},
+		})
+	}
+
+	priority = mempool.Lane{
+		Name:          "priority",
+		Match:         func(tx sdk.Tx) bool { return tx.(testTx).id >= 100 },
+		Mempool:       newPool(),
+		MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1),
+	}
+	fallback = mempool.Lane{
+		Name:          "default",
+		Mempool:       newPool(),
+		MaxBlockSpace: math.LegacyOneDec(),
+	}
+
+	return priority, fallback
+}
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	priority, fallback := newTestLanes()
+	mp := mempool.NewLaneMempool(priority, fallback)
+
+	txs := []testTx{
+		{id: 0, priority: 50, nonce: 0, address: accounts[0].Address},
+		{id: 100, priority: 1, nonce: 0, address: accounts[1].Address},
+		{id: 1, priority: 40, nonce: 0, address: accounts[2].Address},
+		{id: 101, priority: 2, nonce: 1, address: accounts[1].Address},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, priority.Mempool.CountTx())
+	require.Equal(t, 2, fallback.Mempool.CountTx())
+
+	idx, err := mp.LaneIndex(txs[1])
+	require.NoError(t, err)
+	require.Equal(t, 0, idx)
+
+	// lanes are iterated in order, whatever the priority of their txs
+	var ids []int
+	for _, tx := range fetchTxs(mp.Select(ctx, nil), 1000) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{100, 101, 0, 1}, ids)
+
+	// SelectBy stops across lanes
+	ids = nil
+	mp.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		ids = append(ids, tx.(testTx).id)
+		return len(ids) < 3
+	})
+	require.Equal(t, []int{100, 101, 0}, ids)
+
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Equal(t, 1, fallback.Mempool.CountTx())
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+}
+
+func TestLaneMempool_EmptyLanes(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	priority, fallback := newTestLanes()
+	mp := mempool.NewLaneMempool(priority, fallback)
+
+	require.Nil(t, mp.Select(ctx, nil))
+
+	require.NoError(t, mp.Insert(ctx, testTx{id: 0, address: accounts[0].Address}))
+	require.Len(t, fetchTxs(mp.Select(ctx, nil), 1000), 1)
+}
+
+func TestLaneMempool_NoMatchingLane(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	priority, _ := newTestLanes()
+	mp := mempool.NewLaneMempool(priority)
+
+	require.Error(t, mp.Insert(ctx, testTx{id: 0, address: accounts[0].Address}))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestNewLaneMempool_InvalidLanes(t *testing.T) {
+	priority, fallback := newTestLanes()
+
+	require.Panics(t, func() { mempool.NewLaneMempool() })
+	require.Panics(t, func() { mempool.NewLaneMempool(priority, priority) })
+
+	invalid := fallback
+	invalid.MaxBlockSpace = math.LegacyZeroDec()
+	require.Panics(t, func() { mempool.NewLaneMempool(invalid) })
+
+	invalid.MaxBlockSpace = math.LegacyNewDecWithPrec(11, 1)
+	require.Panics(t, func() { mempool.NewLaneMempool(invalid) })
+
+	invalid = fallback
+	invalid.Mempool = nil
+	require.Panics(t, func() { mempool.NewLaneMempool(invalid) })
+}
+
+func TestLaneLimits(t *testing.T) {
+	priority, _ := newTestLanes()
+
+	maxTxBytes, maxGas := priority.Limits(1000, 0)
+	require.Equal(t, uint64(200), maxTxBytes)
+	require.Equal(t, uint64(0), maxGas)
+
+	maxTxBytes, maxGas = priority.Limits(1001, 50_000)
+	require.Equal(t, uint64(200), maxTxBytes)
+	require.Equal(t, uint64(10_000), maxGas)
+}
+
+func TestMatchMsgTypeURLs(t *testing.T) {
+	match := mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&testdata.TestMsg{}))
+
+	require.True(t, match(msgsTestTx{msgs: []sdk.Msg{&testdata.TestMsg{}, &testdata.TestMsg{}}}))
+	require.False(t, match(msgsTestTx{msgs: []sdk.Msg{&testdata.TestMsg{}, &testdata.MsgCreateDog{}}}))
+	require.False(t, match(msgsTestTx{}))
+}
@@ -27,6 +27,14 @@ type Mempool interface {
 	Remove(sdk.Tx) error
 }
 
+// TxPriorityGetter is an optional interface implemented by mempools ordering
+// transactions by priority. It is used to introspect the mempool.
+type TxPriorityGetter interface {
+	// GetTxPriority returns the priority of a pooled transaction in a
+	// human-readable form, or false if the transaction is not in the mempool.
+	GetTxPriority(tx sdk.Tx) (string, bool)
+}
+
 // Iterator defines an app-side mempool iterator interface that is as minimal as
 // possible. The order of iteration is determined by the app-side mempool
 // implementation.
@@ -40,6 +48,7 @@ type Iterator interface {
 }
 
 var (
-	ErrTxNotFound           = errors.New("tx not found in mempool")
-	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrTxNotFound               = errors.New("tx not found in mempool")
+	ErrMempoolTxMaxCapacity     = errors.New("pool reached max tx capacity")
+	ErrMempoolSenderMaxCapacity = errors.New("sender reached max tx capacity")
 )
@@ -0,0 +1,94 @@
+package mempool
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EvictionPolicy defines how a PriorityNonceMempool makes room for a new
+// transaction once it holds MaxTx transactions.
+type EvictionPolicy int
+
+const (
+	// EvictionPolicyNone never evicts transactions, new transactions are
+	// rejected with ErrMempoolTxMaxCapacity while the mempool is full.
+	EvictionPolicyNone EvictionPolicy = iota
+
+	// EvictionPolicyLowestPriority evicts the transaction with the lowest
+	// priority, provided the new transaction has a strictly higher priority.
+	EvictionPolicyLowestPriority
+
+	// EvictionPolicyOldest evicts the transaction which has been in the mempool
+	// for the longest time.
+	EvictionPolicyOldest
+)
+
+var evictionPolicyNames = map[EvictionPolicy]string{
+	EvictionPolicyNone:           "none",
+	EvictionPolicyLowestPriority: "lowest-priority",
+	EvictionPolicyOldest:         "oldest",
+}
+
+// String implements fmt.Stringer.
+func (p EvictionPolicy) String() string {
+	if name, ok := evictionPolicyNames[p]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("EvictionPolicy(%d)", int(p))
+}
+
+// ParseEvictionPolicy parses an eviction policy from its name, as used in
+// app.toml. An empty name maps to EvictionPolicyNone.
+func ParseEvictionPolicy(name string) (EvictionPolicy, error) {
+	if name == "" {
+		return EvictionPolicyNone, nil
+	}
+
+	for p, n := range evictionPolicyNames {
+		if n == name {
+			return p, nil
+		}
+	}
+
+	return EvictionPolicyNone, fmt.Errorf("unknown mempool eviction policy %q, expected one of none, lowest-priority, oldest", name)
+}
+
+// NewFeeBumpReplacement returns a TxReplacement rule implementing
+// replace-by-fee: a transaction with the same sender and nonce as a pooled
+// transaction replaces it only if its gas price is at least minFeeBumpPercent
+// percent higher, in every denomination of the pooled transaction fee.
+//
+// Gas prices rather than fees are compared, so that a replacement cannot bump
+// its fee by raising its gas limit. Both transactions must implement
+// sdk.FeeTx and declare a positive gas limit, otherwise the replacement is
+// refused.
+func NewFeeBumpReplacement[C comparable](minFeeBumpPercent uint64) func(op, np C, oTx, nTx sdk.Tx) bool {
+	threshold := math.NewIntFromUint64(100 + minFeeBumpPercent)
+
+	return func(_, _ C, oTx, nTx sdk.Tx) bool {
+		oldTx, ok := oTx.(sdk.FeeTx)
+		if !ok || oldTx.GetGas() == 0 {
+			return false
+		}
+
+		newTx, ok := nTx.(sdk.FeeTx)
+		if !ok || newTx.GetGas() == 0 {
+			return false
+		}
+
+		oldGas, newGas := math.NewIntFromUint64(oldTx.GetGas()), math.NewIntFromUint64(newTx.GetGas())
+		newFee := newTx.GetFee()
+		for _, coin := range oldTx.GetFee() {
+			// newFee / newGas >= oldFee / oldGas * (100 + bump) / 100
+			if newFee.AmountOf(coin.Denom).Mul(oldGas).MulRaw(100).LT(coin.Amount.Mul(newGas).Mul(threshold)) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
@@ -13,8 +13,9 @@ import (
 )
 
 var (
-	_ Mempool  = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator = (*PriorityNonceIterator[int64])(nil)
+	_ Mempool          = (*PriorityNonceMempool[int64])(nil)
+	_ TxPriorityGetter = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator         = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -41,6 +42,17 @@ type (
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
 
+		// EvictionPolicy defines which transaction, if any, is evicted to make
+		// room for a new transaction once the mempool holds MaxTx transactions.
+		// The default, EvictionPolicyNone, rejects the new transaction with
+		// ErrMempoolTxMaxCapacity.
+		EvictionPolicy EvictionPolicy
+
+		// MaxTxPerSender caps the number of transactions a single sender may have
+		// in the mempool. A value <= 0 means there is no per-sender cap. Replacing
+		// an existing transaction of the sender is always allowed.
+		MaxTxPerSender int
+
 		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
 		SignerExtractor SignerExtractionAdapter
 	}
@@ -58,7 +70,14 @@ type (
 		priorityCounts map[C]int
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
-		cfg            PriorityNonceMempoolConfig[C]
+		// evictionIndex holds the last transaction (nonce-wise) of every
+		// sender, the only ones which can be evicted, ordered by eviction
+		// order. It is only maintained when an EvictionPolicy is set.
+		evictionIndex *skiplist.SkipList
+		tails         map[string]txMeta[C]
+		cfg           PriorityNonceMempoolConfig[C]
+		// seq is incremented on every insertion, it orders txs by age
+		seq uint64
 	}
 
 	// PriorityNonceIterator defines an iterator that is used for mempool iteration
@@ -100,6 +119,9 @@ type (
 		weight C
 		// senderElement is a pointer to the transaction's element in the sender index
 		senderElement *skiplist.Element
+		// seq is the insertion sequence of the transaction, used to evict the
+		// oldest transactions first
+		seq uint64
 	}
 )
 
@@ -158,6 +180,26 @@ func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Compara
 	})
 }
 
+// evictionComparable is a comparator for the txKeys of the eviction index,
+// ordering first the transactions evicted first: the oldest transactions, or
+// the oldest transactions of the lowest priority with
+// EvictionPolicyLowestPriority.
+func evictionComparable[C comparable](cfg PriorityNonceMempoolConfig[C]) skiplist.Comparable {
+	return skiplist.GreaterThanFunc(func(a, b any) int {
+		keyA := a.(txMeta[C])
+		keyB := b.(txMeta[C])
+
+		if cfg.EvictionPolicy == EvictionPolicyLowestPriority {
+			if res := cfg.TxPriority.Compare(keyA.priority, keyB.priority); res != 0 {
+				return res
+			}
+		}
+
+		// every insertion has its own sequence, so keys never collide
+		return skiplist.Uint64.Compare(keyA.seq, keyB.seq)
+	})
+}
+
 // NewPriorityMempool returns the SDK's default mempool implementation which
 // returns txs in a partial order by 2 dimensions; priority, and sender-nonce.
 func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *PriorityNonceMempool[C] {
@@ -171,6 +213,10 @@ func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *Priori
 		scores:         make(map[txMeta[C]]txMeta[C]),
 		cfg:            cfg,
 	}
+	if cfg.EvictionPolicy != EvictionPolicyNone {
+		mp.evictionIndex = skiplist.New(evictionComparable(cfg))
+		mp.tails = make(map[string]txMeta[C])
+	}
 
 	return mp
 }
@@ -201,13 +247,16 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 // O(log n) no-op.
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
-// changing the total order of the mempool.
+// changing the total order of the mempool, provided it satisfies the
+// TxReplacement rule.
+//
+// If the mempool is full, a transaction is evicted according to the
+// EvictionPolicy, or the new transaction is rejected with
+// ErrMempoolTxMaxCapacity.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
-	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
-		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
+	if mp.cfg.MaxTx < 0 {
 		return nil
 	}
 
@@ -233,7 +282,24 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 		}
 	}
 
-	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
+	sk := txMeta[C]{nonce: nonce, sender: sender}
+	oldScore, txExists := mp.scores[sk]
+
+	// capacity limits only apply to new txs, replacements do not grow the mempool
+	if !txExists {
+		if senderIndex, ok := mp.senderIndices[sender]; ok && mp.cfg.MaxTxPerSender > 0 && senderIndex.Len() >= mp.cfg.MaxTxPerSender {
+			return ErrMempoolSenderMaxCapacity
+		}
+
+		if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+			if err := mp.evict(sender, priority); err != nil {
+				return err
+			}
+		}
+	}
+
+	mp.seq++
+	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender, seq: mp.seq}
 
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
@@ -252,8 +318,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
+	if txExists {
 		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
 			return fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
@@ -279,12 +344,68 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	// existing key.
 	key.senderElement = senderIndex.Set(key, tx)
 
-	mp.scores[sk] = txMeta[C]{priority: priority}
+	mp.scores[sk] = txMeta[C]{priority: priority, seq: key.seq}
 	mp.priorityIndex.Set(key, tx)
+	mp.updateTail(sender)
 
 	return nil
 }
 
+// evict removes a transaction according to the eviction policy, in order to
+// make room for a new transaction of the given sender and priority, in
+// O(log n) time.
+//
+// Only the last transaction (nonce-wise) of a sender can be evicted, so that the
+// remaining transactions of every sender stay executable, and the transactions
+// of the sender of the new transaction are never evicted.
+func (mp *PriorityNonceMempool[C]) evict(sender string, priority C) error {
+	if mp.cfg.EvictionPolicy == EvictionPolicyNone {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	// the eviction index holds a single transaction per sender
+	candidate := mp.evictionIndex.Front()
+	if candidate != nil && candidate.Key().(txMeta[C]).sender == sender {
+		candidate = candidate.Next()
+	}
+	if candidate == nil {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	key := candidate.Key().(txMeta[C])
+
+	// a tx is only evicted in favor of a tx with a strictly higher priority
+	if mp.cfg.EvictionPolicy == EvictionPolicyLowestPriority && mp.cfg.TxPriority.Compare(key.priority, priority) >= 0 {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	return mp.remove(key.sender, key.nonce)
+}
+
+// updateTail indexes the current last transaction (nonce-wise) of sender in
+// the eviction index, in place of the previous one.
+func (mp *PriorityNonceMempool[C]) updateTail(sender string) {
+	if mp.evictionIndex == nil {
+		return
+	}
+
+	if tail, ok := mp.tails[sender]; ok {
+		mp.evictionIndex.Remove(tail)
+		delete(mp.tails, sender)
+	}
+
+	back := mp.senderIndices[sender].Back()
+	if back == nil {
+		return
+	}
+
+	nonce := back.Key().(txMeta[C]).nonce
+	score := mp.scores[txMeta[C]{nonce: nonce, sender: sender}]
+	tail := txMeta[C]{nonce: nonce, sender: sender, priority: score.priority, seq: score.seq}
+	mp.evictionIndex.Set(tail, nil)
+	mp.tails[sender] = tail
+}
+
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
 	// beginning of priority iteration
 	if i.priorityNode == nil {
@@ -452,17 +573,52 @@ func (mp *PriorityNonceMempool[C]) CountTx() int {
 	return mp.priorityIndex.Len()
 }
 
+// GetTxPriority returns the priority the transaction was inserted with, or
+// false if the transaction is not in the mempool.
+func (mp *PriorityNonceMempool[C]) GetTxPriority(tx sdk.Tx) (string, bool) {
+	priority, ok := mp.txPriority(tx)
+	if !ok {
+		return "", false
+	}
+
+	return fmt.Sprint(priority), true
+}
+
+// txPriority returns the priority the transaction was inserted with.
+func (mp *PriorityNonceMempool[C]) txPriority(tx sdk.Tx) (C, bool) {
+	var priority C
+	sender, nonce, err := mp.senderNonce(tx)
+	if err != nil {
+		return priority, false
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	score, ok := mp.scores[txMeta[C]{nonce: nonce, sender: sender}]
+	return score.priority, ok
+}
+
 // Remove removes a transaction from the mempool in O(log n) time, returning an
 // error if unsuccessful.
 func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
-	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	sender, nonce, err := mp.senderNonce(tx)
 	if err != nil {
 		return err
 	}
+
+	return mp.remove(sender, nonce)
+}
+
+// senderNonce returns the sender and nonce indexing the transaction.
+func (mp *PriorityNonceMempool[C]) senderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return "", 0, err
+	}
 	if len(sigs) == 0 {
-		return errors.New("attempted to remove a tx with no signatures")
+		return "", 0, errors.New("attempted to remove a tx with no signatures")
 	}
 
 	sig := sigs[0]
@@ -474,10 +630,16 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 		gasLimit, err := unordered.GetGasLimit()
 		nonce = gasLimit
 		if err != nil {
-			return err
+			return "", 0, err
 		}
 	}
 
+	return sender, nonce, nil
+}
+
+// remove removes the transaction of the given sender and nonce from the
+// mempool. The caller must hold the mempool lock.
+func (mp *PriorityNonceMempool[C]) remove(sender string, nonce uint64) error {
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
@@ -494,6 +656,7 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	mp.updateTail(sender)
 
 	return nil
 }
@@ -975,3 +975,227 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestPriorityNonceMempool_Eviction(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	sa, sb, sc, sd := accounts[0].Address, accounts[1].Address, accounts[2].Address, accounts[3].Address
+
+	// sa has 2 txs, only its last one (nonce-wise) can be evicted
+	txs := []testTx{
+		{id: 0, priority: 5, nonce: 1, address: sa},
+		{id: 1, priority: 20, nonce: 2, address: sa},
+		{id: 2, priority: 10, nonce: 1, address: sb},
+		{id: 3, priority: 15, nonce: 1, address: sc},
+	}
+
+	newMempool := func(policy mempool.EvictionPolicy) *mempool.PriorityNonceMempool[int64] {
+		mp := mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxTx:           4,
+			EvictionPolicy:  policy,
+			SignerExtractor: signerExtractionAdapter{},
+		})
+		for _, tx := range txs {
+			require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+		}
+
+		return mp
+	}
+
+	selectIDs := func(mp mempool.Mempool) []int {
+		var ids []int
+		for _, tx := range fetchTxs(mp.Select(ctx, nil), 1000) {
+			ids = append(ids, tx.(testTx).id)
+		}
+		return ids
+	}
+
+	testCases := map[string]struct {
+		policy   mempool.EvictionPolicy
+		tx       testTx
+		expErr   error
+		expected []int
+	}{
+		"none: a full mempool rejects new txs": {
+			policy:   mempool.EvictionPolicyNone,
+			tx:       testTx{id: 4, priority: 100, nonce: 1, address: sd},
+			expErr:   mempool.ErrMempoolTxMaxCapacity,
+			expected: []int{3, 2, 0, 1},
+		},
+		"lowest-priority: evicts the lowest priority sender tail": {
+			policy:   mempool.EvictionPolicyLowestPriority,
+			tx:       testTx{id: 4, priority: 100, nonce: 1, address: sd},
+			expected: []int{4, 3, 0, 1},
+		},
+		"lowest-priority: rejects a tx not paying more than the evictable txs": {
+			policy:   mempool.EvictionPolicyLowestPriority,
+			tx:       testTx{id: 4, priority: 10, nonce: 1, address: sd},
+			expErr:   mempool.ErrMempoolTxMaxCapacity,
+			expected: []int{3, 2, 0, 1},
+		},
+		"lowest-priority: never evicts the txs of the same sender": {
+			policy:   mempool.EvictionPolicyLowestPriority,
+			tx:       testTx{id: 4, priority: 100, nonce: 2, address: sb},
+			expected: []int{2, 4, 0, 1},
+		},
+		"oldest: evicts the oldest sender tail": {
+			policy:   mempool.EvictionPolicyOldest,
+			tx:       testTx{id: 4, priority: 1, nonce: 1, address: sd},
+			expected: []int{3, 2, 0, 4},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mp := newMempool(tc.policy)
+
+			err := mp.Insert(ctx.WithPriority(tc.tx.priority), tc.tx)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, 4, mp.CountTx())
+			require.Equal(t, tc.expected, selectIDs(mp))
+		})
+	}
+}
+
+func TestPriorityNonceMempool_EvictionTails(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 5)
+	sa, sb, sc, sd, se := accounts[0].Address, accounts[1].Address, accounts[2].Address, accounts[3].Address, accounts[4].Address
+
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		MaxTx:           3,
+		EvictionPolicy:  mempool.EvictionPolicyLowestPriority,
+		SignerExtractor: signerExtractionAdapter{},
+	})
+	insert := func(tx testTx) error {
+		return mp.Insert(ctx.WithPriority(tx.priority), tx)
+	}
+	// priority returns the priority of the tx of the same sender and nonce as tx
+	priority := func(tx testTx) string {
+		p, _ := mp.GetTxPriority(tx)
+		return p
+	}
+
+	txs := []testTx{
+		{id: 0, priority: 5, nonce: 1, address: sa},
+		{id: 1, priority: 1, nonce: 2, address: sa},
+		{id: 2, priority: 10, nonce: 1, address: sb},
+		{id: 3, priority: 20, nonce: 1, address: sc},
+		{id: 4, priority: 20, nonce: 1, address: sd},
+		{id: 5, priority: 30, nonce: 1, address: sb},
+		{id: 6, priority: 20, nonce: 1, address: se},
+		{id: 7, priority: 25, nonce: 1, address: se},
+	}
+	for _, tx := range txs[:3] {
+		require.NoError(t, insert(tx))
+	}
+
+	// the tail of sa is evicted, then the tx before it becomes its tail
+	require.NoError(t, insert(txs[3]))
+	require.Empty(t, priority(txs[1]))
+	require.Equal(t, "5", priority(txs[0]))
+	require.NoError(t, insert(txs[4]))
+	require.Empty(t, priority(txs[0]))
+	require.Equal(t, 3, mp.CountTx())
+
+	// replacing a tail updates its priority
+	require.NoError(t, insert(txs[5]))
+	require.Equal(t, "30", priority(txs[5]))
+	require.ErrorIs(t, insert(txs[6]), mempool.ErrMempoolTxMaxCapacity)
+
+	// the oldest of the lowest priority tails is evicted
+	require.NoError(t, insert(txs[7]))
+	require.Empty(t, priority(txs[3]))
+	require.Equal(t, "20", priority(txs[4]))
+	require.Equal(t, "25", priority(txs[7]))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_ReplacementWhenFull(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		MaxTx:           1,
+		SignerExtractor: signerExtractionAdapter{},
+	})
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), testTx{id: 0, priority: 1, nonce: 1, address: accounts[0].Address}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(2), testTx{id: 1, priority: 2, nonce: 1, address: accounts[0].Address}))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, 1, mp.Select(ctx, nil).Tx().(testTx).id)
+}
+
+func TestPriorityNonceMempool_MaxTxPerSender(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		MaxTxPerSender:  2,
+		SignerExtractor: signerExtractionAdapter{},
+	})
+
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 2, address: sa}))
+	require.ErrorIs(t, mp.Insert(ctx, testTx{nonce: 3, address: sa}), mempool.ErrMempoolSenderMaxCapacity)
+
+	// replacements and other senders are not affected
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{nonce: 2, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sb}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestNewFeeBumpReplacement(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	sa := accounts[0].Address
+
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		TxReplacement:   mempool.NewFeeBumpReplacement[int64](10),
+		SignerExtractor: signerExtractionAdapter{},
+	})
+
+	require.NoError(t, mp.Insert(ctx, newFeeTestTx(0, sa, 1, 1000, 100_000)))
+
+	// 9% bump
+	require.Error(t, mp.Insert(ctx, newFeeTestTx(1, sa, 1, 1090, 100_000)))
+	// 10% higher fee, but the same gas price
+	require.Error(t, mp.Insert(ctx, newFeeTestTx(2, sa, 1, 1100, 110_000)))
+	// 10% bump
+	require.NoError(t, mp.Insert(ctx, newFeeTestTx(3, sa, 1, 1100, 100_000)))
+	// non fee txs cannot replace a tx
+	require.Error(t, mp.Insert(ctx, testTx{id: 4, nonce: 1, address: sa}))
+
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, 3, mp.Select(ctx, nil).Tx().(feeTestTx).id)
+}
+
+func TestParseEvictionPolicy(t *testing.T) {
+	for _, policy := range []mempool.EvictionPolicy{
+		mempool.EvictionPolicyNone,
+		mempool.EvictionPolicyLowestPriority,
+		mempool.EvictionPolicyOldest,
+	} {
+		parsed, err := mempool.ParseEvictionPolicy(policy.String())
+		require.NoError(t, err)
+		require.Equal(t, policy, parsed)
+	}
+
+	policy, err := mempool.ParseEvictionPolicy("")
+	require.NoError(t, err)
+	require.Equal(t, mempool.EvictionPolicyNone, policy)
+
+	_, err = mempool.ParseEvictionPolicy("newest")
+	require.Error(t, err)
+}
@@ -137,6 +137,14 @@ func (d *UnorderedTxDecorator) ValidateTx(ctx context.Context, tx transaction.Tx
 		)
 	}
 	if d.env.TransactionService.ExecMode(ctx) == transaction.ExecModeFinalize {
+		if exec, ok := sdk.UncommittedExecutionFromContext(ctx); ok {
+			// the execution is not committed as is, so leave the manager
+			// untouched. Its outcome depends on the hashes added by the txs
+			// committed before it though, so it cannot be committed either.
+			exec.Invalidate()
+			return nil
+		}
+
 		// a new tx included in the block, add the hash to the unordered tx manager
 		d.txManager.Add(txHash, timeoutTimestamp)
 	}
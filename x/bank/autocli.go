@@ -87,6 +87,48 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata <denom>",
+					Short:          "Query the admin of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomMetadataHistory",
+					Use:            "denom-metadata-history <denom>",
+					Short:          "Query the changes of the client metadata of a coin denomination, oldest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "Holds",
+					Use:            "holds <address>",
+					Short:          "Query the coins held by modules in the balance of an account, with the reason of each hold",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Outflows",
+					Use:            "outflows <address>",
+					Short:          "Query the amounts sent by an account over its current outflow quota periods",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SupplyAt",
+					Use:            "supply-at <height>",
+					Short:          "Query the latest supply snapshot recorded at or before a height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "DenomHolderCount",
+					Use:            "denom-holder-count <denom>",
+					Short:          "Query the number of accounts holding a denom, currently or at a height with --height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator <creator>",
+					Short:          "Query the factory denoms created by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -128,6 +170,47 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata-proposal <metadata>",
+					Short:          "Submit a proposal to set the client metadata of a coin denomination",
+					Example:        fmt.Sprintf(`%s tx bank set-denom-metadata-proposal '{"base":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2","display":"atom","name":"Cosmos Hub Atom","symbol":"ATOM","denom_units":[{"denom":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},{"denom":"atom","exponent":6}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom <subdenom> --from <sender>",
+					Short:          "Create the factory denom factory/{sender}/{subdenom}, paying the denom creation fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "MintDenom",
+					Use:            "mint-denom <amount> --from <admin>",
+					Short:          "Mint a factory denom, to the admin unless --mint-to-address is set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "BurnDenom",
+					Use:            "burn-denom <amount> --from <admin>",
+					Short:          "Burn a factory denom from the balance of its admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ChangeDenomAdmin",
+					Use:            "change-denom-admin <denom> <new_admin> --from <admin>",
+					Short:          "Change the admin of a factory denom",
+					Long:           "Change the admin of a factory denom. An empty new admin renounces the admin role, after which the denom can no longer be minted.",
+					Example:        fmt.Sprintf(`%s tx bank change-denom-admin factory/cosmos1.../uatom cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "SetFactoryDenomMetadata",
+					Use:            "set-factory-denom-metadata <metadata> --from <admin>",
+					Short:          "Set the metadata of a factory denom",
+					Example:        fmt.Sprintf(`%s tx bank set-factory-denom-metadata '{"base":"factory/cosmos1.../uatom","display":"atom","name":"Atom","symbol":"ATOM","denom_units":[{"denom":"factory/cosmos1.../uatom"},{"denom":"atom","exponent":6}]}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
 			},
 		},
 	}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateFactoryDenom creates the factory denom factory/{creator}/{subdenom},
+// whose admin is the creator, and returns it. The denom creation fee set in the
+// params is burned from the creator.
+func (k BaseKeeper) CreateFactoryDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.addrCdc.BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.NewFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.DenomAuthorities.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if has || k.HasSupply(ctx, denom) || k.HasDenomMetaData(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrFactoryDenomExists, "denom %s", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.BurnCoins(ctx, creator, fee); err != nil {
+			return "", errorsmod.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	if err := k.DenomAuthorities.Set(ctx, denom, types.DenomAuthorityMetadata{Admin: creatorStr}); err != nil {
+		return "", err
+	}
+	k.SetDenomMetaData(ctx, types.NewFactoryDenomMetadata(denom, subdenom))
+
+	return denom, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDenom,
+		event.NewAttribute(types.AttributeKeyCreator, creatorStr),
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+	)
+}
+
+// GetDenomAuthorityMetadata returns the admin of a factory denom.
+func (k BaseKeeper) GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.DenomAuthorities.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomAuthorityMetadata{}, errorsmod.Wrapf(types.ErrFactoryDenomNotFound, "denom %s", denom)
+	}
+
+	return metadata, err
+}
+
+// GetFactoryDenomsFromCreator returns the factory denoms created by an account.
+func (k BaseKeeper) GetFactoryDenomsFromCreator(ctx context.Context, creator sdk.AccAddress) ([]string, error) {
+	creatorStr, err := k.addrCdc.BytesToString(creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the denoms of a creator are the keys in [factory/{creator}/, factory/{creator}0),
+	// '0' being the character following '/'
+	prefix := types.FactoryDenomPrefix + "/" + creatorStr
+	rng := new(collections.Range[string]).StartInclusive(prefix + "/").EndExclusive(prefix + "0")
+
+	denoms := make([]string, 0)
+	err = k.DenomAuthorities.Walk(ctx, rng, func(denom string, _ types.DenomAuthorityMetadata) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+
+	return denoms, err
+}
+
+// MintFactoryCoins mints a factory denom to the recipient, which must be
+// requested by the admin of the denom. The send restrictions and the denylist
+// are applied to the coins as if they were sent by the admin.
+func (k BaseKeeper) MintFactoryCoins(ctx context.Context, admin, recipient sdk.AccAddress, amt sdk.Coin) error {
+	adminStr, err := k.checkFactoryDenomAdmin(ctx, amt.Denom, admin)
+	if err != nil {
+		return err
+	}
+
+	if !amt.IsValid() || !amt.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	if k.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	coins := sdk.NewCoins(amt)
+	recipient, err = k.sendRestriction.apply(ctx, admin, recipient, coins)
+	if err != nil {
+		return err
+	}
+
+	// the minted coins are neither taxed nor counted in the outflow quotas
+	if err := k.checkDenylist(ctx, k.GetParams(ctx), admin, recipient, coins); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, recipient, coins); err != nil {
+		return err
+	}
+	k.setSupply(ctx, k.GetSupply(ctx, amt.Denom).Add(amt))
+
+	k.Logger.Debug("minted factory coins", "amount", amt.String(), "admin", adminStr)
+
+	// emit mint event
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinMint,
+		event.NewAttribute(types.AttributeKeyMinter, adminStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
+
+// BurnFactoryCoins burns a factory denom from the balance of its admin.
+func (k BaseKeeper) BurnFactoryCoins(ctx context.Context, admin sdk.AccAddress, amt sdk.Coin) error {
+	if _, err := k.checkFactoryDenomAdmin(ctx, amt.Denom, admin); err != nil {
+		return err
+	}
+
+	if !amt.IsValid() || !amt.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	return k.BurnCoins(ctx, admin, sdk.NewCoins(amt))
+}
+
+// ChangeFactoryDenomAdmin changes the admin of a factory denom, which must be
+// requested by its current admin. An empty new admin renounces the admin role.
+func (k BaseKeeper) ChangeFactoryDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error {
+	adminStr, err := k.checkFactoryDenomAdmin(ctx, denom, admin)
+	if err != nil {
+		return err
+	}
+
+	if newAdmin != "" {
+		if _, err := k.addrCdc.StringToBytes(newAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	if err := k.DenomAuthorities.Set(ctx, denom, types.DenomAuthorityMetadata{Admin: newAdmin}); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeChangeDenomAdmin,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeyAdmin, adminStr),
+		event.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+	)
+}
+
+// SetFactoryDenomMetadata sets the metadata of a factory denom, which must be
+// requested by its admin.
+func (k BaseKeeper) SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error {
+	adminStr, err := k.checkFactoryDenomAdmin(ctx, metadata.Base, admin)
+	if err != nil {
+		return err
+	}
+
+	return k.UpdateDenomMetaData(ctx, adminStr, metadata)
+}
+
+// checkFactoryDenomAdmin checks that the given address is the admin of the
+// factory denom, and returns it as a string.
+func (k BaseKeeper) checkFactoryDenomAdmin(ctx context.Context, denom string, admin sdk.AccAddress) (string, error) {
+	metadata, err := k.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	adminStr, err := k.addrCdc.BytesToString(admin)
+	if err != nil {
+		return "", err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != adminStr {
+		return "", errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of denom %s", adminStr, denom)
+	}
+
+	return adminStr, nil
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestFactoryDenom() {
+	ctx, require := suite.ctx, suite.Require()
+
+	creator := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	creatorAddr, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+	otherAddr, err := suite.addrCdc.BytesToString(accAddrs[1])
+	require.NoError(err)
+	blockedAddr, err := suite.addrCdc.BytesToString(accAddrs[4])
+	require.NoError(err)
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(creator).AnyTimes()
+
+	// the creation fee is burned from the creator
+	fee := sdk.NewCoins(newFooCoin(100))
+	params := suite.bankKeeper.GetParams(ctx)
+	params.DenomCreationFee = fee
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(creatorAddr, "token"))
+	require.ErrorContains(err, "failed to pay the denom creation fee")
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], fee))
+
+	res, err := suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(creatorAddr, "token"))
+	require.NoError(err)
+	denom := "factory/" + creatorAddr + "/token"
+	require.Equal(denom, res.NewTokenDenom)
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]).IsZero())
+	require.True(suite.bankKeeper.GetSupply(ctx, fooDenom).IsZero())
+
+	metadata, found := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.NoError(metadata.Validate())
+
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(creatorAddr, "token"))
+	require.ErrorIs(err, banktypes.ErrFactoryDenomExists)
+
+	// only the admin can mint, and the recipient must be allowed to receive funds
+	amt := sdk.NewInt64Coin(denom, 1000)
+	_, err = suite.msgServer.MintDenom(ctx, banktypes.NewMsgMintDenom(otherAddr, amt, ""))
+	require.ErrorContains(err, "is not the admin of denom")
+	_, err = suite.msgServer.MintDenom(ctx, banktypes.NewMsgMintDenom(creatorAddr, amt, blockedAddr))
+	require.ErrorContains(err, "is not allowed to receive funds")
+	_, err = suite.msgServer.MintDenom(ctx, banktypes.NewMsgMintDenom(creatorAddr, sdk.NewInt64Coin(fooDenom, 1), ""))
+	require.ErrorIs(err, banktypes.ErrFactoryDenomNotFound)
+
+	_, err = suite.msgServer.MintDenom(ctx, banktypes.NewMsgMintDenom(creatorAddr, amt, ""))
+	require.NoError(err)
+	_, err = suite.msgServer.MintDenom(ctx, banktypes.NewMsgMintDenom(creatorAddr, amt, otherAddr))
+	require.NoError(err)
+	require.Equal(amt, suite.bankKeeper.GetBalance(ctx, accAddrs[0], denom))
+	require.Equal(amt, suite.bankKeeper.GetBalance(ctx, accAddrs[1], denom))
+	require.Equal(amt.Add(amt), suite.bankKeeper.GetSupply(ctx, denom))
+
+	// the send restrictions apply to the minted coins
+	suite.bankKeeper.AppendSendRestriction(func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, errors.New("restricted")
+	})
+	_, err = suite.msgServer.MintDenom(ctx, banktypes.NewMsgMintDenom(creatorAddr, amt, otherAddr))
+	require.ErrorContains(err, "restricted")
+	suite.bankKeeper.ClearSendRestriction()
+
+	// the admin burns from its own balance
+	_, err = suite.msgServer.BurnDenom(ctx, banktypes.NewMsgBurnDenom(creatorAddr, sdk.NewInt64Coin(denom, 400)))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 600), suite.bankKeeper.GetBalance(ctx, accAddrs[0], denom))
+	require.Equal(sdk.NewInt64Coin(denom, 1600), suite.bankKeeper.GetSupply(ctx, denom))
+
+	// the admin sets the metadata of the denom only
+	metadata = banktypes.Metadata{
+		Name:       "Token",
+		Symbol:     "TKN",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}, {Denom: "tkn", Exponent: 6}},
+		Base:       denom,
+		Display:    "tkn",
+	}
+	_, err = suite.msgServer.SetFactoryDenomMetadata(ctx, banktypes.NewMsgSetFactoryDenomMetadata(otherAddr, metadata))
+	require.ErrorContains(err, "is not the admin of denom")
+	_, err = suite.msgServer.SetFactoryDenomMetadata(ctx, banktypes.NewMsgSetFactoryDenomMetadata(creatorAddr, banktypes.Metadata{Base: denom}))
+	require.ErrorContains(err, "name field cannot be blank")
+	_, err = suite.msgServer.SetFactoryDenomMetadata(ctx, banktypes.NewMsgSetFactoryDenomMetadata(creatorAddr, metadata))
+	require.NoError(err)
+	actual, found := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.Equal(metadata, actual)
+	history, err := suite.queryClient.DenomMetadataHistory(ctx, &banktypes.QueryDenomMetadataHistoryRequest{Denom: denom})
+	require.NoError(err)
+	require.Len(history.Changes, 1)
+	require.Equal(creatorAddr, history.Changes[0].Updater)
+
+	// the admin role is transferred, then renounced
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(creatorAddr, denom, "invalid"))
+	require.ErrorContains(err, "invalid new admin address")
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(creatorAddr, denom, otherAddr))
+	require.NoError(err)
+	_, err = suite.msgServer.MintDenom(ctx, banktypes.NewMsgMintDenom(creatorAddr, amt, ""))
+	require.ErrorContains(err, "is not the admin of denom")
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(otherAddr, denom, ""))
+	require.NoError(err)
+	_, err = suite.msgServer.MintDenom(ctx, banktypes.NewMsgMintDenom(otherAddr, amt, ""))
+	require.ErrorContains(err, "is not the admin of denom")
+
+	authority, err := suite.bankKeeper.GetDenomAuthorityMetadata(ctx, denom)
+	require.NoError(err)
+	require.Equal(banktypes.DenomAuthorityMetadata{}, authority)
+}
+
+func (suite *KeeperTestSuite) TestQueryFactoryDenoms() {
+	ctx, require := suite.ctx, suite.Require()
+
+	creatorAddr, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+	otherAddr, err := suite.addrCdc.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	for _, msg := range []*banktypes.MsgCreateDenom{
+		banktypes.NewMsgCreateDenom(creatorAddr, "b"),
+		banktypes.NewMsgCreateDenom(creatorAddr, "a"),
+		banktypes.NewMsgCreateDenom(otherAddr, "a"),
+	} {
+		_, err := suite.msgServer.CreateDenom(ctx, msg)
+		require.NoError(err)
+	}
+
+	res, err := suite.queryClient.DenomsFromCreator(ctx, &banktypes.QueryDenomsFromCreatorRequest{Creator: creatorAddr})
+	require.NoError(err)
+	require.Equal([]string{"factory/" + creatorAddr + "/a", "factory/" + creatorAddr + "/b"}, res.Denoms)
+
+	authority, err := suite.queryClient.DenomAuthorityMetadata(ctx, &banktypes.QueryDenomAuthorityMetadataRequest{Denom: "factory/" + otherAddr + "/a"})
+	require.NoError(err)
+	require.Equal(otherAddr, authority.AuthorityMetadata.Admin)
+
+	_, err = suite.queryClient.DenomAuthorityMetadata(ctx, &banktypes.QueryDenomAuthorityMetadataRequest{Denom: "factory/" + otherAddr + "/b"})
+	require.ErrorContains(err, "not found")
+	_, err = suite.queryClient.DenomAuthorityMetadata(ctx, &banktypes.QueryDenomAuthorityMetadataRequest{Denom: fooDenom})
+	require.ErrorContains(err, "invalid factory denom")
+
+	genesis, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genesis.FactoryDenoms, 3)
+	require.NoError(genesis.Validate())
+}
@@ -57,7 +57,52 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
-	return nil
+
+	for _, fd := range genState.FactoryDenoms {
+		if admin := fd.AuthorityMetadata.Admin; admin != "" {
+			if _, err := k.addrCdc.StringToBytes(admin); err != nil {
+				return fmt.Errorf("invalid admin of factory denom %s: %w", fd.Denom, err)
+			}
+		}
+
+		if err := k.DenomAuthorities.Set(ctx, fd.Denom, fd.AuthorityMetadata); err != nil {
+			return err
+		}
+	}
+
+	for _, hold := range genState.Holds {
+		addr, err := k.addrCdc.StringToBytes(hold.Address)
+		if err != nil {
+			return fmt.Errorf("invalid address of hold by %s: %w", hold.Holder, err)
+		}
+
+		if err := k.BaseViewKeeper.Holds.Set(ctx, collections.Join3(sdk.AccAddress(addr), hold.Holder, hold.Reason), hold); err != nil {
+			return err
+		}
+		if err := k.updateHoldCount(ctx, sdk.AccAddress(addr), true); err != nil {
+			return err
+		}
+	}
+
+	for _, outflow := range genState.Outflows {
+		addr, err := k.addrCdc.StringToBytes(outflow.Address)
+		if err != nil {
+			return fmt.Errorf("invalid address of outflow of %s: %w", outflow.Denom, err)
+		}
+
+		if err := k.BaseViewKeeper.Outflows.Set(ctx, collections.Join(sdk.AccAddress(addr), outflow.Denom), outflow); err != nil {
+			return err
+		}
+	}
+
+	for _, snapshot := range genState.SupplySnapshots {
+		if err := k.SupplySnapshots.Set(ctx, snapshot.Height, snapshot); err != nil {
+			return err
+		}
+	}
+
+	// the current holder counts are not exported, but counted from the balances
+	return k.setDenomHolderCounts(ctx)
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -74,5 +119,38 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	err = k.DenomAuthorities.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) (bool, error) {
+		rv.FactoryDenoms = append(rv.FactoryDenoms, types.FactoryDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.BaseViewKeeper.Holds.Walk(ctx, nil, func(_ collections.Triple[sdk.AccAddress, string, string], hold types.Hold) (bool, error) {
+		rv.Holds = append(rv.Holds, hold)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.BaseViewKeeper.Outflows.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], outflow types.Outflow) (bool, error) {
+		rv.Outflows = append(rv.Outflows, outflow)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SupplySnapshots.Walk(ctx, nil, func(_ int64, snapshot types.SupplySnapshot) (bool, error) {
+		rv.SupplySnapshots = append(rv.SupplySnapshots, snapshot)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return rv, nil
 }
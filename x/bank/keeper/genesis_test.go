@@ -64,12 +64,18 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	m := types.Metadata{Description: sdk.DefaultBondDenom, Base: sdk.DefaultBondDenom, Display: sdk.DefaultBondDenom}
 	g := types.DefaultGenesisState()
 	g.DenomMetadata = []types.Metadata{m}
+	addr, err := suite.addrCdc.BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	g.Holds = []types.Hold{{Address: addr, Holder: holder, Reason: "bid", Amount: sdk.NewCoins(newFooCoin(10))}}
 	bk := suite.bankKeeper
 	suite.Require().NoError(bk.InitGenesis(suite.ctx, g))
 
 	m2, found := bk.GetDenomMetaData(suite.ctx, m.Base)
 	suite.Require().True(found)
 	suite.Require().Equal(m, m2)
+
+	// the imported holds are counted
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), bk.GetHeldCoins(suite.ctx, accAddrs[0]))
 }
 
 func (suite *KeeperTestSuite) TestTotalSupply() {
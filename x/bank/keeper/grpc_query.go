@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -354,3 +355,184 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// DenomAuthorityMetadata implements the Query/DenomAuthorityMetadata gRPC method.
+func (k BaseKeeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, _, err := types.ParseFactoryDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metadata, err := k.DenomAuthorities.Get(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "factory denom %s not found", req.Denom)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator implements the Query/DenomsFromCreator gRPC method.
+func (k BaseKeeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := k.addrCdc.StringToBytes(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err.Error())
+	}
+
+	denoms, err := k.GetFactoryDenomsFromCreator(ctx, creator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
+}
+
+// DenomMetadataHistory implements the Query/DenomMetadataHistory gRPC method.
+func (k BaseKeeper) DenomMetadataHistory(ctx context.Context, req *types.QueryDenomMetadataHistoryRequest) (*types.QueryDenomMetadataHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.DenomMetadataHistory,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], change types.DenomMetadataChange) (types.DenomMetadataChange, error) {
+			return change, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomMetadataHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// Holds implements the Query/Holds gRPC method.
+func (k BaseKeeper) Holds(ctx context.Context, req *types.QueryHoldsRequest) (*types.QueryHoldsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.addrCdc.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	holds, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.Holds,
+		req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, string, string], hold types.Hold) (types.Hold, error) {
+			return hold, nil
+		},
+		query.WithCollectionPaginationTriplePrefix[sdk.AccAddress, string, string](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHoldsResponse{Holds: holds, Pagination: pageRes}, nil
+}
+
+// Outflows implements the Query/Outflows gRPC method.
+func (k BaseKeeper) Outflows(ctx context.Context, req *types.QueryOutflowsRequest) (*types.QueryOutflowsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.addrCdc.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	outflows, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.Outflows,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, string], outflow types.Outflow) (types.Outflow, error) {
+			return outflow, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOutflowsResponse{Outflows: outflows, Pagination: pageRes}, nil
+}
+
+// SupplyAt implements the Query/SupplyAt gRPC method.
+func (k BaseKeeper) SupplyAt(ctx context.Context, req *types.QuerySupplyAtRequest) (*types.QuerySupplyAtResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height: %d", req.Height)
+	}
+
+	snapshot, err := k.GetSupplySnapshot(ctx, req.Height)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no supply snapshot at or before height %d", req.Height)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyAtResponse{Snapshot: snapshot}, nil
+}
+
+// DenomHolderCount implements the Query/DenomHolderCount gRPC method.
+func (k BaseKeeper) DenomHolderCount(ctx context.Context, req *types.QueryDenomHolderCountRequest) (*types.QueryDenomHolderCountResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height: %d", req.Height)
+	}
+
+	// a zero height queries the current number of holders
+	if req.Height == 0 {
+		count, err := k.GetDenomHolderCount(ctx, req.Denom)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &types.QueryDenomHolderCountResponse{Count: count, Height: k.HeaderService.HeaderInfo(ctx).Height}, nil
+	}
+
+	snapshot, err := k.GetSupplySnapshot(ctx, req.Height)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no supply snapshot at or before height %d", req.Height)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	count, ok := snapshot.GetHolderCount(req.Denom)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s is not in the supply snapshot at height %d", req.Denom, snapshot.Height)
+	}
+
+	return &types.QueryDenomHolderCountResponse{Count: count, Height: snapshot.Height}, nil
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetHeldCoins returns the coins held by modules in the balance of an account.
+func (k BaseViewKeeper) GetHeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	held := sdk.NewCoins()
+
+	// the holds are only walked if the account has some, as the held coins are
+	// checked on each spendable balance check
+	hasHolds, err := k.HoldCounts.Has(ctx, addr)
+	if err != nil {
+		panic(err)
+	}
+	if !hasHolds {
+		return held
+	}
+
+	err = k.Holds.Walk(ctx, collections.NewPrefixedTripleRange[sdk.AccAddress, string, string](addr), func(_ collections.Triple[sdk.AccAddress, string, string], hold types.Hold) (bool, error) {
+		held = held.Add(hold.Amount...)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return held
+}
+
+// GetHolds returns the holds on the balance of an account, by holder and reason.
+func (k BaseViewKeeper) GetHolds(ctx context.Context, addr sdk.AccAddress) ([]types.Hold, error) {
+	holds := make([]types.Hold, 0)
+	err := k.Holds.Walk(ctx, collections.NewPrefixedTripleRange[sdk.AccAddress, string, string](addr), func(_ collections.Triple[sdk.AccAddress, string, string], hold types.Hold) (bool, error) {
+		holds = append(holds, hold)
+		return false, nil
+	})
+
+	return holds, err
+}
+
+// Hold reserves amt coins in the balance of an account on behalf of the holder
+// module, for the given reason. The held coins stay in the balance of the
+// account, but are locked until they are released by the holder. An error is
+// returned if the holder is not a module account, or if the spendable balance
+// of the account is smaller than amt.
+func (k BaseKeeper) Hold(ctx context.Context, holder string, addr sdk.AccAddress, amt sdk.Coins, reason string) error {
+	if holder == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "holder cannot be empty")
+	}
+
+	if k.ak.GetModuleAddress(holder) == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", holder)
+	}
+
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	for _, coin := range amt {
+		if spendable := k.SpendableCoin(ctx, addr, coin.Denom); spendable.IsLT(coin) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, coin)
+		}
+	}
+
+	addrStr, err := k.addrCdc.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join3(addr, holder, reason)
+	hold, err := k.BaseViewKeeper.Holds.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		hold = types.Hold{Address: addrStr, Holder: holder, Reason: reason}
+		if err := k.updateHoldCount(ctx, addr, true); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	}
+
+	hold.Amount = hold.Amount.Add(amt...)
+	if err := k.BaseViewKeeper.Holds.Set(ctx, key, hold); err != nil {
+		return err
+	}
+
+	return k.emitHoldEvent(ctx, types.EventTypeHold, addrStr, holder, reason, amt)
+}
+
+// Release releases amt coins held in the balance of an account by the holder
+// module for the given reason, which become spendable again. An error is
+// returned if less than amt coins are held.
+func (k BaseKeeper) Release(ctx context.Context, holder string, addr sdk.AccAddress, amt sdk.Coins, reason string) error {
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	key := collections.Join3(addr, holder, reason)
+	hold, err := k.BaseViewKeeper.Holds.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(types.ErrHoldNotFound, "no coins of %s held by %s for reason %q", addr, holder, reason)
+		}
+		return err
+	}
+
+	remaining, hasNeg := hold.Amount.SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "held amount %s is smaller than %s", hold.Amount, amt)
+	}
+
+	if remaining.IsZero() {
+		if err := k.updateHoldCount(ctx, addr, false); err != nil {
+			return err
+		}
+		err = k.BaseViewKeeper.Holds.Remove(ctx, key)
+	} else {
+		hold.Amount = remaining
+		err = k.BaseViewKeeper.Holds.Set(ctx, key, hold)
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.emitHoldEvent(ctx, types.EventTypeRelease, hold.Address, holder, reason, amt)
+}
+
+// updateHoldCount updates the number of holds on the balance of an account
+// when one of its holds is created or removed. An error is returned if a hold
+// is removed from an account without any.
+func (k BaseKeeper) updateHoldCount(ctx context.Context, addr sdk.AccAddress, created bool) error {
+	count, err := k.BaseViewKeeper.HoldCounts.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	switch {
+	case created:
+		count++
+	case count == 0:
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "no hold on the balance of %s to remove", addr)
+	default:
+		count--
+	}
+
+	if count == 0 {
+		return k.BaseViewKeeper.HoldCounts.Remove(ctx, addr)
+	}
+	return k.BaseViewKeeper.HoldCounts.Set(ctx, addr, count)
+}
+
+func (k BaseKeeper) emitHoldEvent(ctx context.Context, eventType, addr, holder, reason string, amt sdk.Coins) error {
+	return k.EventService.EventManager(ctx).EmitKV(
+		eventType,
+		event.NewAttribute(types.AttributeKeyAddress, addr),
+		event.NewAttribute(types.AttributeKeyHolder, holder),
+		event.NewAttribute(types.AttributeKeyReason, reason),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestHold() {
+	ctx, require := suite.ctx, suite.Require()
+
+	acc := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	addr, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(holder).Return(holderAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(authtypes.Burner).Return(burnerAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	// only the spendable coins can be held, by module accounts
+	require.ErrorIs(suite.bankKeeper.Hold(ctx, "", accAddrs[0], sdk.NewCoins(newFooCoin(10)), "bid"), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(suite.bankKeeper.Hold(ctx, "unknown", accAddrs[0], sdk.NewCoins(newFooCoin(10)), "bid"), sdkerrors.ErrUnknownAddress)
+	require.ErrorIs(suite.bankKeeper.Hold(ctx, holder, accAddrs[0], sdk.NewCoins(), "bid"), sdkerrors.ErrInvalidCoins)
+	require.ErrorIs(suite.bankKeeper.Hold(ctx, holder, accAddrs[0], sdk.NewCoins(newFooCoin(101)), "bid"), sdkerrors.ErrInsufficientFunds)
+
+	require.NoError(suite.bankKeeper.Hold(ctx, holder, accAddrs[0], sdk.NewCoins(newFooCoin(30)), "bid"))
+	require.NoError(suite.bankKeeper.Hold(ctx, holder, accAddrs[0], sdk.NewCoins(newFooCoin(20), newBarCoin(10)), "bid"))
+	require.NoError(suite.bankKeeper.Hold(ctx, authtypes.Burner, accAddrs[0], sdk.NewCoins(newFooCoin(40)), "bond"))
+	require.ErrorIs(suite.bankKeeper.Hold(ctx, holder, accAddrs[0], sdk.NewCoins(newFooCoin(11)), "fee"), sdkerrors.ErrInsufficientFunds)
+
+	// the holds are counted by account
+	count, err := suite.bankKeeper.BaseViewKeeper.HoldCounts.Get(ctx, accAddrs[0])
+	require.NoError(err)
+	require.Equal(uint64(2), count)
+	require.Equal(sdk.NewCoins(), suite.bankKeeper.GetHeldCoins(ctx, accAddrs[1]))
+
+	// the held coins stay in the balance but are not spendable
+	require.Equal(balances, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(10)), suite.bankKeeper.GetHeldCoins(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(40)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+	require.Equal(newFooCoin(10), suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], fooDenom))
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(11))), sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(newFooCoin(11))), sdkerrors.ErrInsufficientFunds)
+
+	res, err := suite.queryClient.Holds(ctx, &banktypes.QueryHoldsRequest{Address: addr})
+	require.NoError(err)
+	require.Equal([]banktypes.Hold{
+		{Address: addr, Holder: authtypes.Burner, Reason: "bond", Amount: sdk.NewCoins(newFooCoin(40))},
+		{Address: addr, Holder: holder, Reason: "bid", Amount: sdk.NewCoins(newFooCoin(50), newBarCoin(10))},
+	}, res.Holds)
+
+	// the holds are exported and imported with the genesis state
+	genesis, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal(res.Holds, genesis.Holds)
+	require.NoError(genesis.Validate())
+
+	// the released coins are spendable again
+	require.ErrorIs(suite.bankKeeper.Release(ctx, holder, accAddrs[0], sdk.NewCoins(newFooCoin(10)), "fee"), banktypes.ErrHoldNotFound)
+	require.ErrorIs(suite.bankKeeper.Release(ctx, holder, accAddrs[0], sdk.NewCoins(newFooCoin(51)), "bid"), sdkerrors.ErrInsufficientFunds)
+
+	require.NoError(suite.bankKeeper.Release(ctx, holder, accAddrs[0], sdk.NewCoins(newFooCoin(50)), "bid"))
+	require.NoError(suite.bankKeeper.Release(ctx, authtypes.Burner, accAddrs[0], sdk.NewCoins(newFooCoin(40)), "bond"))
+	require.Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(40)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	holds, err := suite.bankKeeper.GetHolds(ctx, accAddrs[0])
+	require.NoError(err)
+	require.Equal([]banktypes.Hold{{Address: addr, Holder: holder, Reason: "bid", Amount: sdk.NewCoins(newBarCoin(10))}}, holds)
+
+	require.NoError(suite.bankKeeper.Release(ctx, holder, accAddrs[0], sdk.NewCoins(newBarCoin(10)), "bid"))
+	has, err := suite.bankKeeper.BaseViewKeeper.Holds.Has(ctx, collections.Join3(accAddrs[0], holder, "bid"))
+	require.NoError(err)
+	require.False(has)
+	has, err = suite.bankKeeper.BaseViewKeeper.HoldCounts.Has(ctx, accAddrs[0])
+	require.NoError(err)
+	require.False(has)
+	require.Equal(balances, suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
@@ -28,6 +29,7 @@ type Keeper interface {
 
 	InitGenesis(context.Context, *types.GenesisState) error
 	ExportGenesis(context.Context) (*types.GenesisState, error)
+	EndBlocker(context.Context) error
 
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 	HasSupply(ctx context.Context, denom string) bool
@@ -38,6 +40,15 @@ type Keeper interface {
 	SetDenomMetaData(ctx context.Context, denomMetaData types.Metadata)
 	GetAllDenomMetaData(ctx context.Context) []types.Metadata
 	IterateAllDenomMetaData(ctx context.Context, cb func(types.Metadata) bool)
+	UpdateDenomMetaData(ctx context.Context, updater string, denomMetaData types.Metadata) error
+
+	CreateFactoryDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error)
+	GetFactoryDenomsFromCreator(ctx context.Context, creator sdk.AccAddress) ([]string, error)
+	MintFactoryCoins(ctx context.Context, admin, recipient sdk.AccAddress, amt sdk.Coin) error
+	BurnFactoryCoins(ctx context.Context, admin sdk.AccAddress, amt sdk.Coin) error
+	ChangeFactoryDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error
+	SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error
 
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
@@ -50,6 +61,8 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	AddressCodec() address.Codec
+
 	types.QueryServer
 }
 
@@ -64,6 +77,11 @@ type BaseKeeper struct {
 	addrCdc                address.Codec
 }
 
+// AddressCodec returns the codec of the account addresses.
+func (k BaseKeeper) AddressCodec() address.Codec {
+	return k.addrCdc
+}
+
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
 func (k BaseKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
 	coins, pageResp, err := query.CollectionPaginate(ctx, k.Supply, pagination, func(key string, value math.Int) (sdk.Coin, error) {
@@ -130,6 +148,7 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 	}
 
 	balances := sdk.NewCoins()
+	held := k.GetHeldCoins(ctx, delegatorAddr)
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
@@ -139,8 +158,15 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 			)
 		}
 
+		// the held coins can't be delegated, unlike the locked coins of vesting accounts
+		if heldAmt := held.AmountOf(coin.Denom); balance.Amount.Sub(heldAmt).LT(coin.Amount) {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s, %s%s being held", balance, amt, heldAmt, coin.Denom,
+			)
+		}
+
 		balances = balances.Add(balance)
-		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
+		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin), balance.Amount)
 		if err != nil {
 			return err
 		}
@@ -247,6 +273,38 @@ func (k BaseKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types.Me
 	_ = k.BaseViewKeeper.DenomMetadata.Set(ctx, denomMetaData.Base, denomMetaData)
 }
 
+// UpdateDenomMetaData validates and sets the metadata of a denom, recording the
+// change made by the updater in the denom metadata history.
+func (k BaseKeeper) UpdateDenomMetaData(ctx context.Context, updater string, denomMetaData types.Metadata) error {
+	if err := denomMetaData.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	seq, err := k.DenomMetadataHistorySequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	change := types.DenomMetadataChange{
+		Metadata: denomMetaData,
+		Updater:  updater,
+		Height:   headerInfo.Height,
+		Time:     headerInfo.Time,
+	}
+	if err := k.BaseViewKeeper.DenomMetadataHistory.Set(ctx, collections.Join(denomMetaData.Base, seq), change); err != nil {
+		return err
+	}
+
+	k.SetDenomMetaData(ctx, denomMetaData)
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetDenomMetadata,
+		event.NewAttribute(types.AttributeKeyDenom, denomMetaData.Base),
+		event.NewAttribute(types.AttributeKeyUpdater, updater),
+	)
+}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // An error is returned if the module account does not exist or if
 // the recipient address is black-listed or if sending the tokens fails.
@@ -423,7 +481,7 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, address []byte, amounts sdk.C
 }
 
 // setSupply sets the supply for the given coin
-func (k BaseKeeper) setSupply(ctx context.Context, coin sdk.Coin) {
+func (k BaseSendKeeper) setSupply(ctx context.Context, coin sdk.Coin) {
 	// Bank invariants and IBC requires to remove zero coins.
 	if coin.IsZero() {
 		_ = k.Supply.Remove(ctx, coin.Denom)
@@ -26,3 +26,9 @@ func (m Migrator) Migrate2to3(ctx context.Context) error {
 func (m Migrator) Migrate3to4(ctx context.Context) error {
 	return nil
 }
+
+// Migrate4to5 migrates x/bank storage from version 4 to 5.
+// It counts the holders of each denom from the existing balances.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	return m.keeper.setDenomHolderCounts(ctx)
+}
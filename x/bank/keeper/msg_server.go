@@ -197,3 +197,88 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+func (k msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.UpdateDenomMetaData(ctx, msg.Authority, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := k.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	denom, err := k.CreateFactoryDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (k msgServer) MintDenom(ctx context.Context, msg *types.MsgMintDenom) (*types.MsgMintDenomResponse, error) {
+	admin, err := k.AddressCodec().StringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	recipient := admin
+	if msg.MintToAddress != "" {
+		if recipient, err = k.AddressCodec().StringToBytes(msg.MintToAddress); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+		}
+	}
+
+	if err := k.MintFactoryCoins(ctx, admin, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintDenomResponse{}, nil
+}
+
+func (k msgServer) BurnDenom(ctx context.Context, msg *types.MsgBurnDenom) (*types.MsgBurnDenomResponse, error) {
+	admin, err := k.AddressCodec().StringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := k.BurnFactoryCoins(ctx, admin, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnDenomResponse{}, nil
+}
+
+func (k msgServer) ChangeDenomAdmin(ctx context.Context, msg *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	admin, err := k.AddressCodec().StringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := k.ChangeFactoryDenomAdmin(ctx, admin, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeDenomAdminResponse{}, nil
+}
+
+func (k msgServer) SetFactoryDenomMetadata(ctx context.Context, msg *types.MsgSetFactoryDenomMetadata) (*types.MsgSetFactoryDenomMetadataResponse, error) {
+	admin, err := k.AddressCodec().StringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := k.Keeper.SetFactoryDenomMetadata(ctx, admin, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFactoryDenomMetadataResponse{}, nil
+}
@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"time"
+
+	"cosmossdk.io/core/header"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -436,3 +439,74 @@ func (suite *KeeperTestSuite) TestMsgBurn() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSetDenomMetadata() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 10, Time: time.Unix(1700000000, 0).UTC()})
+	authority := suite.bankKeeper.GetAuthority()
+
+	metadata := banktypes.Metadata{
+		Name:       "Bridged Token",
+		Symbol:     "BTK",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ubtk"}, {Denom: "btk", Exponent: 6}},
+		Base:       "ubtk",
+		Display:    "btk",
+	}
+	fixed := metadata
+	fixed.DenomUnits = []*banktypes.DenomUnit{{Denom: "ubtk"}, {Denom: "btk", Exponent: 18}}
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgSetDenomMetadata
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     banktypes.NewMsgSetDenomMetadata("invalid", metadata),
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "invalid metadata",
+			input:     banktypes.NewMsgSetDenomMetadata(authority, banktypes.Metadata{Base: "ubtk"}),
+			expErr:    true,
+			expErrMsg: "name field cannot be blank",
+		},
+		{
+			name:  "all good",
+			input: banktypes.NewMsgSetDenomMetadata(authority, metadata),
+		},
+		{
+			name:  "update",
+			input: banktypes.NewMsgSetDenomMetadata(authority, fixed),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetDenomMetadata(ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				actual, found := suite.bankKeeper.GetDenomMetaData(ctx, tc.input.Metadata.Base)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.input.Metadata, actual)
+			}
+		})
+	}
+
+	// the changes are recorded, oldest first
+	res, err := suite.mockQueryClient(ctx).DenomMetadataHistory(ctx, &banktypes.QueryDenomMetadataHistoryRequest{Denom: "ubtk"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]banktypes.DenomMetadataChange{
+		{Metadata: metadata, Updater: authority, Height: 10, Time: ctx.HeaderInfo().Time},
+		{Metadata: fixed, Updater: authority, Height: 10, Time: ctx.HeaderInfo().Time},
+	}, res.Changes)
+
+	res, err = suite.mockQueryClient(ctx).DenomMetadataHistory(ctx, &banktypes.QueryDenomMetadataHistoryRequest{Denom: "ubt"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Changes)
+}
@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ types.SendRestrictionFn = BaseSendKeeper{}.ParamsSendRestriction
+
+// applySendRestrictions applies the registered send restrictions, then the send
+// restrictions set in the params to the final recipient.
+func (k BaseSendKeeper) applySendRestrictions(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	toAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.ParamsSendRestriction(ctx, fromAddr, toAddr, amt)
+}
+
+// ParamsSendRestriction is the SendRestrictionFn of the send restrictions set in
+// the params, which is applied to all the sends after the registered send
+// restrictions:
+//   - the denied addresses can neither send nor receive their denied denoms,
+//   - the amounts sent are counted in the outflow quotas of the sender,
+//   - the transfer taxes are charged to the sender on top of the amounts sent,
+//     and sent to the fee collector or burned.
+//
+// The sends from module accounts, such as the fees and rewards distributed or
+// the deposits refunded, are not restricted, and the sends to the exempt
+// modules are neither counted in the outflow quotas nor taxed. In
+// InputOutputCoins, it is applied to each output with the input address as
+// sender, so that the outflow quotas count the sum of the outputs.
+func (k BaseSendKeeper) ParamsSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	params := k.GetParams(ctx)
+	taxedOrCounted := slices.ContainsFunc(amt, func(coin sdk.Coin) bool {
+		_, hasTax := params.GetTransferTax(coin.Denom)
+		_, hasQuota := params.GetOutflowQuota(coin.Denom)
+		return hasTax || hasQuota
+	})
+	if (len(params.DeniedAddresses) == 0 && !taxedOrCounted) || k.isModuleAccount(ctx, fromAddr) {
+		return toAddr, nil
+	}
+
+	if err := k.checkDenylist(ctx, params, fromAddr, toAddr, amt); err != nil {
+		return nil, err
+	}
+
+	if !taxedOrCounted || k.isExemptModule(params, toAddr) {
+		return toAddr, nil
+	}
+
+	if err := k.countOutflows(ctx, params, fromAddr, amt); err != nil {
+		return nil, err
+	}
+
+	if err := k.chargeTransferTaxes(ctx, params, fromAddr, amt); err != nil {
+		return nil, err
+	}
+
+	return toAddr, nil
+}
+
+// checkDenylist returns an error if the sender or the recipient is denied one of
+// the denoms sent. The denied addresses are stored in their canonical form, see
+// SetParams.
+func (k BaseSendKeeper) checkDenylist(ctx context.Context, params types.Params, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if len(params.DeniedAddresses) == 0 {
+		return nil
+	}
+
+	fromAddrStr, err := k.addrCdc.BytesToString(fromAddr)
+	if err != nil {
+		return err
+	}
+	toAddrStr, err := k.addrCdc.BytesToString(toAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range amt {
+		if params.IsDenied(fromAddrStr, coin.Denom) {
+			return errorsmod.Wrapf(types.ErrDeniedAddress, "%s is not allowed to send %s", fromAddrStr, coin.Denom)
+		}
+		if params.IsDenied(toAddrStr, coin.Denom) {
+			return errorsmod.Wrapf(types.ErrDeniedAddress, "%s is not allowed to receive %s", toAddrStr, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// countOutflows adds the amounts sent to the outflows of the sender over its
+// current periods, starting a new period for the denoms whose period ended. An
+// error is returned if an outflow exceeds its quota. The periods are fixed: the
+// outflow of a period is reset once it ended, rather than the amounts leaving a
+// rolling window one by one.
+func (k BaseSendKeeper) countOutflows(ctx context.Context, params types.Params, fromAddr sdk.AccAddress, amt sdk.Coins) error {
+	fromAddrStr, err := k.addrCdc.BytesToString(fromAddr)
+	if err != nil {
+		return err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	outflows := make([]types.Outflow, 0, len(amt))
+	for _, coin := range amt {
+		quota, ok := params.GetOutflowQuota(coin.Denom)
+		if !ok {
+			continue
+		}
+
+		outflow, err := k.Outflows.Get(ctx, collections.Join(fromAddr, coin.Denom))
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err != nil || !now.Before(outflow.PeriodStart.Add(quota.Period)) {
+			outflow = types.Outflow{Address: fromAddrStr, Denom: coin.Denom, PeriodStart: now, Amount: math.ZeroInt()}
+		}
+
+		outflow.Amount = outflow.Amount.Add(coin.Amount)
+		if outflow.Amount.GT(quota.Amount) {
+			return errorsmod.Wrapf(
+				types.ErrOutflowQuotaExceeded, "%s can send %s%s per %s, and sent %s%s since %s",
+				fromAddrStr, quota.Amount, quota.Denom, quota.Period, outflow.Amount.Sub(coin.Amount), coin.Denom, outflow.PeriodStart,
+			)
+		}
+		outflows = append(outflows, outflow)
+	}
+
+	// the outflows are only stored once all the quotas are checked
+	for _, outflow := range outflows {
+		if err := k.Outflows.Set(ctx, collections.Join(fromAddr, outflow.Denom), outflow); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chargeTransferTaxes charges the transfer taxes of the amounts sent to the
+// sender, and sends them to the fee collector or burns them.
+func (k BaseSendKeeper) chargeTransferTaxes(ctx context.Context, params types.Params, fromAddr sdk.AccAddress, amt sdk.Coins) error {
+	var collected, burned sdk.Coins
+	for _, coin := range amt {
+		tax, ok := params.GetTransferTax(coin.Denom)
+		if !ok {
+			continue
+		}
+
+		taxAmt := tax.Rate.MulInt(coin.Amount).TruncateInt()
+		if !taxAmt.IsPositive() {
+			continue
+		}
+		if tax.Burn {
+			burned = burned.Add(sdk.NewCoin(coin.Denom, taxAmt))
+		} else {
+			collected = collected.Add(sdk.NewCoin(coin.Denom, taxAmt))
+		}
+	}
+
+	taxes := collected.Add(burned...)
+	if taxes.IsZero() {
+		return nil
+	}
+
+	if err := k.subUnlockedCoins(ctx, fromAddr, taxes); err != nil {
+		return errorsmod.Wrap(err, "failed to pay the transfer tax")
+	}
+
+	fromAddrStr, err := k.addrCdc.BytesToString(fromAddr)
+	if err != nil {
+		return err
+	}
+
+	if !collected.IsZero() {
+		feeCollector := k.ak.GetModuleAddress(authtypes.FeeCollectorName)
+		if err := k.addCoins(ctx, feeCollector, collected); err != nil {
+			return err
+		}
+
+		feeCollectorStr, err := k.addrCdc.BytesToString(feeCollector)
+		if err != nil {
+			return err
+		}
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeTransfer,
+			event.NewAttribute(types.AttributeKeyRecipient, feeCollectorStr),
+			event.NewAttribute(types.AttributeKeySender, fromAddrStr),
+			event.NewAttribute(sdk.AttributeKeyAmount, collected.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	if !burned.IsZero() {
+		for _, coin := range burned {
+			supply, err := k.Supply.Get(ctx, coin.Denom)
+			if err != nil && !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			if err != nil || supply.LT(coin.Amount) {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "cannot burn the transfer tax of %s from its supply", coin)
+			}
+			k.setSupply(ctx, sdk.NewCoin(coin.Denom, supply.Sub(coin.Amount)))
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeCoinBurn,
+			event.NewAttribute(types.AttributeKeyBurner, fromAddrStr),
+			event.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isModuleAccount returns whether the address is the one of a module account.
+func (k BaseSendKeeper) isModuleAccount(ctx context.Context, addr sdk.AccAddress) bool {
+	_, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
+
+// isExemptModule returns whether the address is the one of the account of an
+// exempt module.
+func (k BaseSendKeeper) isExemptModule(params types.Params, addr sdk.AccAddress) bool {
+	return slices.ContainsFunc(params.ExemptModules, func(name string) bool {
+		return addr.Equals(k.ak.GetModuleAddress(name))
+	})
+}
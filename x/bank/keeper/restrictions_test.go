@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestParamsSendRestriction() {
+	ctx, require := suite.ctx, suite.Require()
+	now := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+
+	addrs := make([]string, 4)
+	for i := range addrs {
+		addr, err := suite.addrCdc.BytesToString(accAddrs[i])
+		require.NoError(err)
+		addrs[i] = addr
+	}
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(1000), newBarCoin(1000))))
+
+	for _, addr := range accAddrs[:4] {
+		suite.authKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr)).AnyTimes()
+	}
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), holderAcc.GetAddress()).Return(holderAcc).AnyTimes()
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	suite.authKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollectorAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(holder).Return(holderAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress("unknown").Return(nil).AnyTimes()
+
+	params := suite.bankKeeper.GetParams(ctx)
+	params.TransferTaxes = []banktypes.TransferTax{
+		{Denom: fooDenom, Rate: math.LegacyNewDecWithPrec(1, 1)},
+		{Denom: barDenom, Rate: math.LegacyNewDecWithPrec(1, 1), Burn: true},
+	}
+	params.OutflowQuotas = []banktypes.OutflowQuota{{Denom: fooDenom, Amount: math.NewInt(300), Period: time.Hour}}
+	params.ExemptModules = []string{holder}
+	// the denied addresses are stored in their canonical form
+	params.DeniedAddresses = []banktypes.DeniedAddress{{Address: addrs[2], Denoms: []string{barDenom}}, {Address: strings.ToUpper(addrs[3])}}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.Equal(addrs[3], suite.bankKeeper.GetParams(ctx).DeniedAddresses[1].Address)
+
+	invalidParams := params
+	invalidParams.DeniedAddresses = []banktypes.DeniedAddress{{Address: "invalid"}}
+	require.ErrorIs(suite.bankKeeper.SetParams(ctx, invalidParams), sdkerrors.ErrInvalidAddress)
+	invalidParams.DeniedAddresses = []banktypes.DeniedAddress{{Address: addrs[3]}, {Address: strings.ToUpper(addrs[3])}}
+	require.ErrorIs(suite.bankKeeper.SetParams(ctx, invalidParams), sdkerrors.ErrInvalidAddress)
+	invalidParams = params
+	invalidParams.ExemptModules = []string{"unknown"}
+	require.ErrorIs(suite.bankKeeper.SetParams(ctx, invalidParams), sdkerrors.ErrUnknownAddress)
+
+	// the taxes are paid by the sender on top of the amount sent
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+	require.Equal(sdk.NewCoins(newFooCoin(890), newBarCoin(890)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(100)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, feeCollectorAcc.GetAddress()))
+	require.Equal(newBarCoin(990), suite.bankKeeper.GetSupply(ctx, barDenom))
+
+	// the outflow quotas count the sum of the outputs of a multi send
+	input := banktypes.Input{Address: addrs[0], Coins: sdk.NewCoins(newFooCoin(150))}
+	outputs := []banktypes.Output{
+		{Address: addrs[1], Coins: sdk.NewCoins(newFooCoin(100))},
+		{Address: addrs[2], Coins: sdk.NewCoins(newFooCoin(50))},
+	}
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+	require.Equal(newFooCoin(725), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+	require.Equal(newFooCoin(25), suite.bankKeeper.GetBalance(ctx, feeCollectorAcc.GetAddress(), fooDenom))
+
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(51))), banktypes.ErrOutflowQuotaExceeded)
+	require.ErrorIs(suite.bankKeeper.InputOutputCoins(ctx, input, outputs), banktypes.ErrOutflowQuotaExceeded)
+
+	res, err := suite.queryClient.Outflows(ctx, &banktypes.QueryOutflowsRequest{Address: addrs[0]})
+	require.NoError(err)
+	require.Equal([]banktypes.Outflow{{Address: addrs[0], Denom: fooDenom, PeriodStart: now, Amount: math.NewInt(250)}}, res.Outflows)
+
+	// the sends to the exempt modules are neither taxed nor counted, unlike the
+	// sends to the other module accounts
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, holder).Return(holderAcc)
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], holder, sdk.NewCoins(newFooCoin(100))))
+	require.Equal(newFooCoin(625), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), feeCollectorAcc.GetAddress()).Return(feeCollectorAcc).AnyTimes()
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], feeCollectorAcc.GetAddress(), sdk.NewCoins(newFooCoin(51))), banktypes.ErrOutflowQuotaExceeded)
+
+	// the sends from module accounts are neither taxed nor counted, even if the
+	// modules are not exempt
+	require.NoError(suite.bankKeeper.SendCoins(ctx, feeCollectorAcc.GetAddress(), accAddrs[1], sdk.NewCoins(newFooCoin(20))))
+	require.Equal(newFooCoin(5), suite.bankKeeper.GetBalance(ctx, feeCollectorAcc.GetAddress(), fooDenom))
+
+	// the periods are fixed: a new period starts once the previous one ended,
+	// with the full quota
+	ctx = sdk.UnwrapSDKContext(ctx).WithHeaderInfo(header.Info{Time: now.Add(time.Hour)})
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(300))))
+	require.Equal(newFooCoin(295), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+
+	// the denied addresses can neither send nor receive their denied denoms
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newBarCoin(10))), banktypes.ErrDeniedAddress)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[2], accAddrs[0], sdk.NewCoins(newBarCoin(10))), banktypes.ErrDeniedAddress)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newFooCoin(10))), banktypes.ErrDeniedAddress)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[2], accAddrs[0], sdk.NewCoins(newFooCoin(10))))
+
+	// the module accounts can still send to the denied addresses, e.g. to refund
+	// their deposits
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, holder).Return(holderAcc)
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], holder, sdk.NewCoins(newBarCoin(10))))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, holder, accAddrs[3], sdk.NewCoins(newBarCoin(10))))
+	require.Equal(newBarCoin(10), suite.bankKeeper.GetBalance(ctx, accAddrs[3], barDenom))
+
+	genesis, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genesis.Outflows, 2)
+	require.NoError(genesis.Validate())
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -128,8 +129,37 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil
 	}
+
+	// the denied addresses are stored in their canonical form, which the
+	// addresses of the sends are compared with
+	deniedAddrs := make([]types.DeniedAddress, len(params.DeniedAddresses))
+	seen := make(map[string]bool)
+	for i, denied := range params.DeniedAddresses {
+		addr, err := k.addrCdc.StringToBytes(denied.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid denied address %s: %s", denied.Address, err)
+		}
+		if denied.Address, err = k.addrCdc.BytesToString(addr); err != nil {
+			return err
+		}
+		if seen[denied.Address] {
+			return sdkerrors.ErrInvalidAddress.Wrapf("duplicate denied address %s", denied.Address)
+		}
+		seen[denied.Address] = true
+		deniedAddrs[i] = denied
+	}
+	if len(deniedAddrs) > 0 {
+		params.DeniedAddresses = deniedAddrs
+	}
+
+	for _, name := range params.ExemptModules {
+		if k.ak.GetModuleAddress(name) == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "exempt module account %s does not exist", name)
+		}
+	}
+
 	return k.Params.Set(ctx, params)
 }
 
@@ -161,7 +191,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
-		outAddress, err = k.sendRestriction.apply(ctx, inAddress, outAddress, out.Coins)
+		outAddress, err = k.applySendRestrictions(ctx, inAddress, outAddress, out.Coins)
 		if err != nil {
 			return err
 		}
@@ -203,7 +233,7 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 	}
 
 	var err error
-	toAddr, err = k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	toAddr, err = k.applySendRestrictions(ctx, fromAddr, toAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -270,7 +300,7 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx context.Context, addr sdk.AccAddres
 
 		newBalance := balance.Sub(coin)
 
-		if err := k.setBalance(ctx, addr, newBalance); err != nil {
+		if err := k.setBalance(ctx, addr, newBalance, balance.Amount); err != nil {
 			return err
 		}
 	}
@@ -297,7 +327,7 @@ func (k BaseSendKeeper) addCoins(ctx context.Context, addr sdk.AccAddress, amt s
 		balance := k.GetBalance(ctx, addr, coin.Denom)
 		newBalance := balance.Add(coin)
 
-		err := k.setBalance(ctx, addr, newBalance)
+		err := k.setBalance(ctx, addr, newBalance, balance.Amount)
 		if err != nil {
 			return err
 		}
@@ -315,12 +345,17 @@ func (k BaseSendKeeper) addCoins(ctx context.Context, addr sdk.AccAddress, amt s
 	)
 }
 
-// setBalance sets the coin balance for an account by address.
-func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error {
+// setBalance sets the coin balance for an account by address. The previous
+// amount of the balance is used to track the number of holders of the denom.
+func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin, prevAmt math.Int) error {
 	if !balance.IsValid() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	if err := k.updateDenomHolderCount(ctx, balance.Denom, prevAmt, balance.Amount); err != nil {
+		return err
+	}
+
 	// x/bank invariants prohibit persistence of zero balances
 	if balance.IsZero() {
 		err := k.Balances.Remove(ctx, collections.Join(addr, balance.Denom))
@@ -332,6 +367,36 @@ func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, bal
 	return k.Balances.Set(ctx, collections.Join(addr, balance.Denom), balance.Amount)
 }
 
+// updateDenomHolderCount updates the number of holders of a denom when a
+// balance goes from zero to positive, or from positive to zero. An error is
+// returned if a balance goes to zero while the denom has no holder, which
+// means the count is out of sync with the balances.
+func (k BaseSendKeeper) updateDenomHolderCount(ctx context.Context, denom string, prevAmt, amt math.Int) error {
+	wasHolder, isHolder := prevAmt.IsPositive(), amt.IsPositive()
+	if wasHolder == isHolder {
+		return nil
+	}
+
+	count, err := k.DenomHolderCounts.Get(ctx, denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	switch {
+	case isHolder:
+		count++
+	case count == 0:
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "no holder of %s to remove", denom)
+	default:
+		count--
+	}
+
+	if count == 0 {
+		return k.DenomHolderCounts.Remove(ctx, denom)
+	}
+	return k.DenomHolderCounts.Set(ctx, denom, count)
+}
+
 // IsSendEnabledCoins checks the coins provided and returns an ErrSendDisabled
 // if any of the coins are not configured for sending. Returns nil if sending is
 // enabled for all provided coins.
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker records a supply snapshot of the supply snapshot denoms at the
+// heights that are a multiple of the supply snapshot interval set in the params,
+// and prunes the snapshots beyond the supply snapshot retention.
+func (k BaseKeeper) EndBlocker(ctx context.Context) error {
+	params := k.GetParams(ctx)
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if params.SupplySnapshotInterval == 0 || len(params.SupplySnapshotDenoms) == 0 ||
+		height <= 0 || uint64(height)%params.SupplySnapshotInterval != 0 {
+		return nil
+	}
+
+	if _, err := k.RecordSupplySnapshot(ctx, params.SupplySnapshotDenoms); err != nil {
+		return err
+	}
+
+	return k.PruneSupplySnapshots(ctx, params.SupplySnapshotRetention)
+}
+
+// PruneSupplySnapshots removes the supply snapshots older than the given number
+// of latest ones. No snapshot is removed if it is 0.
+func (k BaseKeeper) PruneSupplySnapshots(ctx context.Context, retention uint64) error {
+	if retention == 0 {
+		return nil
+	}
+
+	it, err := k.SupplySnapshots.Iterate(ctx, new(collections.Range[int64]).Descending())
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < retention && it.Valid(); i++ {
+		it.Next()
+	}
+	if !it.Valid() {
+		return it.Close()
+	}
+	latestPruned, err := it.Key()
+	if err != nil {
+		return errors.Join(err, it.Close())
+	}
+	// the iterator is closed before the snapshots are removed
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	return k.SupplySnapshots.Clear(ctx, new(collections.Range[int64]).EndInclusive(latestPruned))
+}
+
+// RecordSupplySnapshot records the total supply and the number of holders of
+// the given denoms at the current height, and returns the snapshot. The holder
+// counts of all the given denoms are recorded, even if they are zero, while the
+// denoms without supply are left out of the supply.
+func (k BaseKeeper) RecordSupplySnapshot(ctx context.Context, denoms []string) (types.SupplySnapshot, error) {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	snapshot := types.SupplySnapshot{Height: headerInfo.Height, Time: headerInfo.Time}
+
+	for _, denom := range slices.Sorted(slices.Values(denoms)) {
+		if supply := k.GetSupply(ctx, denom); supply.IsPositive() {
+			snapshot.Supply = append(snapshot.Supply, supply)
+		}
+
+		count, err := k.GetDenomHolderCount(ctx, denom)
+		if err != nil {
+			return types.SupplySnapshot{}, err
+		}
+		snapshot.HolderCounts = append(snapshot.HolderCounts, types.DenomHolderCount{Denom: denom, Count: count})
+	}
+
+	if err := snapshot.Validate(); err != nil {
+		return types.SupplySnapshot{}, err
+	}
+
+	return snapshot, k.SupplySnapshots.Set(ctx, snapshot.Height, snapshot)
+}
+
+// GetSupplySnapshot returns the latest supply snapshot recorded at or before the
+// given height.
+func (k BaseKeeper) GetSupplySnapshot(ctx context.Context, height int64) (types.SupplySnapshot, error) {
+	rng := new(collections.Range[int64]).EndInclusive(height).Descending()
+	it, err := k.SupplySnapshots.Iterate(ctx, rng)
+	if err != nil {
+		return types.SupplySnapshot{}, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return types.SupplySnapshot{}, errorsmod.Wrapf(collections.ErrNotFound, "no supply snapshot at or before height %d", height)
+	}
+
+	return it.Value()
+}
+
+// GetDenomHolderCount returns the number of accounts holding a denom.
+func (k BaseViewKeeper) GetDenomHolderCount(ctx context.Context, denom string) (uint64, error) {
+	count, err := k.DenomHolderCounts.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+
+	return count, err
+}
+
+// setDenomHolderCounts counts the holders of each denom from the stored
+// balances, and sets the holder counts accordingly.
+func (k BaseViewKeeper) setDenomHolderCounts(ctx context.Context) error {
+	if err := k.DenomHolderCounts.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	counts := make(map[string]uint64)
+	err := k.Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+		if amount.IsPositive() {
+			counts[key.K2()]++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, denom := range slices.Sorted(maps.Keys(counts)) {
+		if err := k.DenomHolderCounts.Set(ctx, denom, counts[denom]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestSupplySnapshots() {
+	ctx, require := suite.ctx, suite.Require()
+
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[2]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[2])).AnyTimes()
+	for _, addr := range accAddrs[:2] {
+		suite.authKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr)).AnyTimes()
+		suite.mockFundAccount(addr)
+		require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, addr, sdk.NewCoins(newFooCoin(100))))
+	}
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newBarCoin(50))))
+
+	// the holder counts follow the balances going from zero to positive and back
+	count, err := suite.bankKeeper.GetDenomHolderCount(ctx, fooDenom)
+	require.NoError(err)
+	require.Equal(uint64(2), count)
+
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	count, err = suite.bankKeeper.GetDenomHolderCount(ctx, fooDenom)
+	require.NoError(err)
+	require.Equal(uint64(1), count)
+
+	// no snapshot is recorded while the interval is zero or no denom is snapshotted
+	at := func(height int64) sdk.Context {
+		return sdk.UnwrapSDKContext(ctx).WithHeaderInfo(header.Info{Height: height, Time: time.Unix(height, 0).UTC()})
+	}
+	params := suite.bankKeeper.GetParams(ctx)
+	params.SupplySnapshotDenoms = []string{fooDenom, "zero", barDenom}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.EndBlocker(at(10)))
+
+	params.SupplySnapshotInterval = 10
+	params.SupplySnapshotDenoms = nil
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.EndBlocker(at(10)))
+	_, err = suite.bankKeeper.SupplyAt(ctx, &banktypes.QuerySupplyAtRequest{Height: 10})
+	require.ErrorContains(err, "no supply snapshot")
+
+	// only the snapshotted denoms are recorded, with their holder counts even if
+	// they have no supply
+	params.SupplySnapshotDenoms = []string{fooDenom, "zero", barDenom}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	require.NoError(suite.bankKeeper.EndBlocker(at(10)))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.EndBlocker(at(15)))
+
+	res, err := suite.bankKeeper.SupplyAt(ctx, &banktypes.QuerySupplyAtRequest{Height: 15})
+	require.NoError(err)
+	require.Equal(int64(10), res.Snapshot.Height)
+	require.Equal(time.Unix(10, 0).UTC(), res.Snapshot.Time)
+	require.Equal(sdk.NewCoins(newBarCoin(50), newFooCoin(200)), res.Snapshot.Supply)
+	require.Equal([]banktypes.DenomHolderCount{{Denom: barDenom, Count: 1}, {Denom: fooDenom, Count: 1}, {Denom: "zero", Count: 0}}, res.Snapshot.HolderCounts)
+
+	_, err = suite.bankKeeper.SupplyAt(ctx, &banktypes.QuerySupplyAtRequest{Height: 9})
+	require.ErrorContains(err, "no supply snapshot")
+
+	// the holder count at a height is the one of the snapshot, and the current one otherwise
+	holders, err := suite.bankKeeper.DenomHolderCount(ctx, &banktypes.QueryDenomHolderCountRequest{Denom: fooDenom, Height: 15})
+	require.NoError(err)
+	require.Equal(&banktypes.QueryDenomHolderCountResponse{Count: 1, Height: 10}, holders)
+
+	_, err = suite.bankKeeper.DenomHolderCount(ctx, &banktypes.QueryDenomHolderCountRequest{Denom: "other", Height: 15})
+	require.ErrorContains(err, "other is not in the supply snapshot at height 10")
+
+	holders, err = suite.bankKeeper.DenomHolderCount(at(15), &banktypes.QueryDenomHolderCountRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(&banktypes.QueryDenomHolderCountResponse{Count: 2, Height: 15}, holders)
+
+	// the snapshots are exported, and the holder counts counted again on import
+	genesis, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal([]banktypes.SupplySnapshot{res.Snapshot}, genesis.SupplySnapshots)
+	require.NoError(genesis.Validate())
+
+	require.NoError(suite.bankKeeper.DenomHolderCounts.Clear(ctx, nil))
+
+	// a holder count out of sync with the balances can't underflow
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	require.ErrorIs(suite.bankKeeper.SendCoins(cacheCtx, accAddrs[2], accAddrs[0], sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrLogic)
+
+	require.NoError(suite.bankKeeper.InitGenesis(ctx, genesis))
+	count, err = suite.bankKeeper.GetDenomHolderCount(ctx, fooDenom)
+	require.NoError(err)
+	require.Equal(uint64(2), count)
+}
+
+func (suite *KeeperTestSuite) TestSupplySnapshotRetention() {
+	ctx, require := suite.ctx, suite.Require()
+	at := func(height int64) sdk.Context {
+		return sdk.UnwrapSDKContext(ctx).WithHeaderInfo(header.Info{Height: height, Time: time.Unix(height, 0).UTC()})
+	}
+	heights := func() []int64 {
+		var heights []int64
+		require.NoError(suite.bankKeeper.SupplySnapshots.Walk(ctx, nil, func(height int64, _ banktypes.SupplySnapshot) (bool, error) {
+			heights = append(heights, height)
+			return false, nil
+		}))
+		return heights
+	}
+
+	// all the snapshots are kept while the retention is zero
+	params := suite.bankKeeper.GetParams(ctx)
+	params.SupplySnapshotInterval = 10
+	params.SupplySnapshotDenoms = []string{fooDenom}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	for height := int64(10); height <= 40; height += 10 {
+		require.NoError(suite.bankKeeper.EndBlocker(at(height)))
+	}
+	require.Equal([]int64{10, 20, 30, 40}, heights())
+
+	// the older snapshots are pruned when a snapshot is recorded
+	params.SupplySnapshotRetention = 2
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.EndBlocker(at(45)))
+	require.Equal([]int64{10, 20, 30, 40}, heights())
+	require.NoError(suite.bankKeeper.EndBlocker(at(50)))
+	require.Equal([]int64{40, 50}, heights())
+	require.NoError(suite.bankKeeper.EndBlocker(at(60)))
+	require.Equal([]int64{50, 60}, heights())
+
+	_, err := suite.bankKeeper.SupplyAt(ctx, &banktypes.QuerySupplyAtRequest{Height: 45})
+	require.ErrorContains(err, "no supply snapshot")
+}
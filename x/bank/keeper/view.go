@@ -68,21 +68,57 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	// DenomAuthorities holds the admins of the factory denoms.
+	DenomAuthorities collections.Map[string, types.DenomAuthorityMetadata]
+	// DenomMetadataHistory holds the changes of the denom metadata made with
+	// messages, keyed by denom and sequence.
+	DenomMetadataHistory         collections.Map[collections.Pair[string, uint64], types.DenomMetadataChange]
+	DenomMetadataHistorySequence collections.Sequence
+	// Holds holds the coins held by modules in the balances of the accounts,
+	// keyed by address, holder and reason.
+	Holds collections.Map[collections.Triple[sdk.AccAddress, string, string], types.Hold]
+	// HoldCounts holds the numbers of holds on the balances of the accounts, so
+	// that the holds of the accounts without any are not walked.
+	HoldCounts collections.Map[sdk.AccAddress, uint64]
+	// Outflows holds the amounts sent by the accounts over their current outflow
+	// quota periods, keyed by address and denom.
+	Outflows collections.Map[collections.Pair[sdk.AccAddress, string], types.Outflow]
+	// DenomHolderCounts holds the numbers of accounts holding each denom.
+	DenomHolderCounts collections.Map[string, uint64]
+	// SupplySnapshots holds the supply snapshots, keyed by height.
+	SupplySnapshots collections.Map[int64, types.SupplySnapshot]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak types.AccountKeeper) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := BaseViewKeeper{
-		Environment:   env,
-		cdc:           cdc,
-		ak:            ak,
-		addrCdc:       ak.AddressCodec(),
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Environment:      env,
+		cdc:              cdc,
+		ak:               ak,
+		addrCdc:          ak.AddressCodec(),
+		Supply:           collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:    collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:      collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		Balances:         collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthorities: collections.NewMap(sb, types.DenomAuthorityPrefix, "denom_authorities", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		DenomMetadataHistory: collections.NewMap(
+			sb, types.DenomMetadataHistoryPrefix, "denom_metadata_history",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.DenomMetadataChange](cdc),
+		),
+		DenomMetadataHistorySequence: collections.NewSequence(sb, types.DenomMetadataHistorySequenceKey, "denom_metadata_history_sequence"),
+		Holds: collections.NewMap(
+			sb, types.HoldsPrefix, "holds",
+			collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.StringKey), codec.CollValue[types.Hold](cdc),
+		),
+		HoldCounts: collections.NewMap(sb, types.HoldCountsPrefix, "hold_counts", sdk.AccAddressKey, collections.Uint64Value),
+		Outflows: collections.NewMap(
+			sb, types.OutflowsPrefix, "outflows",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.Outflow](cdc),
+		),
+		DenomHolderCounts: collections.NewMap(sb, types.DenomHolderCountsPrefix, "denom_holder_counts", collections.StringKey, collections.Uint64Value),
+		SupplySnapshots:   collections.NewMap(sb, types.SupplySnapshotsPrefix, "supply_snapshots", collections.Int64Key, codec.CollValue[types.SupplySnapshot](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -174,19 +210,21 @@ func (k BaseViewKeeper) IterateAllBalances(ctx context.Context, cb func(sdk.AccA
 }
 
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
-// account by address. For standard accounts, the result will always be no coins.
-// For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// account by address, which are the coins held by modules in its balance. For
+// vesting accounts, the locked coins of the concrete vesting account type are
+// added to them.
 func (k BaseViewKeeper) LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := k.GetHeldCoins(ctx, addr)
+
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(k.HeaderService.HeaderInfo(ctx).Time)
+			return locked.Add(vacc.LockedCoins(k.HeaderService.HeaderInfo(ctx).Time)...)
 		}
 	}
 
-	return sdk.NewCoins()
+	return locked
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
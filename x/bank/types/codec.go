@@ -15,6 +15,12 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(registrar, &MsgCreateDenom{}, "cosmos-sdk/MsgCreateDenom")
+	legacy.RegisterAminoMsg(registrar, &MsgMintDenom{}, "cosmos-sdk/MsgMintDenom")
+	legacy.RegisterAminoMsg(registrar, &MsgBurnDenom{}, "cosmos-sdk/MsgBurnDenom")
+	legacy.RegisterAminoMsg(registrar, &MsgChangeDenomAdmin{}, "cosmos-sdk/MsgChangeDenomAdmin")
+	legacy.RegisterAminoMsg(registrar, &MsgSetFactoryDenomMetadata{}, "cosmos-sdk/MsgSetFactoryDenomMetadata")
+	legacy.RegisterAminoMsg(registrar, &MsgSetDenomMetadata{}, "cosmos-sdk/MsgSetDenomMetadata")
 
 	registrar.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization")
 	registrar.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params")
@@ -27,6 +33,12 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgBurn{},
 		&MsgSetSendEnabled{},
+		&MsgCreateDenom{},
+		&MsgMintDenom{},
+		&MsgBurnDenom{},
+		&MsgChangeDenomAdmin{},
+		&MsgSetFactoryDenomMetadata{},
+		&MsgSetDenomMetadata{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
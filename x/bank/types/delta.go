@@ -0,0 +1,81 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// CreateAccountBalancesPrefix returns the prefix of the keys of the balances
+// of addr.
+func CreateAccountBalancesPrefix(addr []byte) []byte {
+	return append(BalancesPrefix.Bytes(), address.MustLengthPrefix(addr)...)
+}
+
+// MergeBalanceDelta returns current + (written - read), the three values being
+// balances encoded with BalanceValueCodec and nil standing for a zero balance.
+// It can be used as the baseapp.MergeDeltaFunc of the balances transactions
+// only add to, such as the ones of the fee collector.
+func MergeBalanceDelta(read, written, current []byte) ([]byte, bool) {
+	amounts := make([]math.Int, 3)
+	for i, bz := range [][]byte{read, written, current} {
+		amounts[i] = math.ZeroInt()
+		if bz == nil {
+			continue
+		}
+
+		amount, err := BalanceValueCodec.Decode(bz)
+		if err != nil {
+			return nil, false
+		}
+		amounts[i] = amount
+	}
+
+	merged, err := amounts[2].SafeAdd(amounts[1])
+	if err == nil {
+		merged, err = merged.SafeSub(amounts[0])
+	}
+	// zero balances are removed from the store
+	if err != nil || !merged.IsPositive() {
+		return nil, false
+	}
+
+	bz, err := BalanceValueCodec.Encode(merged)
+	if err != nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// MergeDenomHolderCountDelta returns current + (written - read), the three
+// values being denom holder counts and nil standing for a zero count. It can be
+// used as the baseapp.MergeDeltaFunc of the denom holder counts.
+func MergeDenomHolderCountDelta(read, written, current []byte) ([]byte, bool) {
+	counts := make([]uint64, 3)
+	for i, bz := range [][]byte{read, written, current} {
+		if bz == nil {
+			continue
+		}
+
+		count, err := collections.Uint64Value.Decode(bz)
+		if err != nil {
+			return nil, false
+		}
+		counts[i] = count
+	}
+
+	merged := counts[2] + counts[1] - counts[0]
+	// zero counts are removed from the store
+	if counts[2]+counts[1] < counts[0] || counts[2]+counts[1] < counts[2] || merged == 0 {
+		return nil, false
+	}
+
+	bz, err := collections.Uint64Value.Encode(merged)
+	if err != nil {
+		return nil, false
+	}
+
+	return bz, true
+}
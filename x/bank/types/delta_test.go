@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCreateAccountBalancesPrefix(t *testing.T) {
+	addr := sdk.AccAddress("fee_collector_______")
+	keyCodec := collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+
+	key, err := collections.EncodeKeyWithPrefix(BalancesPrefix, keyCodec, collections.Join(addr, "stake"))
+	require.NoError(t, err)
+	require.Equal(t, append(CreateAccountBalancesPrefix(addr), "stake"...), key)
+
+	other, err := collections.EncodeKeyWithPrefix(BalancesPrefix, keyCodec, collections.Join(sdk.AccAddress("fee_collector_______x"), "stake"))
+	require.NoError(t, err)
+	require.NotContains(t, string(other), string(CreateAccountBalancesPrefix(addr)))
+}
+
+func TestMergeBalanceDelta(t *testing.T) {
+	encode := func(amount int64) []byte {
+		bz, err := BalanceValueCodec.Encode(math.NewInt(amount))
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name                   string
+		read, written, current []byte
+		exp                    []byte
+		expOK                  bool
+	}{
+		{"added", encode(100), encode(150), encode(120), encode(170), true},
+		{"subtracted", encode(100), encode(90), encode(120), encode(110), true},
+		{"missing read", nil, encode(50), encode(20), encode(70), true},
+		{"missing current", encode(100), encode(150), nil, encode(50), true},
+		{"zero", encode(100), encode(50), encode(50), nil, false},
+		{"negative", encode(100), encode(10), encode(50), nil, false},
+		{"invalid", []byte("invalid"), encode(150), encode(120), nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			merged, ok := MergeBalanceDelta(tc.read, tc.written, tc.current)
+			require.Equal(t, tc.expOK, ok)
+			require.Equal(t, tc.exp, merged)
+		})
+	}
+}
+
+func TestMergeDenomHolderCountDelta(t *testing.T) {
+	encode := func(count uint64) []byte {
+		bz, err := collections.Uint64Value.Encode(count)
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name                   string
+		read, written, current []byte
+		exp                    []byte
+		expOK                  bool
+	}{
+		{"incremented", encode(3), encode(4), encode(7), encode(8), true},
+		{"decremented", encode(3), encode(2), encode(7), encode(6), true},
+		{"missing read", nil, encode(1), encode(7), encode(8), true},
+		{"zero", encode(3), encode(2), encode(1), nil, false},
+		{"underflow", encode(3), encode(2), nil, nil, false},
+		{"invalid", []byte{1}, encode(2), encode(1), nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			merged, ok := MergeDenomHolderCountDelta(tc.read, tc.written, tc.current)
+			require.Equal(t, tc.expOK, ok)
+			require.Equal(t, tc.exp, merged)
+		})
+	}
+}
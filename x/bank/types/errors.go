@@ -13,4 +13,10 @@ var (
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
 	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrInvalidFactoryDenom   = errors.Register(ModuleName, 11, "invalid factory denom")
+	ErrFactoryDenomExists    = errors.Register(ModuleName, 12, "factory denom already exists")
+	ErrFactoryDenomNotFound  = errors.Register(ModuleName, 13, "factory denom not found")
+	ErrHoldNotFound          = errors.Register(ModuleName, 14, "hold not found")
+	ErrDeniedAddress         = errors.Register(ModuleName, 15, "address is denied")
+	ErrOutflowQuotaExceeded  = errors.Register(ModuleName, 16, "outflow quota exceeded")
 )
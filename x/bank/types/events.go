@@ -21,4 +21,23 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// factory denom events name and attributes
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeChangeDenomAdmin = "change_denom_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator  = "creator"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyAdmin    = "admin"
+	AttributeKeyNewAdmin = "new_admin"
+	AttributeKeyUpdater  = "updater"
+
+	// hold events name and attributes
+	EventTypeHold    = "hold"
+	EventTypeRelease = "release"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyHolder  = "holder"
+	AttributeKeyReason  = "reason"
 )
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of the factory denoms, which are of the
+	// form factory/{creator}/{subdenom}.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory denom.
+	MaxSubdenomLength = 44
+	// MaxCreatorLength is the maximum length of the creator address of a factory
+	// denom, so that the denom fits the 128 characters of a valid denom.
+	MaxCreatorLength = 128 - len(FactoryDenomPrefix) - MaxSubdenomLength - 2
+)
+
+// NewFactoryDenom returns the factory denom of the given creator and subdenom,
+// of the form factory/{creator}/{subdenom}.
+func NewFactoryDenom(creator, subdenom string) (string, error) {
+	if creator == "" {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, "creator cannot be empty")
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "creator %s is longer than %d characters", creator, MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "creator %s cannot contain '/'", creator)
+	}
+	if subdenom == "" {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, "subdenom cannot be empty")
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom %s is longer than %d characters", subdenom, MaxSubdenomLength)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// ParseFactoryDenom returns the creator and subdenom of a factory denom.
+func ParseFactoryDenom(denom string) (creator, subdenom string, err error) {
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	// the denom is built again to validate its parts
+	if _, err := NewFactoryDenom(parts[1], parts[2]); err != nil {
+		return "", "", err
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns whether the denom is in the factory denom namespace.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, FactoryDenomPrefix+"/")
+}
+
+// NewFactoryDenomMetadata returns the initial metadata of a factory denom, with
+// a single denom unit.
+func NewFactoryDenomMetadata(denom, subdenom string) Metadata {
+	return Metadata{
+		DenomUnits: []*DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     subdenom,
+	}
+}
+
+// Validate performs a basic validation of the factory denom. Its admin address
+// is validated when the genesis state is imported.
+func (fd FactoryDenom) Validate() error {
+	_, _, err := ParseFactoryDenom(fd.Denom)
+	return err
+}
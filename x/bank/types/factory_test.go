@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/bank/types"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := "cosmos1t5u0jfg3ljsjrh2m9e47d4ny2hea7eehxrzdgd"
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expErr   string
+	}{
+		{"valid", creator, "uatom", ""},
+		{"valid subdenom with slashes", creator, "a/b", ""},
+		{"empty creator", "", "uatom", "creator cannot be empty"},
+		{"creator with slash", "a/b", "uatom", "cannot contain '/'"},
+		{"creator too long", strings.Repeat("a", types.MaxCreatorLength+1), "uatom", "longer than"},
+		{"empty subdenom", creator, "", "subdenom cannot be empty"},
+		{"subdenom too long", creator, strings.Repeat("a", types.MaxSubdenomLength+1), "longer than"},
+		{"invalid subdenom", creator, "u atom", "invalid denom"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.NewFactoryDenom(tc.creator, tc.subdenom)
+			if tc.expErr != "" {
+				require.ErrorIs(t, err, types.ErrInvalidFactoryDenom)
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "factory/"+tc.creator+"/"+tc.subdenom, denom)
+			require.True(t, types.IsFactoryDenom(denom))
+
+			parsedCreator, parsedSubdenom, err := types.ParseFactoryDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, tc.creator, parsedCreator)
+			require.Equal(t, tc.subdenom, parsedSubdenom)
+			require.NoError(t, types.NewFactoryDenomMetadata(denom, tc.subdenom).Validate())
+		})
+	}
+
+	for _, denom := range []string{"uatom", "factory/" + creator, "ibc/" + creator + "/uatom", "factory//uatom"} {
+		_, _, err := types.ParseFactoryDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidFactoryDenom, denom)
+	}
+}
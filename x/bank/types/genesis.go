@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +24,10 @@ func (gs GenesisState) Validate() error {
 	seenSendEnabled := make(map[string]bool)
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenFactoryDenoms := make(map[string]bool)
+	seenHolds := make(map[string]bool)
+	seenOutflows := make(map[string]bool)
+	seenSnapshots := make(map[int64]bool)
 
 	totalSupply := sdk.Coins{}
 
@@ -62,6 +67,56 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	for _, fd := range gs.FactoryDenoms {
+		if seenFactoryDenoms[fd.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", fd.Denom)
+		}
+
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[fd.Denom] = true
+	}
+
+	for _, hold := range gs.Holds {
+		key := strings.Join([]string{hold.Address, hold.Holder, hold.Reason}, "/")
+		if seenHolds[key] {
+			return fmt.Errorf("duplicate hold of %s by %s for reason %q", hold.Address, hold.Holder, hold.Reason)
+		}
+
+		if err := hold.Validate(); err != nil {
+			return err
+		}
+
+		seenHolds[key] = true
+	}
+
+	for _, outflow := range gs.Outflows {
+		key := outflow.Address + "/" + outflow.Denom
+		if seenOutflows[key] {
+			return fmt.Errorf("duplicate outflow of %s by %s", outflow.Denom, outflow.Address)
+		}
+
+		if err := outflow.Validate(); err != nil {
+			return err
+		}
+
+		seenOutflows[key] = true
+	}
+
+	for _, snapshot := range gs.SupplySnapshots {
+		if seenSnapshots[snapshot.Height] {
+			return fmt.Errorf("duplicate supply snapshot at height %d", snapshot.Height)
+		}
+
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+
+		seenSnapshots[snapshot.Height] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
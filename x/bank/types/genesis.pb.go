@@ -40,6 +40,16 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// factory_denoms defines the factory denoms with their admin.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,6,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// holds defines the coins held by modules in the balances of the accounts.
+	Holds []Hold `protobuf:"bytes,7,rep,name=holds,proto3" json:"holds"`
+	// outflows defines the amounts sent by the accounts over their current outflow
+	// quota periods.
+	Outflows []Outflow `protobuf:"bytes,8,rep,name=outflows,proto3" json:"outflows"`
+	// supply_snapshots defines the supply snapshots, see
+	// Params.supply_snapshot_interval.
+	SupplySnapshots []SupplySnapshot `protobuf:"bytes,9,rep,name=supply_snapshots,json=supplySnapshots,proto3" json:"supply_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +120,34 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutflows() []Outflow {
+	if m != nil {
+		return m.Outflows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplySnapshots() []SupplySnapshot {
+	if m != nil {
+		return m.SupplySnapshots
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -152,45 +190,113 @@ func (m *Balance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Balance proto.InternalMessageInfo
 
+// FactoryDenom defines a factory denom and its admin, used in the bank module's
+// genesis state.
+type FactoryDenom struct {
+	// denom is the factory denom, of the form factory/{creator}/{subdenom}.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// authority_metadata holds the admin of the denom.
+	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
+}
+func (m *FactoryDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
+
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
+	if m != nil {
+		return m.AuthorityMetadata
+	}
+	return DenomAuthorityMetadata{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v1beta1.FactoryDenom")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x40, 0x98, 0x0e, 0x6e, 0x29, 0x4e, 0xe8, 0x14, 0x55,
-	0xca, 0x99, 0xa6, 0x48, 0x48, 0x0c, 0x48, 0xb8, 0x02, 0x26, 0x04, 0x6a, 0x36, 0x96, 0xe8, 0xec,
-	0x3b, 0x19, 0x2b, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a, 0x22, 0xa6,
-	0x0e, 0xfd, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0xf9, 0x18, 0xc8, 0xf7,
-	0xae, 0x49, 0x24, 0x3c, 0xb3, 0xd8, 0x96, 0xff, 0xff, 0xf7, 0xfb, 0xbf, 0xf7, 0xee, 0xc8, 0xdd,
-	0x44, 0x41, 0xae, 0x20, 0x8c, 0x99, 0x1c, 0x86, 0x6f, 0xf6, 0x62, 0xa1, 0xd9, 0x5e, 0x98, 0x0a,
-	0x29, 0x20, 0x03, 0x5a, 0x8c, 0x95, 0x56, 0xde, 0x2d, 0xb4, 0xd0, 0xd2, 0x42, 0xad, 0x65, 0x6b,
-	0x23, 0x55, 0xa9, 0x32, 0x7a, 0x58, 0x7e, 0xa1, 0x75, 0x2b, 0x98, 0xd3, 0x40, 0xcc, 0x69, 0x89,
-	0xca, 0xe4, 0x3f, 0xfa, 0x52, 0x9a, 0xe1, 0xa2, 0xbe, 0x89, 0xfa, 0x00, 0xc1, 0x36, 0x17, 0xa5,
-	0x9b, 0x2c, 0xcf, 0xa4, 0x0a, 0xcd, 0x13, 0x7f, 0xed, 0x7c, 0xad, 0x91, 0xe6, 0x33, 0x6c, 0xb5,
-	0xaf, 0x99, 0x16, 0xde, 0x23, 0x52, 0x2f, 0xd8, 0x98, 0xe5, 0xe0, 0xbb, 0x6d, 0xb7, 0xd3, 0xe8,
-	0xdd, 0xa6, 0x15, 0xad, 0xd3, 0x97, 0xc6, 0x12, 0xad, 0x9f, 0xfd, 0x6c, 0x39, 0x9f, 0x2e, 0x4e,
-	0x76, 0xdd, 0x43, 0x5b, 0xe5, 0x1d, 0x90, 0xb5, 0x98, 0x8d, 0x98, 0x4c, 0x04, 0xf8, 0x57, 0xda,
-	0xb5, 0x4e, 0xa3, 0xb7, 0x5d, 0x49, 0x88, 0xd0, 0xb4, 0x8c, 0x98, 0x17, 0x7a, 0x13, 0x52, 0x87,
-	0xa3, 0xa2, 0x18, 0x4d, 0xfc, 0x9a, 0x41, 0x6c, 0x2e, 0x10, 0x20, 0xe6, 0x88, 0x03, 0x95, 0xc9,
-	0xe8, 0x69, 0x59, 0xff, 0xf9, 0x57, 0xab, 0x93, 0x66, 0xfa, 0xf5, 0x51, 0x4c, 0x13, 0x95, 0xdb,
-	0xa1, 0xed, 0xab, 0x0b, 0x7c, 0x18, 0xea, 0x49, 0x21, 0xc0, 0x14, 0xc0, 0xc7, 0x8b, 0x93, 0xdd,
-	0xe6, 0x48, 0xa4, 0x2c, 0x99, 0x0c, 0xca, 0xb5, 0x82, 0xed, 0x1f, 0x03, 0xbd, 0x17, 0xe4, 0x3a,
-	0x17, 0x52, 0xe5, 0x83, 0x5c, 0x68, 0xc6, 0x99, 0x66, 0xfe, 0x55, 0xd3, 0xc2, 0x9d, 0xca, 0x29,
-	0x9e, 0x5b, 0xd3, 0xf2, 0x18, 0xd7, 0x4c, 0xfd, 0xa5, 0xe2, 0x31, 0xd2, 0x04, 0x21, 0xf9, 0x40,
-	0x48, 0x16, 0x8f, 0x04, 0xf7, 0x57, 0x0c, 0xae, 0x5d, 0x89, 0xeb, 0x0b, 0xc9, 0x9f, 0xa0, 0x2f,
-	0xda, 0x2e, 0x89, 0x3f, 0x4e, 0xbb, 0x37, 0x16, 0x63, 0xb4, 0xef, 0xd1, 0xfb, 0x0f, 0x30, 0xa4,
-	0x01, 0x0b, 0xeb, 0xce, 0x17, 0x97, 0xac, 0xda, 0x7d, 0x7a, 0x3d, 0xb2, 0xca, 0x38, 0x1f, 0x0b,
-	0xc0, 0x03, 0x5c, 0x8f, 0xfc, 0x6f, 0xa7, 0xdd, 0x0d, 0x1b, 0xf6, 0x18, 0x95, 0xbe, 0x1e, 0x67,
-	0x32, 0x3d, 0xbc, 0x34, 0x7a, 0x6f, 0xc9, 0x8a, 0xd9, 0x84, 0x3d, 0xb0, 0xff, 0xb0, 0x6d, 0xcc,
-	0x7b, 0xb8, 0xf6, 0xfe, 0xb8, 0xe5, 0xfc, 0x39, 0x6e, 0x39, 0xd1, 0xfe, 0xd9, 0x34, 0x70, 0xcf,
-	0xa7, 0x81, 0xfb, 0x7b, 0x1a, 0xb8, 0x1f, 0x66, 0x81, 0x73, 0x3e, 0x0b, 0x9c, 0xef, 0xb3, 0xc0,
-	0x79, 0x65, 0xef, 0x33, 0xf0, 0x21, 0xcd, 0x54, 0xf8, 0x0e, 0xef, 0xbd, 0x49, 0x88, 0xeb, 0xe6,
-	0x0e, 0xef, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe3, 0x0e, 0x29, 0x81, 0x03, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3f, 0x53, 0x13, 0x4f,
+	0x18, 0xc7, 0x73, 0xf0, 0x4b, 0x08, 0x4b, 0x7e, 0x20, 0x0b, 0xc5, 0x81, 0x78, 0x09, 0xd8, 0x30,
+	0x38, 0xdc, 0x49, 0xd0, 0x71, 0x86, 0xc2, 0x19, 0x82, 0xa2, 0x0d, 0x83, 0x43, 0x66, 0x2c, 0x6c,
+	0x32, 0x9b, 0xdc, 0x26, 0xb9, 0xe1, 0x6e, 0xf7, 0xe6, 0x9e, 0x8d, 0x78, 0xef, 0xc0, 0xd2, 0xda,
+	0x8a, 0xd2, 0xb1, 0xa2, 0xa0, 0xd7, 0x92, 0x92, 0xa1, 0x72, 0x2c, 0xd4, 0x81, 0x02, 0x5f, 0x86,
+	0x73, 0xbb, 0x4b, 0x72, 0xc2, 0x69, 0x2a, 0x9b, 0xfc, 0xb9, 0xe7, 0xfb, 0x7c, 0xbe, 0xfb, 0x3c,
+	0xf7, 0xec, 0x83, 0x16, 0x5b, 0x1c, 0x02, 0x0e, 0x4e, 0x93, 0xb0, 0x7d, 0xe7, 0xf5, 0x5a, 0x93,
+	0x0a, 0xb2, 0xe6, 0x74, 0x28, 0xa3, 0xe0, 0x81, 0x1d, 0x46, 0x5c, 0x70, 0x3c, 0xa3, 0x24, 0x76,
+	0x22, 0xb1, 0xb5, 0x64, 0x7e, 0xb6, 0xc3, 0x3b, 0x5c, 0xc6, 0x9d, 0xe4, 0x97, 0x92, 0xce, 0x5b,
+	0x7d, 0x1a, 0xd0, 0x3e, 0xad, 0xc5, 0x3d, 0x76, 0x23, 0x9e, 0x72, 0x93, 0x5c, 0x15, 0x9f, 0x53,
+	0xf1, 0x86, 0x02, 0x6b, 0x5f, 0x15, 0x9a, 0x26, 0x81, 0xc7, 0xb8, 0x23, 0x3f, 0xd5, 0xa3, 0xa5,
+	0x4f, 0x05, 0x54, 0x7a, 0xa6, 0x8e, 0x5a, 0x17, 0x44, 0x50, 0xfc, 0x18, 0x15, 0x42, 0x12, 0x91,
+	0x00, 0x4c, 0xa3, 0x62, 0x2c, 0x4f, 0x54, 0x6f, 0xdb, 0x19, 0x47, 0xb7, 0x5f, 0x48, 0x49, 0x6d,
+	0xfc, 0xe4, 0x5b, 0x39, 0xf7, 0xe1, 0xf2, 0x68, 0xc5, 0xd8, 0xd3, 0x59, 0x78, 0x0b, 0x15, 0x9b,
+	0xc4, 0x27, 0xac, 0x45, 0xc1, 0x1c, 0xa9, 0x8c, 0x2e, 0x4f, 0x54, 0x17, 0x32, 0x09, 0x35, 0x25,
+	0x4a, 0x23, 0xfa, 0x89, 0x38, 0x46, 0x05, 0xe8, 0x85, 0xa1, 0x1f, 0x9b, 0xa3, 0x12, 0x31, 0x37,
+	0x40, 0x00, 0xed, 0x23, 0xb6, 0xb8, 0xc7, 0x6a, 0xdb, 0x49, 0xfe, 0xc7, 0xef, 0xe5, 0xe5, 0x8e,
+	0x27, 0xba, 0xbd, 0xa6, 0xdd, 0xe2, 0x81, 0x2e, 0x5a, 0x7f, 0xad, 0x82, 0xbb, 0xef, 0x88, 0x38,
+	0xa4, 0x20, 0x13, 0xe0, 0xfd, 0xe5, 0xd1, 0x4a, 0xc9, 0xa7, 0x1d, 0xd2, 0x8a, 0x1b, 0x49, 0x5b,
+	0x41, 0x9f, 0x5f, 0x19, 0xe2, 0x5d, 0x34, 0xe9, 0x52, 0xc6, 0x83, 0x46, 0x40, 0x05, 0x71, 0x89,
+	0x20, 0xe6, 0x7f, 0xf2, 0x08, 0x77, 0x32, 0xab, 0xd8, 0xd1, 0xa2, 0x74, 0x19, 0xff, 0xcb, 0xfc,
+	0xab, 0x08, 0x26, 0xa8, 0x04, 0x94, 0xb9, 0x0d, 0xca, 0x48, 0xd3, 0xa7, 0xae, 0x99, 0x97, 0xb8,
+	0x4a, 0x26, 0xae, 0x4e, 0x99, 0xfb, 0x54, 0xe9, 0x6a, 0x0b, 0x09, 0xf1, 0xeb, 0xf1, 0xea, 0xd4,
+	0xa0, 0x8c, 0xca, 0x7d, 0xfb, 0xc1, 0x23, 0x65, 0x32, 0x01, 0x03, 0x29, 0x6e, 0xa3, 0xc9, 0x36,
+	0x69, 0x09, 0x1e, 0xc5, 0x0d, 0xe9, 0x0d, 0x66, 0x41, 0x9a, 0x2c, 0x66, 0x9a, 0x6c, 0x2b, 0xe9,
+	0x93, 0x44, 0xf9, 0x07, 0x97, 0x87, 0x55, 0x5d, 0x4a, 0x3b, 0xa5, 0x05, 0xbc, 0x83, 0xf2, 0x5d,
+	0xee, 0xbb, 0x60, 0x8e, 0x5d, 0x7f, 0x2b, 0x29, 0xfc, 0x73, 0xee, 0xbb, 0x43, 0xb0, 0x8a, 0x82,
+	0x5f, 0xa2, 0x22, 0xef, 0x89, 0xb6, 0xcf, 0x0f, 0xc0, 0x2c, 0xfe, 0x65, 0x54, 0x76, 0x95, 0x68,
+	0x08, 0xb4, 0xcf, 0xc2, 0x3e, 0xba, 0xa5, 0x5e, 0x66, 0x03, 0x18, 0x09, 0xa1, 0xcb, 0x05, 0x98,
+	0xe3, 0x92, 0x7f, 0x37, 0xbb, 0xeb, 0x52, 0x5c, 0xd7, 0xda, 0x21, 0x36, 0x53, 0xf0, 0x9b, 0x1a,
+	0x96, 0x3e, 0x1b, 0x68, 0x4c, 0x0f, 0x33, 0xae, 0xa2, 0x31, 0xe2, 0xba, 0x11, 0x05, 0x75, 0x7b,
+	0xc6, 0x6b, 0xe6, 0xd9, 0xf1, 0xea, 0xac, 0xf6, 0xdc, 0x54, 0x91, 0xba, 0x88, 0x3c, 0xd6, 0xd9,
+	0xbb, 0x12, 0xe2, 0x03, 0x94, 0x97, 0x63, 0x68, 0x8e, 0x5c, 0x6f, 0xea, 0xbf, 0x1a, 0x75, 0xe5,
+	0xb7, 0x51, 0x7c, 0x7b, 0x58, 0xce, 0xfd, 0x3c, 0x2c, 0xe7, 0x96, 0x0e, 0x0d, 0x54, 0x4a, 0x4f,
+	0x05, 0x9e, 0x45, 0x79, 0x39, 0x48, 0xaa, 0x8a, 0x3d, 0xf5, 0x07, 0x53, 0x84, 0x49, 0x4f, 0x74,
+	0x79, 0xe4, 0x89, 0x78, 0x70, 0x3d, 0x46, 0xe4, 0x9a, 0xb8, 0x97, 0xd9, 0x59, 0x49, 0xdb, 0xbc,
+	0xca, 0xc9, 0xba, 0x2c, 0xd3, 0xe4, 0x7a, 0x74, 0x63, 0xe6, 0xec, 0x66, 0xef, 0x6b, 0xeb, 0x27,
+	0xe7, 0x96, 0x71, 0x7a, 0x6e, 0x19, 0x3f, 0xce, 0x2d, 0xe3, 0xdd, 0x85, 0x95, 0x3b, 0xbd, 0xb0,
+	0x72, 0x5f, 0x2e, 0xac, 0xdc, 0x2b, 0xbd, 0xef, 0xc0, 0xdd, 0xb7, 0x3d, 0xee, 0xbc, 0x51, 0x7b,
+	0x51, 0x36, 0xa1, 0x59, 0x90, 0x3b, 0x6e, 0xfd, 0xd7, 0x00, 0x14, 0xa5, 0x26, 0x80, 0xa1, 0x05,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +319,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SupplySnapshots) > 0 {
+		for iNdEx := len(m.SupplySnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplySnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Outflows) > 0 {
+		for iNdEx := len(m.Outflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -326,6 +488,46 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -369,6 +571,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Outflows) > 0 {
+		for _, e := range m.Outflows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplySnapshots) > 0 {
+		for _, e := range m.SupplySnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -391,6 +617,21 @@ func (m *Balance) Size() (n int) {
 	return n
 }
 
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -595,6 +836,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outflows = append(m.Outflows, Outflow{})
+			if err := m.Outflows[len(m.Outflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplySnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplySnapshots = append(m.SupplySnapshots, SupplySnapshot{})
+			if err := m.SupplySnapshots[len(m.SupplySnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -732,6 +1109,121 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -143,6 +143,77 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid holds",
+			GenesisState{
+				Holds: []Hold{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "auction", Reason: "bid", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "auction", Reason: "fee", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate holds",
+			GenesisState{
+				Holds: []Hold{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "auction", Reason: "bid", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "auction", Reason: "bid", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 2))},
+				},
+			},
+			true,
+		},
+		{
+			"hold without holder",
+			GenesisState{
+				Holds: []Hold{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Reason: "bid", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))},
+				},
+			},
+			true,
+		},
+		{
+			"hold of zero coins",
+			GenesisState{
+				Holds: []Hold{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "auction", Reason: "bid"},
+				},
+			},
+			true,
+		},
+		{
+			"valid supply snapshots",
+			GenesisState{
+				SupplySnapshots: []SupplySnapshot{
+					{Height: 10, Supply: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), HolderCounts: []DenomHolderCount{{Denom: "uatom", Count: 1}}},
+					{Height: 20},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate supply snapshots",
+			GenesisState{
+				SupplySnapshots: []SupplySnapshot{{Height: 10}, {Height: 10}},
+			},
+			true,
+		},
+		{
+			"supply snapshot at height zero",
+			GenesisState{
+				SupplySnapshots: []SupplySnapshot{{Height: 0}},
+			},
+			true,
+		},
+		{
+			"duplicate supply snapshot holder counts",
+			GenesisState{
+				SupplySnapshots: []SupplySnapshot{
+					{Height: 10, HolderCounts: []DenomHolderCount{{Denom: "uatom", Count: 1}, {Denom: "uatom", Count: 2}}},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
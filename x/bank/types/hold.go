@@ -0,0 +1,25 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate performs a basic validation of the hold. Its address is validated
+// when the genesis state is imported.
+func (h Hold) Validate() error {
+	if h.Address == "" {
+		return errors.New("hold address cannot be empty")
+	}
+	if h.Holder == "" {
+		return fmt.Errorf("holder of the hold of %s cannot be empty", h.Address)
+	}
+	if err := h.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount held from %s by %s: %w", h.Address, h.Holder, err)
+	}
+	if h.Amount.IsZero() {
+		return fmt.Errorf("amount held from %s by %s cannot be zero", h.Address, h.Holder)
+	}
+
+	return nil
+}
@@ -39,6 +39,36 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// DenomAuthorityPrefix is the prefix for the admins of the factory denoms.
+	DenomAuthorityPrefix = collections.NewPrefix(6)
+
+	// DenomMetadataHistoryPrefix is the prefix for the changes of the denom
+	// metadata, keyed by denom and sequence.
+	DenomMetadataHistoryPrefix = collections.NewPrefix(7)
+	// DenomMetadataHistorySequenceKey is the key of the sequence of the changes
+	// of the denom metadata.
+	DenomMetadataHistorySequenceKey = collections.NewPrefix(8)
+
+	// HoldsPrefix is the prefix for the coins held by modules in the balances of
+	// the accounts, keyed by address, holder and reason.
+	HoldsPrefix = collections.NewPrefix(9)
+
+	// OutflowsPrefix is the prefix for the amounts sent by the accounts over
+	// their current outflow quota periods, keyed by address and denom.
+	OutflowsPrefix = collections.NewPrefix(10)
+
+	// DenomHolderCountsPrefix is the prefix for the numbers of accounts holding
+	// each denom.
+	DenomHolderCountsPrefix = collections.NewPrefix(11)
+
+	// SupplySnapshotsPrefix is the prefix for the supply snapshots, keyed by
+	// height.
+	SupplySnapshotsPrefix = collections.NewPrefix(12)
+
+	// HoldCountsPrefix is the prefix for the numbers of holds on the balances
+	// of the accounts, keyed by address.
+	HoldCountsPrefix = collections.NewPrefix(13)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
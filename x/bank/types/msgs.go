@@ -10,6 +10,12 @@ var (
 	_ coretransaction.Msg = &MsgSend{}
 	_ coretransaction.Msg = &MsgMultiSend{}
 	_ coretransaction.Msg = &MsgUpdateParams{}
+	_ coretransaction.Msg = &MsgCreateDenom{}
+	_ coretransaction.Msg = &MsgMintDenom{}
+	_ coretransaction.Msg = &MsgBurnDenom{}
+	_ coretransaction.Msg = &MsgChangeDenomAdmin{}
+	_ coretransaction.Msg = &MsgSetFactoryDenomMetadata{}
+	_ coretransaction.Msg = &MsgSetDenomMetadata{}
 )
 
 // NewMsgSend constructs a msg to send coins from one account to another.
@@ -30,3 +36,36 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgCreateDenom constructs a msg to create the factory denom
+// factory/{sender}/{subdenom}.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgMintDenom constructs a msg to mint a factory denom to an account.
+func NewMsgMintDenom(admin string, amount sdk.Coin, mintToAddr string) *MsgMintDenom {
+	return &MsgMintDenom{Admin: admin, Amount: amount, MintToAddress: mintToAddr}
+}
+
+// NewMsgBurnDenom constructs a msg to burn a factory denom from the admin.
+func NewMsgBurnDenom(admin string, amount sdk.Coin) *MsgBurnDenom {
+	return &MsgBurnDenom{Admin: admin, Amount: amount}
+}
+
+// NewMsgChangeDenomAdmin constructs a msg to change the admin of a factory denom.
+func NewMsgChangeDenomAdmin(admin, denom, newAdmin string) *MsgChangeDenomAdmin {
+	return &MsgChangeDenomAdmin{Admin: admin, Denom: denom, NewAdmin: newAdmin}
+}
+
+// NewMsgSetFactoryDenomMetadata constructs a msg to set the metadata of a
+// factory denom.
+func NewMsgSetFactoryDenomMetadata(admin string, metadata Metadata) *MsgSetFactoryDenomMetadata {
+	return &MsgSetFactoryDenomMetadata{Admin: admin, Metadata: metadata}
+}
+
+// NewMsgSetDenomMetadata constructs a msg to set the metadata of a denom with
+// the module authority.
+func NewMsgSetDenomMetadata(authority string, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Authority: authority, Metadata: metadata}
+}
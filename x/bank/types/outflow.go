@@ -0,0 +1,24 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the outflow. Its address is validated
+// when the genesis state is imported.
+func (o Outflow) Validate() error {
+	if o.Address == "" {
+		return errors.New("outflow address cannot be empty")
+	}
+	if err := sdk.ValidateDenom(o.Denom); err != nil {
+		return fmt.Errorf("invalid outflow denom: %w", err)
+	}
+	if o.Amount.IsNil() || o.Amount.IsNegative() {
+		return fmt.Errorf("outflow amount of %s by %s cannot be negative: %s", o.Denom, o.Address, o.Amount)
+	}
+
+	return nil
+}
@@ -3,6 +3,9 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -31,9 +34,57 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+	if err := validateTransferTaxes(p.TransferTaxes); err != nil {
+		return err
+	}
+	if err := validateOutflowQuotas(p.OutflowQuotas); err != nil {
+		return err
+	}
+	if err := validateDeniedAddresses(p.DeniedAddresses); err != nil {
+		return err
+	}
+	if err := validateExemptModules(p.ExemptModules); err != nil {
+		return err
+	}
+	if err := validateSupplySnapshotDenoms(p.SupplySnapshotDenoms); err != nil {
+		return err
+	}
 	return validateIsBool(p.DefaultSendEnabled)
 }
 
+// GetTransferTax returns the transfer tax of a denom, if any.
+func (p Params) GetTransferTax(denom string) (TransferTax, bool) {
+	for _, tax := range p.TransferTaxes {
+		if tax.Denom == denom {
+			return tax, true
+		}
+	}
+	return TransferTax{}, false
+}
+
+// GetOutflowQuota returns the outflow quota of a denom, if any.
+func (p Params) GetOutflowQuota(denom string) (OutflowQuota, bool) {
+	for _, quota := range p.OutflowQuotas {
+		if quota.Denom == denom {
+			return quota, true
+		}
+	}
+	return OutflowQuota{}, false
+}
+
+// IsDenied returns whether the address can neither send nor receive the denom.
+func (p Params) IsDenied(addr, denom string) bool {
+	for _, denied := range p.DeniedAddresses {
+		if denied.Address == addr {
+			return len(denied.Denoms) == 0 || slices.Contains(denied.Denoms, denom)
+		}
+	}
+	return false
+}
+
 // Validate gets any errors with this SendEnabled entry.
 func (se SendEnabled) Validate() error {
 	return sdk.ValidateDenom(se.Denom)
@@ -48,6 +99,94 @@ func NewSendEnabled(denom string, sendEnabled bool) *SendEnabled {
 	}
 }
 
+func validateTransferTaxes(taxes []TransferTax) error {
+	seen := make(map[string]bool)
+	for _, tax := range taxes {
+		if err := sdk.ValidateDenom(tax.Denom); err != nil {
+			return fmt.Errorf("invalid transfer tax denom: %w", err)
+		}
+		if seen[tax.Denom] {
+			return fmt.Errorf("duplicate transfer tax for denom %s", tax.Denom)
+		}
+		if tax.Rate.IsNil() || !tax.Rate.IsPositive() || tax.Rate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("transfer tax rate of denom %s must be positive and at most 1: %s", tax.Denom, tax.Rate)
+		}
+		seen[tax.Denom] = true
+	}
+	return nil
+}
+
+func validateOutflowQuotas(quotas []OutflowQuota) error {
+	seen := make(map[string]bool)
+	for _, quota := range quotas {
+		if err := sdk.ValidateDenom(quota.Denom); err != nil {
+			return fmt.Errorf("invalid outflow quota denom: %w", err)
+		}
+		if seen[quota.Denom] {
+			return fmt.Errorf("duplicate outflow quota for denom %s", quota.Denom)
+		}
+		if quota.Amount.IsNil() || quota.Amount.IsNegative() {
+			return fmt.Errorf("outflow quota amount of denom %s cannot be negative: %s", quota.Denom, quota.Amount)
+		}
+		if quota.Period <= 0 {
+			return fmt.Errorf("outflow quota period of denom %s must be positive: %s", quota.Denom, quota.Period)
+		}
+		seen[quota.Denom] = true
+	}
+	return nil
+}
+
+// validateDeniedAddresses validates the denied addresses, whose format is
+// validated by the keeper.
+func validateDeniedAddresses(deniedAddrs []DeniedAddress) error {
+	seen := make(map[string]bool)
+	for _, denied := range deniedAddrs {
+		if denied.Address == "" {
+			return errors.New("denied address cannot be empty")
+		}
+		if seen[denied.Address] {
+			return fmt.Errorf("duplicate denied address %s", denied.Address)
+		}
+		for _, denom := range denied.Denoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid denom of denied address %s: %w", denied.Address, err)
+			}
+		}
+		seen[denied.Address] = true
+	}
+	return nil
+}
+
+// validateExemptModules validates the names of the exempt modules, whose
+// accounts are checked by the keeper.
+func validateExemptModules(names []string) error {
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if name == "" {
+			return errors.New("exempt module name cannot be empty")
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate exempt module %s", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+func validateSupplySnapshotDenoms(denoms []string) error {
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid supply snapshot denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate supply snapshot denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
 // validateIsBool is used by the x/params module to validate that a thing is a bool.
 func validateIsBool(i interface{}) error {
 	_, ok := i.(bool)
@@ -2,10 +2,13 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -38,22 +41,22 @@ func Test_ParamsString(t *testing.T) {
 	}{
 		{
 			name:     "default true empty send enabled",
-			params:   Params{[]*SendEnabled{}, true},
+			params:   Params{[]*SendEnabled{}, true, nil, nil, nil, nil, 0, nil, 0, nil},
 			expected: "default_send_enabled:true ",
 		},
 		{
 			name:     "default false empty send enabled",
-			params:   Params{[]*SendEnabled{}, false},
+			params:   Params{[]*SendEnabled{}, false, nil, nil, nil, nil, 0, nil, 0, nil},
 			expected: "",
 		},
 		{
 			name:     "default true one true send enabled",
-			params:   Params{[]*SendEnabled{{"foocoin", true}}, true},
+			params:   Params{[]*SendEnabled{{"foocoin", true}}, true, nil, nil, nil, nil, 0, nil, 0, nil},
 			expected: "send_enabled:<denom:\"foocoin\" enabled:true > default_send_enabled:true ",
 		},
 		{
 			name:     "default true one false send enabled",
-			params:   Params{[]*SendEnabled{{"barcoin", false}}, true},
+			params:   Params{[]*SendEnabled{{"barcoin", false}}, true, nil, nil, nil, nil, 0, nil, 0, nil},
 			expected: "send_enabled:<denom:\"barcoin\" > default_send_enabled:true ",
 		},
 	}
@@ -69,5 +72,42 @@ func Test_validateParams(t *testing.T) {
 	assert.NoError(t, DefaultParams().Validate(), "default")
 	assert.NoError(t, NewParams(true).Validate(), "true")
 	assert.NoError(t, NewParams(false).Validate(), "false")
-	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true}.Validate(), "with SendEnabled entry")
+	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true, nil, nil, nil, nil, 0, nil, 0, nil}.Validate(), "with SendEnabled entry")
+	assert.NoError(t, Params{DefaultSendEnabled: true, DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}.Validate(), "with denom creation fee")
+	assert.Error(t, Params{DefaultSendEnabled: true, DenomCreationFee: sdk.Coins{sdk.NewInt64Coin("stake", 0)}}.Validate(), "with zero denom creation fee")
+
+	tax := TransferTax{Denom: "stake", Rate: math.LegacyNewDecWithPrec(1, 2)}
+	assert.NoError(t, Params{TransferTaxes: []TransferTax{tax}}.Validate(), "with transfer tax")
+	assert.Error(t, Params{TransferTaxes: []TransferTax{tax, tax}}.Validate(), "with duplicate transfer taxes")
+	assert.Error(t, Params{TransferTaxes: []TransferTax{{Denom: "stake", Rate: math.LegacyZeroDec()}}}.Validate(), "with zero transfer tax rate")
+	assert.Error(t, Params{TransferTaxes: []TransferTax{{Denom: "stake", Rate: math.LegacyNewDec(2)}}}.Validate(), "with transfer tax rate above 1")
+
+	quota := OutflowQuota{Denom: "stake", Amount: math.NewInt(100), Period: time.Hour}
+	assert.NoError(t, Params{OutflowQuotas: []OutflowQuota{quota}}.Validate(), "with outflow quota")
+	assert.Error(t, Params{OutflowQuotas: []OutflowQuota{quota, quota}}.Validate(), "with duplicate outflow quotas")
+	assert.Error(t, Params{OutflowQuotas: []OutflowQuota{{Denom: "stake", Amount: math.NewInt(100)}}}.Validate(), "with zero outflow quota period")
+	assert.Error(t, Params{OutflowQuotas: []OutflowQuota{{Denom: "stake", Amount: math.NewInt(-1), Period: time.Hour}}}.Validate(), "with negative outflow quota")
+
+	denied := DeniedAddress{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denoms: []string{"stake"}}
+	assert.NoError(t, Params{DeniedAddresses: []DeniedAddress{denied}}.Validate(), "with denied address")
+	assert.Error(t, Params{DeniedAddresses: []DeniedAddress{denied, denied}}.Validate(), "with duplicate denied addresses")
+	assert.Error(t, Params{DeniedAddresses: []DeniedAddress{{Denoms: []string{"stake"}}}}.Validate(), "with empty denied address")
+	assert.NoError(t, Params{ExemptModules: []string{"fee_collector"}}.Validate(), "with exempt module")
+	assert.Error(t, Params{ExemptModules: []string{"fee_collector", "fee_collector"}}.Validate(), "with duplicate exempt modules")
+	assert.Error(t, Params{ExemptModules: []string{""}}.Validate(), "with empty exempt module")
+	assert.NoError(t, Params{SupplySnapshotDenoms: []string{"stake"}}.Validate(), "with supply snapshot denom")
+	assert.Error(t, Params{SupplySnapshotDenoms: []string{"stake", "stake"}}.Validate(), "with duplicate supply snapshot denoms")
+	assert.Error(t, Params{SupplySnapshotDenoms: []string{""}}.Validate(), "with invalid supply snapshot denom")
+}
+
+func Test_IsDenied(t *testing.T) {
+	params := Params{DeniedAddresses: []DeniedAddress{
+		{Address: "alice", Denoms: []string{"stake"}},
+		{Address: "bob"},
+	}}
+
+	assert.True(t, params.IsDenied("alice", "stake"))
+	assert.False(t, params.IsDenied("alice", "atom"))
+	assert.True(t, params.IsDenied("bob", "atom"))
+	assert.False(t, params.IsDenied("carol", "stake"))
 }
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the supply snapshot.
+func (s SupplySnapshot) Validate() error {
+	if s.Height <= 0 {
+		return fmt.Errorf("supply snapshot height must be positive: %d", s.Height)
+	}
+	if err := s.Supply.Validate(); err != nil {
+		return fmt.Errorf("invalid supply of the supply snapshot at height %d: %w", s.Height, err)
+	}
+
+	seen := make(map[string]bool)
+	for _, hc := range s.HolderCounts {
+		if err := sdk.ValidateDenom(hc.Denom); err != nil {
+			return fmt.Errorf("invalid holder count denom of the supply snapshot at height %d: %w", s.Height, err)
+		}
+		if seen[hc.Denom] {
+			return fmt.Errorf("duplicate holder count of denom %s in the supply snapshot at height %d", hc.Denom, s.Height)
+		}
+		seen[hc.Denom] = true
+	}
+
+	return nil
+}
+
+// GetHolderCount returns the number of holders of a denom in the snapshot, and
+// whether the denom was snapshotted.
+func (s SupplySnapshot) GetHolderCount(denom string) (uint64, bool) {
+	for _, hc := range s.HolderCounts {
+		if hc.Denom == denom {
+			return hc.Count, true
+		}
+	}
+	return 0, false
+}
@@ -28,7 +28,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "DisabledList",
 					Use:       "disabled-list",
-					Short:     "Query a list of all disabled message types",
+					Short:     "Query a list of all disabled message types, message type prefixes and query paths",
+				},
+				{
+					RpcMethod: "Records",
+					Use:       "records",
+					Short:     "Query the circuit breaker trip and reset records",
+					Example:   fmt.Sprintf(`%s query circuit records --reverse --limit 10`, version.AppName),
 				},
 			},
 		},
@@ -51,18 +57,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "TripCircuitBreaker",
-					Use:       "disable <msg_type_urls>",
-					Short:     "Disable a message from being executed",
-					Example:   fmt.Sprintf(`%s tx circuit disable "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
+					Use:       "disable [msg_type_urls]",
+					Short:     "Disable a message from being executed, or a query from being served",
+					Long: `Disable messages from being executed, or queries from being served.
+All the messages of a module can be disabled with --msg-type-url-prefixes, and gRPC queries with --query-paths.
+With --reset-after-blocks, the disabled messages and queries are enabled again after the given number of blocks.
+The --reason is kept in the trip record.`,
+					Example: fmt.Sprintf(`%s tx circuit disable "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"
+%s tx circuit disable --msg-type-url-prefixes /cosmos.bank.v1beta1. --query-paths /cosmos.bank.v1beta1.Query/AllBalances --reset-after-blocks 100 --reason "bank exploit"`, version.AppName, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
 				},
 				{
 					RpcMethod: "ResetCircuitBreaker",
-					Use:       "reset <msg_type_urls>",
-					Short:     "Enable a message to be executed",
-					Example:   fmt.Sprintf(`%s tx circuit reset "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
+					Use:       "reset [msg_type_urls]",
+					Short:     "Enable a message to be executed, or a query to be served",
+					Example: fmt.Sprintf(`%s tx circuit reset "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"
+%s tx circuit reset --msg-type-url-prefixes /cosmos.bank.v1beta1. --query-paths /cosmos.bank.v1beta1.Query/AllBalances`, version.AppName, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
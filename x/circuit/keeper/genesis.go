@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/types"
 )
 
@@ -37,9 +38,37 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, e
 		return nil, err
 	}
 
+	disabledPrefixes, err := walkKeys(ctx, k.DisabledPrefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	disabledQueries, err := walkKeys(ctx, k.DisabledQueries)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledResets, err := k.GetScheduledResets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*types.CircuitBreakerRecord
+	err = k.Records.Walk(ctx, nil, func(_ uint64, record types.CircuitBreakerRecord) (stop bool, err error) {
+		records = append(records, &record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		AccountPermissions: permissions,
-		DisabledTypeUrls:   disabledMsgs,
+		AccountPermissions:         permissions,
+		DisabledTypeUrls:           disabledMsgs,
+		DisabledMsgTypeUrlPrefixes: disabledPrefixes,
+		DisabledQueryPaths:         disabledQueries,
+		ScheduledResets:            scheduledResets,
+		Records:                    records,
 	}, nil
 }
 
@@ -62,6 +91,43 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			return err
 		}
 	}
+	for _, prefix := range genState.DisabledMsgTypeUrlPrefixes {
+		if err := k.DisabledPrefixes.Set(ctx, prefix); err != nil {
+			return err
+		}
+	}
+	for _, path := range genState.DisabledQueryPaths {
+		if err := k.DisabledQueries.Set(ctx, path); err != nil {
+			return err
+		}
+	}
+	for _, reset := range genState.ScheduledResets {
+		if err := k.Trip(ctx, reset.CircuitType, reset.Value, reset.Height); err != nil {
+			return err
+		}
+	}
+	for _, record := range genState.Records {
+		if err := k.Records.Set(ctx, record.Id, *record); err != nil {
+			return err
+		}
+	}
+	if n := len(genState.Records); n > 0 {
+		// records are ordered by id, the next record follows the last one
+		if err := k.RecordSequence.Set(ctx, genState.Records[n-1].Id+1); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
+
+// walkKeys returns all the keys of the given set.
+func walkKeys(ctx context.Context, set collections.KeySet[string]) ([]string, error) {
+	var keys []string
+	err := set.Walk(ctx, nil, func(key string) (stop bool, err error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+
+	return keys, err
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -74,9 +75,19 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	url := "test_url"
 
 	genesisState := &types.GenesisState{
-		AccountPermissions: accounts,
-		DisabledTypeUrls:   []string{url},
+		AccountPermissions:         accounts,
+		DisabledTypeUrls:           []string{url},
+		DisabledMsgTypeUrlPrefixes: []string{"/cosmos.bank.v1beta1."},
+		DisabledQueryPaths:         []string{"/cosmos.bank.v1beta1.Query/AllBalances"},
+		ScheduledResets: []*types.ScheduledReset{
+			{CircuitType: types.CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX, Value: "/cosmos.bank.v1beta1.", Height: 10},
+		},
+		Records: []*types.CircuitBreakerRecord{
+			{Id: 3, Action: types.CircuitBreakerRecord_ACTION_TRIP, Authority: addr, Reason: "test", MsgTypeUrls: []string{url}, Height: 4, Time: time.Unix(1, 0).UTC()},
+			{Id: 5, Action: types.CircuitBreakerRecord_ACTION_TRIP, Authority: addr, MsgTypeUrlPrefixes: []string{"/cosmos.bank.v1beta1."}, ResetHeight: 10, Height: 6, Time: time.Unix(2, 0).UTC()},
+		},
 	}
+	s.Require().NoError(genesisState.Validate())
 
 	err = s.keeper.InitGenesis(s.ctx, genesisState)
 	s.Require().NoError(err)
@@ -92,4 +103,13 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.DisabledMsgTypeUrlPrefixes, exportedGenesisState.DisabledMsgTypeUrlPrefixes)
+	s.Require().Equal(genesisState.DisabledQueryPaths, exportedGenesisState.DisabledQueryPaths)
+	s.Require().Equal(genesisState.ScheduledResets, exportedGenesisState.ScheduledResets)
+	s.Require().Equal(genesisState.Records, exported.Records)
+
+	// new records follow the imported ones
+	next, err := s.keeper.RecordSequence.Peek(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6), next)
 }
@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,6 +28,19 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// DisabledPrefixes contains the message URL prefixes that are disabled
+	DisabledPrefixes collections.KeySet[string]
+	// DisabledQueries contains the gRPC query paths that are disabled
+	DisabledQueries collections.KeySet[string]
+	// ScheduledResets maps the tripped circuits reset automatically, keyed by
+	// circuit type and value, to the height they are reset at
+	ScheduledResets collections.Map[collections.Pair[int32, string], uint64]
+	// ResetQueue contains the scheduled resets, ordered by height
+	ResetQueue collections.KeySet[collections.Triple[uint64, int32, string]]
+	// Records contains the circuit breaker trip and reset records, by id
+	Records collections.Map[uint64, types.CircuitBreakerRecord]
+	// RecordSequence is the sequence of the record ids
+	RecordSequence collections.Sequence
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -53,6 +70,43 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority strin
 			"disable_list",
 			collections.StringKey,
 		),
+		DisabledPrefixes: collections.NewKeySet(
+			sb,
+			types.DisabledPrefixesPrefix,
+			"disabled_prefixes",
+			collections.StringKey,
+		),
+		DisabledQueries: collections.NewKeySet(
+			sb,
+			types.DisabledQueriesPrefix,
+			"disabled_queries",
+			collections.StringKey,
+		),
+		ScheduledResets: collections.NewMap(
+			sb,
+			types.ScheduledResetsPrefix,
+			"scheduled_resets",
+			collections.PairKeyCodec(collections.Int32Key, collections.StringKey),
+			collections.Uint64Value,
+		),
+		ResetQueue: collections.NewKeySet(
+			sb,
+			types.ResetQueuePrefix,
+			"reset_queue",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.Int32Key, collections.StringKey),
+		),
+		Records: collections.NewMap(
+			sb,
+			types.RecordsPrefix,
+			"records",
+			collections.Uint64Key,
+			codec.CollValue[types.CircuitBreakerRecord](cdc),
+		),
+		RecordSequence: collections.NewSequence(
+			sb,
+			types.RecordSequencePrefix,
+			"record_sequence",
+		),
 	}
 
 	schema, err := sb.Build()
@@ -68,8 +122,208 @@ func (k *Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
-// IsAllowed returns true when msg URL is not found in the DisableList for given context, else false.
+// IsAllowed returns true when msg URL is not found in the DisableList for given context
+// and no disabled prefix matches it, else false.
 func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
+	if err != nil || has {
+		return !has, err
+	}
+
+	// prefixes end at a proto package boundary, so only the package prefixes
+	// of the msg URL need to be looked up
+	for i := 0; i < len(msgURL); i++ {
+		if msgURL[i] != '.' {
+			continue
+		}
+
+		has, err := k.DisabledPrefixes.Has(ctx, msgURL[:i+1])
+		if err != nil || has {
+			return !has, err
+		}
+	}
+
+	return true, nil
+}
+
+// IsQueryAllowed returns true when the gRPC query path is not found in the disabled
+// queries for given context, else false.
+func (k *Keeper) IsQueryAllowed(ctx context.Context, path string) (bool, error) {
+	has, err := k.DisabledQueries.Has(ctx, path)
 	return !has, err
 }
+
+// circuits returns the set of the disabled circuits of the given type.
+func (k *Keeper) circuits(circuitType types.CircuitType) (collections.KeySet[string], error) {
+	switch circuitType {
+	case types.CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL:
+		return k.DisableList, nil
+	case types.CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX:
+		return k.DisabledPrefixes, nil
+	case types.CircuitType_CIRCUIT_TYPE_QUERY_PATH:
+		return k.DisabledQueries, nil
+	default:
+		return collections.KeySet[string]{}, fmt.Errorf("unknown circuit type %s", circuitType)
+	}
+}
+
+// IsTripped returns true if the circuit of the given type and value is tripped.
+// Unlike IsAllowed, a message URL is not tripped by a disabled prefix matching it.
+func (k *Keeper) IsTripped(ctx context.Context, circuitType types.CircuitType, value string) (bool, error) {
+	circuits, err := k.circuits(circuitType)
+	if err != nil {
+		return false, err
+	}
+
+	return circuits.Has(ctx, value)
+}
+
+// Trip trips the circuit of the given type and value. If resetHeight is non-zero,
+// the circuit is automatically reset at the beginning of the block at that height.
+func (k *Keeper) Trip(ctx context.Context, circuitType types.CircuitType, value string, resetHeight uint64) error {
+	circuits, err := k.circuits(circuitType)
+	if err != nil {
+		return err
+	}
+
+	if err := circuits.Set(ctx, value); err != nil {
+		return err
+	}
+
+	if err := k.cancelScheduledReset(ctx, circuitType, value); err != nil {
+		return err
+	}
+	if resetHeight == 0 {
+		return nil
+	}
+
+	if err := k.ScheduledResets.Set(ctx, collections.Join(int32(circuitType), value), resetHeight); err != nil {
+		return err
+	}
+
+	return k.ResetQueue.Set(ctx, collections.Join3(resetHeight, int32(circuitType), value))
+}
+
+// Reset resets the circuit of the given type and value, and cancels its scheduled
+// reset if any.
+func (k *Keeper) Reset(ctx context.Context, circuitType types.CircuitType, value string) error {
+	circuits, err := k.circuits(circuitType)
+	if err != nil {
+		return err
+	}
+
+	if err := circuits.Remove(ctx, value); err != nil {
+		return err
+	}
+
+	return k.cancelScheduledReset(ctx, circuitType, value)
+}
+
+func (k *Keeper) cancelScheduledReset(ctx context.Context, circuitType types.CircuitType, value string) error {
+	key := collections.Join(int32(circuitType), value)
+	height, err := k.ScheduledResets.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := k.ScheduledResets.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	return k.ResetQueue.Remove(ctx, collections.Join3(height, int32(circuitType), value))
+}
+
+// GetScheduledResets returns all the scheduled resets, ordered by height.
+func (k *Keeper) GetScheduledResets(ctx context.Context) ([]*types.ScheduledReset, error) {
+	var resets []*types.ScheduledReset
+	err := k.ResetQueue.Walk(ctx, nil, func(key collections.Triple[uint64, int32, string]) (bool, error) {
+		resets = append(resets, &types.ScheduledReset{
+			CircuitType: types.CircuitType(key.K2()),
+			Value:       key.K3(),
+			Height:      key.K1(),
+		})
+		return false, nil
+	})
+
+	return resets, err
+}
+
+// AddRecord stores the record of a circuit breaker action, setting its id and
+// the current block height and time.
+func (k *Keeper) AddRecord(ctx context.Context, record types.CircuitBreakerRecord) error {
+	id, err := k.RecordSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	record.Id = id
+	record.Height = headerInfo.Height
+	record.Time = headerInfo.Time
+
+	return k.Records.Set(ctx, id, record)
+}
+
+// BeginBlocker resets the tripped circuits scheduled to be reset at the current
+// block height, and records the resets.
+func (k *Keeper) BeginBlocker(ctx context.Context) error {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if height <= 0 {
+		return nil
+	}
+
+	resets, err := k.ResetQueue.Iterate(ctx, collections.NewPrefixUntilTripleRange[uint64, int32, string](uint64(height)))
+	if err != nil {
+		return err
+	}
+	keys, err := resets.Keys()
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	record := types.CircuitBreakerRecord{Action: types.CircuitBreakerRecord_ACTION_SCHEDULED_RESET}
+	for _, key := range keys {
+		circuitType := types.CircuitType(key.K2())
+		if err := k.Reset(ctx, circuitType, key.K3()); err != nil {
+			return err
+		}
+
+		switch circuitType {
+		case types.CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX:
+			record.MsgTypeUrlPrefixes = append(record.MsgTypeUrlPrefixes, key.K3())
+		case types.CircuitType_CIRCUIT_TYPE_QUERY_PATH:
+			record.QueryPaths = append(record.QueryPaths, key.K3())
+		default:
+			record.MsgTypeUrls = append(record.MsgTypeUrls, key.K3())
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			"reset_circuit_breaker",
+			event.NewAttribute(circuitEventAttribute(circuitType), key.K3()),
+			event.NewAttribute("scheduled_height", strconv.FormatUint(key.K1(), 10)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return k.AddRecord(ctx, record)
+}
+
+// circuitEventAttribute returns the event attribute key of a circuit type.
+func circuitEventAttribute(circuitType types.CircuitType) string {
+	switch circuitType {
+	case types.CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX:
+		return "msg_url_prefix"
+	case types.CircuitType_CIRCUIT_TYPE_QUERY_PATH:
+		return "query_path"
+	default:
+		return "msg_url"
+	}
+}
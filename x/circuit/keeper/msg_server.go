@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/collections"
@@ -83,48 +84,68 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		return nil, err
 	}
 
+	circuits, err := circuitsOf(msg.MsgTypeUrls, msg.MsgTypeUrlPrefixes, msg.QueryPaths)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check that the account has the permissions
 	perms, err := srv.Permissions.Get(ctx, address)
 	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
 		return nil, err
 	}
 
-	for _, msgTypeURL := range msg.MsgTypeUrls {
-		// check if the message is in the list of allowed messages
-		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+	if len(msg.Reason) > types.MaxReasonLength {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason length %d exceeds the maximum of %d", len(msg.Reason), types.MaxReasonLength)
+	}
+
+	var resetHeight uint64
+	if msg.ResetAfterBlocks > 0 {
+		resetHeight = uint64(srv.HeaderService.HeaderInfo(ctx).Height) + msg.ResetAfterBlocks
+	}
+
+	for _, c := range circuits {
+		// check if the circuit is already tripped
+		tripped, err := srv.IsTripped(ctx, c.circuitType, c.value)
 		if err != nil {
 			return nil, err
 		}
 
-		if !isAllowed {
-			return nil, fmt.Errorf("message %s is already disabled", msgTypeURL)
+		if tripped {
+			return nil, fmt.Errorf("%s is already disabled", c)
 		}
 
-		switch {
-		case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
-			// if the sender is a super admin or the module authority, no need to check perms
-		case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
-			// if the sender has permission for some messages, check if the sender has permission for this specific message
-			if !hasPermissionForMsg(perms, msgTypeURL) {
-				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for message %s", msgTypeURL)
-			}
-		default:
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker")
+		if err := srv.checkPermission(address, perms, "trip", c); err != nil {
+			return nil, err
 		}
 
-		if err = srv.DisableList.Set(ctx, msgTypeURL); err != nil {
+		if err = srv.Trip(ctx, c.circuitType, c.value, resetHeight); err != nil {
 			return nil, err
 		}
-
 	}
 
-	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
+	if err := srv.AddRecord(ctx, types.CircuitBreakerRecord{
+		Action:             types.CircuitBreakerRecord_ACTION_TRIP,
+		Authority:          msg.Authority,
+		Reason:             msg.Reason,
+		MsgTypeUrls:        msg.MsgTypeUrls,
+		MsgTypeUrlPrefixes: msg.MsgTypeUrlPrefixes,
+		QueryPaths:         msg.QueryPaths,
+		ResetHeight:        resetHeight,
+	}); err != nil {
+		return nil, err
+	}
 
-	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
-		"trip_circuit_breaker",
+	attrs := []event.Attribute{
 		event.NewAttribute("authority", msg.Authority),
-		event.NewAttribute("msg_url", urls),
-	); err != nil {
+		event.NewAttribute("msg_url", strings.Join(msg.GetMsgTypeUrls(), ",")),
+	}
+	attrs = append(attrs, circuitEventAttributes(msg.MsgTypeUrlPrefixes, msg.QueryPaths, msg.Reason)...)
+	if resetHeight > 0 {
+		attrs = append(attrs, event.NewAttribute("reset_height", strconv.FormatUint(resetHeight, 10)))
+	}
+
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV("trip_circuit_breaker", attrs...); err != nil {
 		return nil, err
 	}
 
@@ -133,8 +154,8 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 	}, nil
 }
 
-// ResetCircuitBreaker resumes processing of Msg's in the state machine that
-// have been paused using TripCircuitBreaker.
+// ResetCircuitBreaker resumes processing of Msg's in the state machine, or of
+// gRPC queries, that have been paused using TripCircuitBreaker.
 func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
 	keeper := srv.Keeper
 	address, err := srv.addressCodec.StringToBytes(msg.Authority)
@@ -142,53 +163,144 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		return nil, err
 	}
 
+	circuits, err := circuitsOf(msg.MsgTypeUrls, msg.MsgTypeUrlPrefixes, msg.QueryPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Reason) > types.MaxReasonLength {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason length %d exceeds the maximum of %d", len(msg.Reason), types.MaxReasonLength)
+	}
+
 	// Get the permissions for the account specified in the msg.Authority field
 	perms, err := keeper.Permissions.Get(ctx, address)
 	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
 		return nil, err
 	}
 
-	for _, msgTypeURL := range msg.MsgTypeUrls {
-		// check if the message is in the list of allowed messages
-		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+	for _, c := range circuits {
+		// check if the circuit is tripped
+		tripped, err := srv.IsTripped(ctx, c.circuitType, c.value)
 		if err != nil {
 			return nil, err
 		}
 
-		if isAllowed {
-			return nil, fmt.Errorf("message %s is not disabled", msgTypeURL)
+		if !tripped {
+			return nil, fmt.Errorf("%s is not disabled", c)
 		}
 
-		switch {
-		case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
-			// if the sender is a super admin or the module authority, no need to check perms
-		case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
-			// if the sender has permission for some messages, check if the sender has permission for this specific message
-			if !hasPermissionForMsg(perms, msgTypeURL) {
-				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker for message %s", msgTypeURL)
-			}
-		default:
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
+		if err := srv.checkPermission(address, perms, "reset", c); err != nil {
+			return nil, err
 		}
 
-		if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
+		if err = srv.Reset(ctx, c.circuitType, c.value); err != nil {
 			return nil, err
 		}
 	}
 
-	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
+	if err := srv.AddRecord(ctx, types.CircuitBreakerRecord{
+		Action:             types.CircuitBreakerRecord_ACTION_RESET,
+		Authority:          msg.Authority,
+		Reason:             msg.Reason,
+		MsgTypeUrls:        msg.MsgTypeUrls,
+		MsgTypeUrlPrefixes: msg.MsgTypeUrlPrefixes,
+		QueryPaths:         msg.QueryPaths,
+	}); err != nil {
+		return nil, err
+	}
 
-	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
-		"reset_circuit_breaker",
+	attrs := []event.Attribute{
 		event.NewAttribute("authority", msg.Authority),
-		event.NewAttribute("msg_url", urls),
-	); err != nil {
+		event.NewAttribute("msg_url", strings.Join(msg.GetMsgTypeUrls(), ",")),
+	}
+	attrs = append(attrs, circuitEventAttributes(msg.MsgTypeUrlPrefixes, msg.QueryPaths, msg.Reason)...)
+
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV("reset_circuit_breaker", attrs...); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// circuit is a circuit to trip or reset.
+type circuit struct {
+	circuitType types.CircuitType
+	value       string
+}
+
+func (c circuit) String() string {
+	switch c.circuitType {
+	case types.CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX:
+		return "message prefix " + c.value
+	case types.CircuitType_CIRCUIT_TYPE_QUERY_PATH:
+		return "query " + c.value
+	default:
+		return "message " + c.value
+	}
+}
+
+// circuitsOf validates and returns the circuits of a trip or reset request.
+func circuitsOf(msgTypeURLs, msgTypeURLPrefixes, queryPaths []string) ([]circuit, error) {
+	circuits := make([]circuit, 0, len(msgTypeURLs)+len(msgTypeURLPrefixes)+len(queryPaths))
+	for _, msgTypeURL := range msgTypeURLs {
+		circuits = append(circuits, circuit{types.CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL, msgTypeURL})
+	}
+
+	for _, prefix := range msgTypeURLPrefixes {
+		if err := types.ValidateMsgTypeURLPrefix(prefix); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		circuits = append(circuits, circuit{types.CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX, prefix})
+	}
+
+	for _, path := range queryPaths {
+		if err := types.ValidateQueryPath(path); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		circuits = append(circuits, circuit{types.CircuitType_CIRCUIT_TYPE_QUERY_PATH, path})
+	}
+
+	return circuits, nil
+}
+
+// circuitEventAttributes returns the event attributes of the tripped or reset
+// message prefixes and query paths, and of the reason, if any.
+func circuitEventAttributes(msgTypeURLPrefixes, queryPaths []string, reason string) []event.Attribute {
+	var attrs []event.Attribute
+	if len(msgTypeURLPrefixes) > 0 {
+		attrs = append(attrs, event.NewAttribute("msg_url_prefix", strings.Join(msgTypeURLPrefixes, ",")))
+	}
+	if len(queryPaths) > 0 {
+		attrs = append(attrs, event.NewAttribute("query_path", strings.Join(queryPaths, ",")))
+	}
+	if reason != "" {
+		attrs = append(attrs, event.NewAttribute("reason", reason))
+	}
+
+	return attrs
+}
+
+// checkPermission returns an error if the account cannot trip or reset the circuit.
+// Accounts with LEVEL_ALL_MSGS can trip or reset the circuit of any message, but
+// not of queries.
+func (srv msgServer) checkPermission(address []byte, perms types.Permissions, action string, c circuit) error {
+	switch {
+	case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || bytes.Equal(address, srv.GetAuthority()):
+		// if the sender is a super admin or the module authority, no need to check perms
+		return nil
+	case perms.Level == types.Permissions_LEVEL_ALL_MSGS && c.circuitType != types.CircuitType_CIRCUIT_TYPE_QUERY_PATH:
+		return nil
+	case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
+		// if the sender has permission for some messages, check if the sender has permission for this specific circuit
+		if !hasPermissionForMsg(perms, c.value) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to %s circuit breaker for %s", action, c)
+		}
+		return nil
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to %s circuit breaker", action)
+	}
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
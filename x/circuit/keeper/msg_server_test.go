@@ -4,9 +4,11 @@ import (
 	"context"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
@@ -373,3 +375,162 @@ func TestResetCircuitBreakerSomeMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, allowed, "circuit breaker should be reset")
 }
+
+func TestTripCircuitBreakerPrefixesAndQueries(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+
+	const (
+		bankPrefix = "/cosmos.bank.v1beta1."
+		balances   = "/cosmos.bank.v1beta1.Query/AllBalances"
+	)
+
+	// prefixes and query paths are validated
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrlPrefixes: []string{"/cosmos.bank"}})
+	require.ErrorContains(t, err, "invalid msg type url prefix")
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: authority, QueryPaths: []string{"cosmos.bank.v1beta1.Query"}})
+	require.ErrorContains(t, err, "invalid query path")
+
+	// admin trips the bank messages and a bank query
+	trip := &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrlPrefixes: []string{bankPrefix}, QueryPaths: []string{balances}}
+	_, err = srv.TripCircuitBreaker(ft.ctx, trip)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"trip_circuit_breaker",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", ""),
+			sdk.NewAttribute("msg_url_prefix", bankPrefix),
+			sdk.NewAttribute("query_path", balances),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	for url, expected := range map[string]bool{
+		"/cosmos.bank.v1beta1.MsgSend":      false,
+		"/cosmos.bank.v1beta1.MsgMultiSend": false,
+		"/cosmos.bank.v1.MsgSend":           true,
+		"/cosmos.staking.v1beta1.MsgSend":   true,
+	} {
+		allowed, err := ft.keeper.IsAllowed(ft.ctx, url)
+		require.NoError(t, err)
+		require.Equal(t, expected, allowed, url)
+	}
+
+	allowed, err := ft.keeper.IsQueryAllowed(ft.ctx, balances)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = ft.keeper.IsQueryAllowed(ft.ctx, "/cosmos.bank.v1beta1.Query/Balance")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// a message disabled by a prefix can still be tripped on its own
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}})
+	require.NoError(t, err)
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrlPrefixes: []string{bankPrefix}})
+	require.ErrorContains(t, err, "message prefix /cosmos.bank.v1beta1. is already disabled")
+
+	// accounts with all msgs permissions cannot trip queries
+	allMsgs := &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS}
+	_, err = srv.AuthorizeCircuitBreaker(ft.ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[1], Permissions: allMsgs})
+	require.NoError(t, err)
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: addresses[1], MsgTypeUrlPrefixes: []string{"/cosmos.gov."}})
+	require.NoError(t, err)
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: addresses[1], QueryPaths: []string{"/cosmos.gov.v1.Query/Proposals"}})
+	require.ErrorContains(t, err, "unauthorized")
+
+	// accounts with some msgs permissions can trip the listed prefixes and queries
+	someMsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"/cosmos.staking.", "/cosmos.gov.v1.Query/Proposals"}}
+	_, err = srv.AuthorizeCircuitBreaker(ft.ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[2], Permissions: someMsgs})
+	require.NoError(t, err)
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: addresses[2], MsgTypeUrlPrefixes: []string{"/cosmos.staking."}, QueryPaths: []string{"/cosmos.gov.v1.Query/Proposals"}})
+	require.NoError(t, err)
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{Authority: addresses[2], MsgTypeUrlPrefixes: []string{"/cosmos.distribution."}})
+	require.ErrorContains(t, err, "message prefix /cosmos.distribution.: unauthorized")
+
+	// reset the bank prefix and query
+	_, err = srv.ResetCircuitBreaker(ft.ctx, &types.MsgResetCircuitBreaker{Authority: authority, MsgTypeUrlPrefixes: []string{bankPrefix}, QueryPaths: []string{balances}})
+	require.NoError(t, err)
+
+	allowed, err = ft.keeper.IsAllowed(ft.ctx, "/cosmos.bank.v1beta1.MsgMultiSend")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = ft.keeper.IsQueryAllowed(ft.ctx, balances)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	_, err = srv.ResetCircuitBreaker(ft.ctx, &types.MsgResetCircuitBreaker{Authority: authority, QueryPaths: []string{balances}})
+	require.ErrorContains(t, err, "query /cosmos.bank.v1beta1.Query/AllBalances is not disabled")
+}
+
+func TestTripCircuitBreakerResetAfterBlocks(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithHeaderInfo(header.Info{Height: 10})
+
+	const balances = "/cosmos.bank.v1beta1.Query/AllBalances"
+
+	trip := &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, QueryPaths: []string{balances}, ResetAfterBlocks: 5}
+	_, err = srv.TripCircuitBreaker(ctx, trip)
+	require.NoError(t, err)
+	require.Contains(t, lastEvent(ctx).Attributes, abci.EventAttribute{Key: "reset_height", Value: "15"})
+
+	// tripping a prefix without a reset
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrlPrefixes: []string{"/cosmos.gov."}})
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServer(ft.keeper).DisabledList(ctx, &types.QueryDisabledListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{msgSend}, res.DisabledList)
+	require.Equal(t, []string{"/cosmos.gov."}, res.DisabledMsgTypeUrlPrefixes)
+	require.Equal(t, []string{balances}, res.DisabledQueryPaths)
+	require.Equal(t, []*types.ScheduledReset{
+		{CircuitType: types.CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL, Value: msgSend, Height: 15},
+		{CircuitType: types.CircuitType_CIRCUIT_TYPE_QUERY_PATH, Value: balances, Height: 15},
+	}, res.ScheduledResets)
+
+	// the circuits are still tripped before the reset height
+	require.NoError(t, ft.keeper.BeginBlocker(ctx.WithHeaderInfo(header.Info{Height: 14})))
+	allowed, err := ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// a manual reset cancels the scheduled one
+	_, err = srv.ResetCircuitBreaker(ctx, &types.MsgResetCircuitBreaker{Authority: authority, QueryPaths: []string{balances}})
+	require.NoError(t, err)
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, QueryPaths: []string{balances}})
+	require.NoError(t, err)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 15})
+	require.NoError(t, ft.keeper.BeginBlocker(ctx))
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"reset_circuit_breaker",
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("scheduled_height", "15"),
+		),
+		lastEvent(ctx),
+	)
+
+	allowed, err = ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = ft.keeper.IsQueryAllowed(ctx, balances)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = ft.keeper.IsAllowed(ctx, "/cosmos.gov.v1.MsgVote")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	resets, err := ft.keeper.GetScheduledResets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, resets)
+}
@@ -59,17 +59,49 @@ func (qs QueryServer) Accounts(ctx context.Context, req *types.QueryAccountsRequ
 	return &types.AccountsResponse{Accounts: results, Pagination: pageRes}, nil
 }
 
-// DisabledList returns a list of disabled message urls
+// DisabledList returns a list of disabled message urls, message url prefixes and query paths
 func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabledListRequest) (*types.DisabledListResponse, error) {
-	// Iterate over disabled list and perform the callback
-	var msgs []string
-	err := qs.keeper.DisableList.Walk(ctx, nil, func(msgUrl string) (bool, error) {
-		msgs = append(msgs, msgUrl)
-		return false, nil
-	})
+	msgs, err := walkKeys(ctx, qs.keeper.DisableList)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.DisabledListResponse{DisabledList: msgs}, nil
+	prefixes, err := walkKeys(ctx, qs.keeper.DisabledPrefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := walkKeys(ctx, qs.keeper.DisabledQueries)
+	if err != nil {
+		return nil, err
+	}
+
+	resets, err := qs.keeper.GetScheduledResets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DisabledListResponse{
+		DisabledList:               msgs,
+		DisabledMsgTypeUrlPrefixes: prefixes,
+		DisabledQueryPaths:         queries,
+		ScheduledResets:            resets,
+	}, nil
+}
+
+// Records returns the circuit breaker trip and reset records.
+func (qs QueryServer) Records(ctx context.Context, req *types.QueryRecordsRequest) (*types.RecordsResponse, error) {
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.Records,
+		req.Pagination,
+		func(_ uint64, record types.CircuitBreakerRecord) (types.CircuitBreakerRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RecordsResponse{Records: records, Pagination: pageRes}, nil
 }
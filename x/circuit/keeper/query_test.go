@@ -1,13 +1,17 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -98,3 +102,84 @@ func TestQueryDisabledList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
 }
+
+func TestQueryRecords(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	authority, err := f.ac.BytesToString(f.mockAddr)
+	require.NoError(t, err)
+
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Height: 7, Time: blockTime})
+
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServer(f.keeper)
+
+	res, err := qs.Records(ctx, &types.QueryRecordsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{
+		Authority:        authority,
+		MsgTypeUrls:      []string{msgSend},
+		QueryPaths:       []string{"/cosmos.bank.v1beta1.Query/AllBalances"},
+		ResetAfterBlocks: 10,
+		Reason:           "exploit in the bank module",
+	})
+	require.NoError(t, err)
+
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, Reason: strings.Repeat("a", types.MaxReasonLength+1)})
+	require.ErrorContains(t, err, "reason length")
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 8, Time: blockTime.Add(time.Minute)})
+	_, err = srv.ResetCircuitBreaker(ctx, &types.MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: []string{msgSend},
+		Reason:      "patched",
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 17, Time: blockTime.Add(time.Hour)})
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+	expected := []types.CircuitBreakerRecord{
+		{
+			Id:          0,
+			Action:      types.CircuitBreakerRecord_ACTION_TRIP,
+			Authority:   authority,
+			Reason:      "exploit in the bank module",
+			MsgTypeUrls: []string{msgSend},
+			QueryPaths:  []string{"/cosmos.bank.v1beta1.Query/AllBalances"},
+			ResetHeight: 17,
+			Height:      7,
+			Time:        blockTime,
+		},
+		{
+			Id:          1,
+			Action:      types.CircuitBreakerRecord_ACTION_RESET,
+			Authority:   authority,
+			Reason:      "patched",
+			MsgTypeUrls: []string{msgSend},
+			Height:      8,
+			Time:        blockTime.Add(time.Minute),
+		},
+		{
+			Id:         2,
+			Action:     types.CircuitBreakerRecord_ACTION_SCHEDULED_RESET,
+			QueryPaths: []string{"/cosmos.bank.v1beta1.Query/AllBalances"},
+			Height:     17,
+			Time:       blockTime.Add(time.Hour),
+		},
+	}
+
+	res, err = qs.Records(ctx, &types.QueryRecordsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, expected, res.Records)
+
+	// newest first
+	res, err = qs.Records(ctx, &types.QueryRecordsRequest{Pagination: &query.PageRequest{Limit: 2, Reverse: true, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.CircuitBreakerRecord{expected[2], expected[1]}, res.Records)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+}
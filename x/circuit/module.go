@@ -28,6 +28,7 @@ var (
 	_ module.HasGRPCGateway = AppModule{}
 
 	_ appmodule.AppModule                        = AppModule{}
+	_ appmodule.HasBeginBlocker                  = AppModule{}
 	_ appmodule.HasGenesis                       = AppModule{}
 	_ appmodule.HasRegisterInterfaces            = AppModule{}
 	_ appmodulev2.HasTxValidator[transaction.Tx] = AppModule{}
@@ -112,6 +113,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// BeginBlock resets the circuit breakers scheduled to be reset at the current height.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.BeginBlocker(ctx)
+}
+
 // TxValidator implements appmodule.HasTxValidator.
 func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
 	validator := ante.NewCircuitBreakerDecorator(&am.keeper)
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxReasonLength is the maximum length of the reason of a circuit breaker trip
+// or reset.
+const MaxReasonLength = 512
+
+// ValidateMsgTypeURLPrefix returns an error if prefix is not a valid Msg type
+// URL prefix, i.e. a type URL prefix ending at a proto package boundary such as
+// "/cosmos.bank.v1beta1." or "/cosmos.bank.".
+func ValidateMsgTypeURLPrefix(prefix string) error {
+	if len(prefix) < 3 || !strings.HasPrefix(prefix, "/") || !strings.HasSuffix(prefix, ".") {
+		return fmt.Errorf("invalid msg type url prefix %q: must start with '/' and end with '.'", prefix)
+	}
+
+	return nil
+}
+
+// ValidateQueryPath returns an error if path is not a valid gRPC query path of
+// the form "/<service>/<method>".
+func ValidateQueryPath(path string) error {
+	service, method, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if !strings.HasPrefix(path, "/") || !ok || service == "" || method == "" || strings.Contains(method, "/") {
+		return fmt.Errorf("invalid query path %q: must be of the form /<service>/<method>", path)
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{}
@@ -20,6 +23,48 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	for _, prefix := range gs.DisabledMsgTypeUrlPrefixes {
+		if err := ValidateMsgTypeURLPrefix(prefix); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range gs.DisabledQueryPaths {
+		if err := ValidateQueryPath(path); err != nil {
+			return err
+		}
+	}
+
+	for _, reset := range gs.ScheduledResets {
+		var disabled []string
+		switch reset.CircuitType {
+		case CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL:
+			disabled = gs.DisabledTypeUrls
+		case CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX:
+			disabled = gs.DisabledMsgTypeUrlPrefixes
+		case CircuitType_CIRCUIT_TYPE_QUERY_PATH:
+			disabled = gs.DisabledQueryPaths
+		default:
+			return fmt.Errorf("invalid scheduled reset circuit type: %s", reset.CircuitType)
+		}
+
+		if !slices.Contains(disabled, reset.Value) {
+			return fmt.Errorf("scheduled reset of %s %s which is not disabled", reset.CircuitType, reset.Value)
+		}
+		if reset.Height == 0 {
+			return fmt.Errorf("scheduled reset of %s %s has a zero height", reset.CircuitType, reset.Value)
+		}
+	}
+
+	for i, record := range gs.Records {
+		if i > 0 && record.Id <= gs.Records[i-1].Id {
+			return fmt.Errorf("records must be ordered by increasing id, got %d after %d", record.Id, gs.Records[i-1].Id)
+		}
+		if record.Action == CircuitBreakerRecord_ACTION_UNSPECIFIED {
+			return fmt.Errorf("record %d has an unspecified action", record.Id)
+		}
+	}
+
 	return nil
 }
 
@@ -19,4 +19,10 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	DisabledPrefixesPrefix  = collections.NewPrefix(3)
+	DisabledQueriesPrefix   = collections.NewPrefix(4)
+	ScheduledResetsPrefix   = collections.NewPrefix(5)
+	ResetQueuePrefix        = collections.NewPrefix(6)
+	RecordsPrefix           = collections.NewPrefix(7)
+	RecordSequencePrefix    = collections.NewPrefix(8)
 )
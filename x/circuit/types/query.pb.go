@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -259,6 +260,13 @@ var xxx_messageInfo_QueryDisabledListRequest proto.InternalMessageInfo
 // DisabledListResponse is the response type for the Query/DisabledList RPC method.
 type DisabledListResponse struct {
 	DisabledList []string `protobuf:"bytes,1,rep,name=disabled_list,json=disabledList,proto3" json:"disabled_list,omitempty"`
+	// disabled_msg_type_url_prefixes are the disabled Msg type URL prefixes.
+	DisabledMsgTypeUrlPrefixes []string `protobuf:"bytes,2,rep,name=disabled_msg_type_url_prefixes,json=disabledMsgTypeUrlPrefixes,proto3" json:"disabled_msg_type_url_prefixes,omitempty"`
+	// disabled_query_paths are the disabled gRPC query paths.
+	DisabledQueryPaths []string `protobuf:"bytes,3,rep,name=disabled_query_paths,json=disabledQueryPaths,proto3" json:"disabled_query_paths,omitempty"`
+	// scheduled_resets are the tripped circuit breakers automatically reset at a
+	// given height.
+	ScheduledResets []*ScheduledReset `protobuf:"bytes,4,rep,name=scheduled_resets,json=scheduledResets,proto3" json:"scheduled_resets,omitempty"`
 }
 
 func (m *DisabledListResponse) Reset()         { *m = DisabledListResponse{} }
@@ -301,6 +309,128 @@ func (m *DisabledListResponse) GetDisabledList() []string {
 	return nil
 }
 
+func (m *DisabledListResponse) GetDisabledMsgTypeUrlPrefixes() []string {
+	if m != nil {
+		return m.DisabledMsgTypeUrlPrefixes
+	}
+	return nil
+}
+
+func (m *DisabledListResponse) GetDisabledQueryPaths() []string {
+	if m != nil {
+		return m.DisabledQueryPaths
+	}
+	return nil
+}
+
+func (m *DisabledListResponse) GetScheduledResets() []*ScheduledReset {
+	if m != nil {
+		return m.ScheduledResets
+	}
+	return nil
+}
+
+// QueryRecordsRequest is the request type for the Query/Records RPC method.
+type QueryRecordsRequest struct {
+	// pagination defines an optional pagination for the request. Records are
+	// ordered from the oldest to the newest, unless reverse is set.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecordsRequest) Reset()         { *m = QueryRecordsRequest{} }
+func (m *QueryRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsRequest) ProtoMessage()    {}
+func (*QueryRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{6}
+}
+func (m *QueryRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsRequest.Merge(m, src)
+}
+func (m *QueryRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// RecordsResponse is the response type for the Query/Records RPC method.
+type RecordsResponse struct {
+	Records []CircuitBreakerRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RecordsResponse) Reset()         { *m = RecordsResponse{} }
+func (m *RecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordsResponse) ProtoMessage()    {}
+func (*RecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{7}
+}
+func (m *RecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordsResponse.Merge(m, src)
+}
+func (m *RecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordsResponse proto.InternalMessageInfo
+
+func (m *RecordsResponse) GetRecords() []CircuitBreakerRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *RecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.circuit.v1.QueryAccountRequest")
 	proto.RegisterType((*AccountResponse)(nil), "cosmos.circuit.v1.AccountResponse")
@@ -308,45 +438,58 @@ func init() {
 	proto.RegisterType((*AccountsResponse)(nil), "cosmos.circuit.v1.AccountsResponse")
 	proto.RegisterType((*QueryDisabledListRequest)(nil), "cosmos.circuit.v1.QueryDisabledListRequest")
 	proto.RegisterType((*DisabledListResponse)(nil), "cosmos.circuit.v1.DisabledListResponse")
+	proto.RegisterType((*QueryRecordsRequest)(nil), "cosmos.circuit.v1.QueryRecordsRequest")
+	proto.RegisterType((*RecordsResponse)(nil), "cosmos.circuit.v1.RecordsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/query.proto", fileDescriptor_87c65073a3d3c1e1) }
 
 var fileDescriptor_87c65073a3d3c1e1 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0x33, 0x2d, 0xbf, 0x5f, 0xdb, 0xd7, 0x8a, 0x3a, 0xf6, 0x10, 0xb6, 0xed, 0x5a, 0xb7,
-	0xd8, 0x84, 0x5a, 0x76, 0x48, 0x04, 0x2f, 0x82, 0xa0, 0x88, 0xf5, 0xe0, 0xa1, 0xdd, 0xa3, 0x07,
-	0x65, 0xb2, 0x3b, 0x84, 0xc1, 0x74, 0x67, 0xbb, 0x6f, 0x13, 0x2c, 0xe2, 0xa5, 0x27, 0xbd, 0x89,
-	0xfe, 0x0d, 0x1e, 0x05, 0xff, 0x0c, 0x8f, 0x05, 0x2f, 0x1e, 0x25, 0x11, 0xfc, 0x37, 0xa4, 0xb3,
-	0x33, 0x9b, 0x8d, 0x9d, 0xda, 0xe3, 0xcc, 0xbc, 0xef, 0xcb, 0xe7, 0xfb, 0xbe, 0x2f, 0x0b, 0x1b,
-	0xb1, 0xc2, 0x43, 0x85, 0x2c, 0x96, 0x79, 0x3c, 0x94, 0x05, 0x1b, 0x75, 0xd8, 0xd1, 0x50, 0xe4,
-	0xc7, 0x61, 0x96, 0xab, 0x42, 0xd1, 0xeb, 0xe5, 0x73, 0x68, 0x9e, 0xc3, 0x51, 0xc7, 0xdb, 0x31,
-	0x8a, 0x1e, 0x47, 0x51, 0xd6, 0xb2, 0x51, 0xa7, 0x27, 0x0a, 0xde, 0x61, 0x19, 0xef, 0xcb, 0x94,
-	0x17, 0x52, 0xa5, 0xa5, 0xdc, 0x73, 0x74, 0x2f, 0x8e, 0x33, 0x81, 0xe6, 0x79, 0xbd, 0xaf, 0x54,
-	0x7f, 0x20, 0x18, 0xcf, 0x24, 0xe3, 0x69, 0xaa, 0x0a, 0xad, 0xb5, 0xaf, 0x6b, 0x46, 0x6c, 0x7f,
-	0xa3, 0x0e, 0x16, 0x30, 0xb8, 0x71, 0x70, 0x76, 0x7c, 0x18, 0xc7, 0x6a, 0x98, 0x16, 0x91, 0x38,
-	0x1a, 0x0a, 0x2c, 0x68, 0x13, 0x16, 0x78, 0x92, 0xe4, 0x02, 0xb1, 0x49, 0x36, 0x49, 0x7b, 0x29,
-	0xb2, 0xc7, 0xe0, 0x00, 0xae, 0x56, 0xb5, 0x98, 0xa9, 0x14, 0x05, 0x7d, 0x00, 0x90, 0x89, 0xfc,
-	0x50, 0x22, 0x4a, 0x95, 0xea, 0xfa, 0xe5, 0xae, 0x1f, 0x9e, 0x73, 0x1c, 0xee, 0x57, 0x45, 0x18,
-	0xd5, 0x14, 0xc1, 0x0b, 0x58, 0xad, 0x33, 0xa0, 0x85, 0x78, 0x02, 0x30, 0x9d, 0x84, 0xe9, 0xbb,
-	0x6d, 0xfb, 0x9e, 0x8d, 0x2d, 0x2c, 0x9d, 0x98, 0xb1, 0x85, 0xfb, 0xbc, 0x2f, 0x8c, 0x36, 0xaa,
-	0x29, 0x83, 0xcf, 0x04, 0xae, 0x4d, 0x7b, 0x1b, 0xe8, 0xa7, 0xb0, 0xc8, 0xcd, 0x5d, 0x93, 0x6c,
-	0xce, 0xb7, 0x97, 0xbb, 0xbb, 0x0e, 0xe4, 0x3d, 0x91, 0x0a, 0x94, 0x68, 0xd4, 0x75, 0x03, 0x95,
-	0x9a, 0xee, 0xcd, 0x60, 0xce, 0x69, 0xcc, 0xd6, 0xa5, 0x98, 0x25, 0xc6, 0x0c, 0xa7, 0x07, 0x4d,
-	0x3d, 0x87, 0xc7, 0x12, 0x79, 0x6f, 0x20, 0x92, 0x67, 0x12, 0x6d, 0x20, 0xc1, 0x7d, 0x58, 0x9d,
-	0xbd, 0x36, 0x36, 0xb6, 0xe0, 0x4a, 0x62, 0xee, 0x5f, 0x0e, 0x24, 0x16, 0xda, 0xcb, 0x52, 0xb4,
-	0x92, 0xd4, 0x8a, 0xbb, 0x5f, 0xe6, 0xe1, 0x3f, 0xdd, 0x99, 0xbe, 0x27, 0xb0, 0x60, 0xcc, 0xd0,
-	0x6d, 0x87, 0x5f, 0xc7, 0x2e, 0x78, 0x81, 0xa3, 0xee, 0xaf, 0x15, 0x08, 0xba, 0xef, 0x7e, 0x7f,
-	0xdd, 0x21, 0x27, 0xdf, 0x7f, 0x7d, 0x9a, 0x6b, 0xd1, 0xdb, 0xec, 0xfc, 0xba, 0xda, 0x69, 0xb1,
-	0x37, 0x66, 0x91, 0xde, 0xd2, 0x13, 0x02, 0x8b, 0x36, 0x16, 0xda, 0xba, 0x04, 0xc6, 0x2e, 0x85,
-	0xb7, 0x75, 0x31, 0x4d, 0x15, 0x6e, 0xd0, 0x9e, 0xe2, 0x6c, 0xd0, 0xb5, 0x7f, 0xe0, 0xd0, 0x8f,
-	0x04, 0x56, 0xea, 0x83, 0xa5, 0x77, 0x2e, 0x02, 0x71, 0xa4, 0xe2, 0xb9, 0xa8, 0x5d, 0x31, 0x05,
-	0xbb, 0x53, 0xa0, 0x5b, 0xf4, 0xa6, 0x03, 0xc8, 0xe4, 0xa5, 0x33, 0x7c, 0x74, 0xef, 0xdb, 0xd8,
-	0x27, 0xa7, 0x63, 0x9f, 0xfc, 0x1c, 0xfb, 0xe4, 0xc3, 0xc4, 0x6f, 0x9c, 0x4e, 0xfc, 0xc6, 0x8f,
-	0x89, 0xdf, 0x78, 0xbe, 0x5e, 0x2a, 0x31, 0x79, 0x15, 0x4a, 0xc5, 0x5e, 0x57, 0x1d, 0xf4, 0xd7,
-	0xa0, 0xf7, 0xbf, 0xfe, 0x4f, 0xdf, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xdd, 0xdb, 0x03,
-	0x8d, 0x04, 0x00, 0x00,
+	// 697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xb7, 0x80, 0x2e, 0x0c, 0x18, 0x70, 0xdc, 0x43, 0x53, 0xa0, 0x40, 0x89, 0x2c, 0x41,
+	0xd2, 0xba, 0x6b, 0xe2, 0xd1, 0x84, 0xd5, 0x88, 0x07, 0x4c, 0x96, 0xaa, 0x17, 0x13, 0xdd, 0x74,
+	0xdb, 0xb1, 0x34, 0x2c, 0x9d, 0xd2, 0xd7, 0x25, 0x10, 0xa3, 0x07, 0x4e, 0x7a, 0x33, 0xfa, 0x11,
+	0x8c, 0x47, 0x13, 0x3f, 0x06, 0x47, 0x12, 0x2f, 0x9e, 0x8c, 0x01, 0x13, 0x3f, 0x86, 0xa6, 0xd3,
+	0x99, 0x6e, 0x17, 0x66, 0xe5, 0xc2, 0xad, 0x3b, 0xef, 0xff, 0x7f, 0xfb, 0x7b, 0x6f, 0xde, 0x6b,
+	0xd1, 0xac, 0x4b, 0x61, 0x87, 0x82, 0xe5, 0x06, 0xb1, 0xdb, 0x0d, 0x12, 0x6b, 0xaf, 0x66, 0xed,
+	0x76, 0x49, 0x7c, 0x60, 0x46, 0x31, 0x4d, 0x28, 0xbe, 0x9e, 0x85, 0x4d, 0x1e, 0x36, 0xf7, 0x6a,
+	0xda, 0x0a, 0x77, 0xb4, 0x1d, 0x20, 0x99, 0xd6, 0xda, 0xab, 0xb5, 0x49, 0xe2, 0xd4, 0xac, 0xc8,
+	0xf1, 0x83, 0xd0, 0x49, 0x02, 0x1a, 0x66, 0x76, 0x4d, 0x92, 0x3d, 0x39, 0x88, 0x08, 0xf0, 0xf0,
+	0x8c, 0x4f, 0xa9, 0xdf, 0x21, 0x96, 0x13, 0x05, 0x96, 0x13, 0x86, 0x34, 0x61, 0x5e, 0x11, 0x9d,
+	0xe6, 0x66, 0xf1, 0x1f, 0x45, 0x30, 0xad, 0xe2, 0x53, 0x9f, 0xb2, 0x47, 0x2b, 0x7d, 0xca, 0x4e,
+	0x0d, 0x0b, 0xdd, 0xd8, 0x4c, 0x45, 0x6b, 0xae, 0x4b, 0xbb, 0x61, 0x62, 0x93, 0xdd, 0x2e, 0x81,
+	0x04, 0xab, 0xa8, 0xec, 0x78, 0x5e, 0x4c, 0x00, 0x54, 0x65, 0x5e, 0x59, 0x1e, 0xb3, 0xc5, 0x4f,
+	0x63, 0x13, 0x4d, 0xe6, 0x5a, 0x88, 0x68, 0x08, 0x04, 0xdf, 0x43, 0x28, 0x22, 0xf1, 0x4e, 0x00,
+	0x10, 0xd0, 0x90, 0xe9, 0xc7, 0xeb, 0xba, 0x79, 0xae, 0x0f, 0x66, 0x33, 0x17, 0x81, 0x5d, 0x70,
+	0x18, 0x2f, 0x51, 0xa5, 0xc8, 0x00, 0x02, 0xe2, 0x21, 0x42, 0xbd, 0xfe, 0xf0, 0xbc, 0x4b, 0x22,
+	0x6f, 0xda, 0x4c, 0x33, 0xab, 0x8f, 0x37, 0xd3, 0x6c, 0x3a, 0x3e, 0xe1, 0x5e, 0xbb, 0xe0, 0x34,
+	0xbe, 0x28, 0x68, 0xaa, 0x97, 0x9b, 0x43, 0x3f, 0x42, 0xa3, 0x0e, 0x3f, 0x53, 0x95, 0xf9, 0xe1,
+	0xe5, 0xf1, 0xfa, 0xaa, 0x04, 0x79, 0x9d, 0x84, 0x04, 0x02, 0xe0, 0xee, 0x62, 0x01, 0xb9, 0x1b,
+	0xaf, 0xf7, 0x61, 0x0e, 0x31, 0xcc, 0xea, 0x85, 0x98, 0x19, 0x46, 0x1f, 0xa7, 0x86, 0x54, 0xd6,
+	0x87, 0x07, 0x01, 0x38, 0xed, 0x0e, 0xf1, 0x36, 0x02, 0x10, 0x17, 0x62, 0xfc, 0x55, 0x50, 0xa5,
+	0xff, 0x9c, 0xd7, 0xb1, 0x88, 0xae, 0x79, 0xfc, 0xbc, 0xd5, 0x09, 0x20, 0x61, 0xc5, 0x8c, 0xd9,
+	0x13, 0x5e, 0x41, 0x8c, 0x1b, 0x48, 0xcf, 0x45, 0x3b, 0xe0, 0xb7, 0xd2, 0x91, 0x6a, 0x75, 0xe3,
+	0x4e, 0x2b, 0x8a, 0xc9, 0xab, 0x60, 0x9f, 0x80, 0x3a, 0xc4, 0x5c, 0x9a, 0x50, 0x3d, 0x06, 0xff,
+	0xe9, 0x41, 0x44, 0x9e, 0xc5, 0x9d, 0x26, 0x57, 0xe0, 0xdb, 0xa8, 0x92, 0xe7, 0x60, 0x05, 0xb5,
+	0x22, 0x27, 0xd9, 0x02, 0x75, 0x98, 0x39, 0xb1, 0x88, 0xb1, 0x0a, 0x9a, 0x69, 0x04, 0x6f, 0xa0,
+	0x29, 0x70, 0xb7, 0x88, 0xd7, 0x4d, 0x2d, 0x31, 0x01, 0x92, 0x80, 0x3a, 0xc2, 0x5a, 0xbd, 0x20,
+	0x69, 0xf5, 0x13, 0x21, 0xb5, 0x53, 0xa5, 0x3d, 0x09, 0x7d, 0xbf, 0xc1, 0x78, 0xc1, 0x27, 0xd5,
+	0x26, 0x2e, 0x8d, 0xbd, 0x4b, 0x1f, 0x92, 0xcf, 0x0a, 0x9a, 0xcc, 0x53, 0xf3, 0xde, 0xae, 0xa3,
+	0x72, 0x9c, 0x1d, 0xf1, 0x11, 0xa9, 0x4a, 0xb8, 0xef, 0x67, 0x8f, 0x8d, 0x98, 0x38, 0xdb, 0x24,
+	0xce, 0x52, 0x34, 0x46, 0x8e, 0x7e, 0xce, 0x95, 0x6c, 0xe1, 0xbe, 0xb4, 0x11, 0xa9, 0x7f, 0x1d,
+	0x41, 0x57, 0x58, 0x17, 0xf0, 0x7b, 0x05, 0x95, 0xf9, 0x58, 0xe2, 0x25, 0x09, 0x96, 0x64, 0xab,
+	0x35, 0x43, 0xa2, 0x3b, 0xb3, 0xcc, 0x46, 0xfd, 0xdd, 0x9f, 0x6f, 0x2b, 0xca, 0xe1, 0xf7, 0xdf,
+	0x9f, 0x86, 0xaa, 0xf8, 0xa6, 0x75, 0xfe, 0x75, 0x24, 0xe6, 0xde, 0x7a, 0xcd, 0x5f, 0x09, 0x6f,
+	0xf0, 0xa1, 0x82, 0x46, 0xd7, 0xc4, 0x3a, 0x54, 0x2f, 0x80, 0x11, 0x37, 0xa7, 0x2d, 0x0e, 0xa6,
+	0xc9, 0xaf, 0xc0, 0x58, 0xee, 0xe1, 0xcc, 0xe2, 0xe9, 0xff, 0xe0, 0xe0, 0x8f, 0x0a, 0x9a, 0x28,
+	0x6e, 0x08, 0xbe, 0x35, 0x08, 0x44, 0xb2, 0x5f, 0x9a, 0x8c, 0x5a, 0xb6, 0x6f, 0xc6, 0x6a, 0x0f,
+	0x68, 0x01, 0xcf, 0x49, 0x80, 0xf8, 0x22, 0xb0, 0x65, 0xc4, 0x6f, 0x51, 0x99, 0x0f, 0xd5, 0xe0,
+	0x4b, 0xea, 0x1f, 0x68, 0xe9, 0x25, 0x9d, 0x19, 0x4c, 0xa3, 0xda, 0x83, 0x98, 0xc1, 0x9a, 0x04,
+	0x82, 0x0f, 0x5e, 0xe3, 0xee, 0xd1, 0x89, 0xae, 0x1c, 0x9f, 0xe8, 0xca, 0xaf, 0x13, 0x5d, 0xf9,
+	0x70, 0xaa, 0x97, 0x8e, 0x4f, 0xf5, 0xd2, 0x8f, 0x53, 0xbd, 0xf4, 0x7c, 0x26, 0x33, 0x81, 0xb7,
+	0x6d, 0x06, 0xd4, 0xda, 0xcf, 0xcd, 0xec, 0x6b, 0xd3, 0xbe, 0xca, 0xbe, 0x0e, 0x77, 0xfe, 0x0d,
+	0x00, 0x60, 0xf5, 0xc0, 0x46, 0xed, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -365,8 +508,11 @@ type QueryClient interface {
 	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
 	// Accounts returns multiple accounts permissions.
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
-	// DisabledList returns a list of disabled message urls
+	// DisabledList returns a list of disabled message urls, message url prefixes
+	// and query paths
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// Records returns the circuit breaker trip and reset records.
+	Records(ctx context.Context, in *QueryRecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error)
 }
 
 type queryClient struct {
@@ -404,14 +550,26 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) Records(ctx context.Context, in *QueryRecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error) {
+	out := new(RecordsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/Records", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account returns account permissions.
 	Account(context.Context, *QueryAccountRequest) (*AccountResponse, error)
 	// Accounts returns multiple accounts permissions.
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
-	// DisabledList returns a list of disabled message urls
+	// DisabledList returns a list of disabled message urls, message url prefixes
+	// and query paths
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// Records returns the circuit breaker trip and reset records.
+	Records(context.Context, *QueryRecordsRequest) (*RecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -427,6 +585,9 @@ func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccount
 func (*UnimplementedQueryServer) DisabledList(ctx context.Context, req *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (*UnimplementedQueryServer) Records(ctx context.Context, req *QueryRecordsRequest) (*RecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -486,6 +647,24 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Records(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/Records",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Records(ctx, req.(*QueryRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.circuit.v1.Query",
@@ -503,6 +682,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "Records",
+			Handler:    _Query_Records_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -700,6 +883,38 @@ func (m *DisabledListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledResets) > 0 {
+		for iNdEx := len(m.ScheduledResets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledResets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DisabledQueryPaths) > 0 {
+		for iNdEx := len(m.DisabledQueryPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledQueryPaths[iNdEx])
+			copy(dAtA[i:], m.DisabledQueryPaths[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DisabledQueryPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DisabledMsgTypeUrlPrefixes) > 0 {
+		for iNdEx := len(m.DisabledMsgTypeUrlPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypeUrlPrefixes[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypeUrlPrefixes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DisabledMsgTypeUrlPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.DisabledList) > 0 {
 		for iNdEx := len(m.DisabledList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DisabledList[iNdEx])
@@ -712,6 +927,90 @@ func (m *DisabledListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -802,6 +1101,56 @@ func (m *DisabledListResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.DisabledMsgTypeUrlPrefixes) > 0 {
+		for _, s := range m.DisabledMsgTypeUrlPrefixes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DisabledQueryPaths) > 0 {
+		for _, s := range m.DisabledQueryPaths {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ScheduledResets) > 0 {
+		for _, e := range m.ScheduledResets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1296,6 +1645,310 @@ func (m *DisabledListResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.DisabledList = append(m.DisabledList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypeUrlPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypeUrlPrefixes = append(m.DisabledMsgTypeUrlPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledQueryPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledQueryPaths = append(m.DisabledQueryPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledResets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledResets = append(m.ScheduledResets, &ScheduledReset{})
+			if err := m.ScheduledResets[len(m.ScheduledResets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, CircuitBreakerRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
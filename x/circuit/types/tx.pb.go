@@ -150,6 +150,19 @@ type MsgTripCircuitBreaker struct {
 	// authority does not have permissions to trip the specified msg type URLs
 	// (or all URLs), the operation will fail.
 	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// msg_type_url_prefixes specifies a list of Msg type URL prefixes to stop
+	// processing, e.g. "/cosmos.bank.v1beta1." to stop processing all the Msg's
+	// of the bank module. A prefix must start with "/" and end with ".".
+	MsgTypeUrlPrefixes []string `protobuf:"bytes,3,rep,name=msg_type_url_prefixes,json=msgTypeUrlPrefixes,proto3" json:"msg_type_url_prefixes,omitempty"`
+	// query_paths specifies a list of gRPC query paths to stop serving, e.g.
+	// "/cosmos.bank.v1beta1.Query/AllBalances".
+	QueryPaths []string `protobuf:"bytes,4,rep,name=query_paths,json=queryPaths,proto3" json:"query_paths,omitempty"`
+	// reset_after_blocks, if non-zero, is the number of blocks after which the
+	// tripped circuit breakers are automatically reset.
+	ResetAfterBlocks uint64 `protobuf:"varint,5,opt,name=reset_after_blocks,json=resetAfterBlocks,proto3" json:"reset_after_blocks,omitempty"`
+	// reason is the reason for tripping the circuit breaker, kept in the trip
+	// record.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgTripCircuitBreaker) Reset()         { *m = MsgTripCircuitBreaker{} }
@@ -199,6 +212,34 @@ func (m *MsgTripCircuitBreaker) GetMsgTypeUrls() []string {
 	return nil
 }
 
+func (m *MsgTripCircuitBreaker) GetMsgTypeUrlPrefixes() []string {
+	if m != nil {
+		return m.MsgTypeUrlPrefixes
+	}
+	return nil
+}
+
+func (m *MsgTripCircuitBreaker) GetQueryPaths() []string {
+	if m != nil {
+		return m.QueryPaths
+	}
+	return nil
+}
+
+func (m *MsgTripCircuitBreaker) GetResetAfterBlocks() uint64 {
+	if m != nil {
+		return m.ResetAfterBlocks
+	}
+	return 0
+}
+
+func (m *MsgTripCircuitBreaker) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // MsgTripCircuitBreakerResponse defines the Msg/TripCircuitBreaker response type.
 type MsgTripCircuitBreakerResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -252,6 +293,14 @@ type MsgResetCircuitBreaker struct {
 	// it is left empty all Msg processing for type URLs that the account is
 	// authorized to trip will resume.
 	MsgTypeUrls []string `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// msg_type_url_prefixes specifies a list of Msg type URL prefixes to resume
+	// processing.
+	MsgTypeUrlPrefixes []string `protobuf:"bytes,4,rep,name=msg_type_url_prefixes,json=msgTypeUrlPrefixes,proto3" json:"msg_type_url_prefixes,omitempty"`
+	// query_paths specifies a list of gRPC query paths to resume serving.
+	QueryPaths []string `protobuf:"bytes,5,rep,name=query_paths,json=queryPaths,proto3" json:"query_paths,omitempty"`
+	// reason is the reason for resetting the circuit breaker, kept in the reset
+	// record.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgResetCircuitBreaker) Reset()         { *m = MsgResetCircuitBreaker{} }
@@ -301,6 +350,27 @@ func (m *MsgResetCircuitBreaker) GetMsgTypeUrls() []string {
 	return nil
 }
 
+func (m *MsgResetCircuitBreaker) GetMsgTypeUrlPrefixes() []string {
+	if m != nil {
+		return m.MsgTypeUrlPrefixes
+	}
+	return nil
+}
+
+func (m *MsgResetCircuitBreaker) GetQueryPaths() []string {
+	if m != nil {
+		return m.QueryPaths
+	}
+	return nil
+}
+
+func (m *MsgResetCircuitBreaker) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // MsgResetCircuitBreakerResponse defines the Msg/ResetCircuitBreaker response type.
 type MsgResetCircuitBreakerResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -358,34 +428,40 @@ func init() {
 func init() { proto.RegisterFile("cosmos/circuit/v1/tx.proto", fileDescriptor_a02145e57a6fbb1d) }
 
 var fileDescriptor_a02145e57a6fbb1d = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0xae, 0xd2, 0x40,
-	0x14, 0x87, 0x19, 0x1a, 0xff, 0x30, 0xa8, 0x89, 0x35, 0x7a, 0x9b, 0xc9, 0xbd, 0x13, 0xd2, 0x55,
-	0xbd, 0x89, 0xad, 0x60, 0x34, 0x91, 0x85, 0x51, 0x5c, 0x37, 0x31, 0x0d, 0x6e, 0xdc, 0x10, 0xac,
-	0x93, 0x71, 0x84, 0x32, 0xcd, 0x9c, 0x96, 0x80, 0x1b, 0x8d, 0x4f, 0xe0, 0x23, 0xf8, 0x08, 0x3c,
-	0x86, 0x4b, 0x96, 0x2e, 0x0d, 0x2c, 0x78, 0x01, 0x1f, 0xc0, 0x94, 0x52, 0x4a, 0x64, 0x08, 0x98,
-	0xbb, 0x1c, 0xce, 0x37, 0xe7, 0xf7, 0x0d, 0xa7, 0x07, 0x93, 0x50, 0x42, 0x24, 0xc1, 0x0b, 0x85,
-	0x0a, 0x53, 0x91, 0x78, 0xe3, 0xa6, 0x97, 0x4c, 0xdc, 0x58, 0xc9, 0x44, 0x9a, 0x77, 0xf3, 0x9a,
-	0xbb, 0xa9, 0xb9, 0xe3, 0x26, 0x39, 0xdb, 0xe0, 0x11, 0xf0, 0x0c, 0x8d, 0x80, 0xe7, 0x2c, 0xb9,
-	0xd0, 0xf4, 0x99, 0xc6, 0x0c, 0xf2, 0xb2, 0xfd, 0x03, 0x61, 0xe2, 0x03, 0x7f, 0x95, 0x26, 0x1f,
-	0xa5, 0x12, 0x9f, 0xd9, 0xeb, 0x1c, 0xeb, 0x28, 0xd6, 0x1f, 0x30, 0x65, 0x5a, 0xf8, 0x06, 0x57,
-	0xfd, 0x51, 0xc2, 0x94, 0x85, 0x1a, 0xc8, 0xa9, 0x05, 0xc5, 0xb1, 0xac, 0x30, 0xab, 0xba, 0x5b,
-	0x61, 0xe6, 0x4b, 0x5c, 0x8f, 0x99, 0x8a, 0x04, 0x80, 0x90, 0x23, 0xb0, 0x8c, 0x06, 0x72, 0xea,
-	0x2d, 0xea, 0xee, 0x39, 0xbb, 0x6f, 0x4a, 0x2a, 0xd8, 0xbd, 0xd2, 0xbe, 0xf5, 0x6d, 0x35, 0xbb,
-	0x2c, 0x92, 0xec, 0x17, 0xd8, 0x3e, 0x6c, 0x18, 0x30, 0x88, 0xe5, 0x08, 0x58, 0xe6, 0x03, 0x69,
-	0x18, 0x32, 0x80, 0xb5, 0xe9, 0xcd, 0xa0, 0x38, 0xda, 0x02, 0xdf, 0xf7, 0x81, 0x77, 0x95, 0x88,
-	0xff, 0x79, 0xdc, 0x39, 0xae, 0xf5, 0xf3, 0xae, 0xc9, 0x74, 0xf3, 0xbc, 0xf2, 0x07, 0xd3, 0xc6,
-	0xb7, 0x23, 0xe0, 0xbd, 0xec, 0xcf, 0xea, 0xa5, 0x6a, 0x08, 0x56, 0xb5, 0x61, 0x38, 0xb5, 0xa0,
-	0x1e, 0x01, 0xef, 0x4e, 0x63, 0xf6, 0x56, 0x0d, 0xa1, 0x7d, 0x27, 0x13, 0x2d, 0xef, 0xd8, 0xcf,
-	0xf1, 0x85, 0x36, 0xea, 0x04, 0xcb, 0x4f, 0xf8, 0x81, 0x0f, 0x3c, 0x60, 0xc0, 0x92, 0xab, 0x69,
-	0x1a, 0xc7, 0x35, 0xdb, 0x98, 0xea, 0xb3, 0x8e, 0x7b, 0xb6, 0xfe, 0x54, 0xb1, 0xe1, 0x03, 0x37,
-	0xbf, 0xe0, 0xb3, 0x43, 0x1f, 0xcd, 0x23, 0xcd, 0xac, 0x0f, 0x4f, 0x90, 0x3c, 0xfd, 0x2f, 0x7c,
-	0xab, 0x18, 0x63, 0x53, 0x33, 0x53, 0x47, 0xdf, 0x6c, 0x9f, 0x24, 0x8f, 0x4f, 0x25, 0xb7, 0x89,
-	0x80, 0xef, 0xe9, 0xe6, 0xf3, 0x50, 0xdf, 0x48, 0x83, 0x92, 0xe6, 0xc9, 0x68, 0x11, 0x4a, 0xae,
-	0x7d, 0x5d, 0xcd, 0x2e, 0x51, 0xe7, 0xd9, 0xcf, 0x05, 0x45, 0xf3, 0x05, 0x45, 0xbf, 0x17, 0x14,
-	0x7d, 0x5f, 0xd2, 0xca, 0x7c, 0x49, 0x2b, 0xbf, 0x96, 0xb4, 0xf2, 0xee, 0x3c, 0x6f, 0x09, 0x1f,
-	0x06, 0xae, 0x90, 0xde, 0x64, 0xbb, 0xe8, 0xeb, 0x2d, 0x7f, 0x7f, 0x7d, 0xbd, 0xe6, 0x4f, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x89, 0x07, 0x74, 0x02, 0x4f, 0x04, 0x00, 0x00,
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0xf9, 0x82, 0xbc, 0x00, 0x82, 0x41, 0x6d, 0x2d, 0xab, 0x35, 0x91, 0x57, 0xa6,
+	0x02, 0x87, 0x14, 0x81, 0x44, 0x16, 0x88, 0x86, 0x75, 0xa4, 0xc8, 0x2a, 0x1b, 0x36, 0x96, 0x6b,
+	0xa6, 0xae, 0x95, 0x38, 0x63, 0xe6, 0xd9, 0x55, 0xc2, 0x06, 0x04, 0x17, 0xe0, 0x08, 0x1c, 0xa1,
+	0xc7, 0x60, 0xd9, 0x15, 0x62, 0x89, 0x92, 0x45, 0x2f, 0xc0, 0x01, 0x90, 0x3f, 0x12, 0x47, 0xc4,
+	0x11, 0x8e, 0x58, 0xce, 0xfb, 0xff, 0xfc, 0x3e, 0xfe, 0x7a, 0x7e, 0x20, 0xdb, 0x1c, 0x3d, 0x8e,
+	0x6d, 0xdb, 0x15, 0x76, 0xe8, 0x06, 0xed, 0x8b, 0x4e, 0x3b, 0x98, 0xe8, 0xbe, 0xe0, 0x01, 0xa7,
+	0xf7, 0x12, 0x4d, 0x4f, 0x35, 0xfd, 0xa2, 0x23, 0xef, 0xa5, 0xb8, 0x87, 0x4e, 0x84, 0x7a, 0xe8,
+	0x24, 0xac, 0x7c, 0x90, 0x93, 0x67, 0xea, 0x33, 0x4c, 0x64, 0xf5, 0x1b, 0x01, 0xb9, 0x8f, 0xce,
+	0x71, 0x18, 0x9c, 0x73, 0xe1, 0x7e, 0x60, 0xaf, 0x13, 0xac, 0x27, 0x98, 0x35, 0x64, 0x82, 0x4a,
+	0x70, 0xc3, 0x11, 0xd6, 0x38, 0x60, 0x42, 0x22, 0x2d, 0xa2, 0x35, 0x8c, 0xc5, 0x33, 0x53, 0x98,
+	0x54, 0x5e, 0x55, 0x18, 0x7d, 0x05, 0x4d, 0x9f, 0x09, 0xcf, 0x45, 0x74, 0xf9, 0x18, 0xa5, 0x4a,
+	0x8b, 0x68, 0xcd, 0x23, 0x45, 0x5f, 0xeb, 0x59, 0x1f, 0x64, 0x94, 0xb1, 0xfa, 0x49, 0xf7, 0xd6,
+	0xe7, 0xeb, 0xcb, 0xc3, 0x45, 0x25, 0xf5, 0x25, 0xa8, 0x9b, 0x3b, 0x34, 0x18, 0xfa, 0x7c, 0x8c,
+	0x2c, 0xea, 0x07, 0x43, 0xdb, 0x66, 0x88, 0x71, 0xa7, 0x37, 0x8d, 0xc5, 0x53, 0xfd, 0x52, 0x86,
+	0x9d, 0x3e, 0x3a, 0x27, 0xc2, 0xf5, 0xff, 0x9a, 0x6e, 0x1f, 0x1a, 0x56, 0x92, 0x36, 0x98, 0xa6,
+	0xf3, 0x65, 0x01, 0xaa, 0xc2, 0x6d, 0x0f, 0x1d, 0x33, 0x72, 0xcb, 0x0c, 0xc5, 0x08, 0xa5, 0x72,
+	0xab, 0xa2, 0x35, 0x8c, 0xa6, 0x87, 0xce, 0xc9, 0xd4, 0x67, 0x6f, 0xc4, 0x08, 0x69, 0x07, 0x76,
+	0x56, 0x19, 0xd3, 0x17, 0xec, 0xcc, 0x9d, 0xb0, 0x68, 0xea, 0x88, 0xa5, 0x19, 0x3b, 0x48, 0x15,
+	0xfa, 0x00, 0x9a, 0xef, 0x43, 0x26, 0xa6, 0xa6, 0x6f, 0x05, 0xe7, 0x28, 0x55, 0x63, 0x10, 0xe2,
+	0xd0, 0x20, 0x8a, 0xd0, 0x47, 0x40, 0x05, 0x43, 0x16, 0x98, 0xd6, 0x59, 0xc0, 0x84, 0x79, 0x3a,
+	0xe2, 0xf6, 0x10, 0xa5, 0x5a, 0x8b, 0x68, 0x55, 0xe3, 0x6e, 0xac, 0x1c, 0x47, 0x42, 0x2f, 0x8e,
+	0xd3, 0x5d, 0xa8, 0x0b, 0x66, 0x21, 0x1f, 0x4b, 0xf5, 0x78, 0x80, 0xf4, 0xd5, 0xbd, 0x13, 0x79,
+	0x98, 0x4d, 0xa3, 0xbe, 0x80, 0x83, 0x5c, 0x13, 0x0a, 0x18, 0xf8, 0x83, 0xc0, 0x6e, 0x1f, 0x1d,
+	0x23, 0x2a, 0xfd, 0x7f, 0x0e, 0x56, 0xb6, 0x70, 0xb0, 0x5a, 0xd4, 0xc1, 0xda, 0x9a, 0x83, 0x45,
+	0x3d, 0xe9, 0x82, 0x92, 0x3f, 0xd7, 0xbf, 0x4d, 0x39, 0xfa, 0x5d, 0x86, 0x4a, 0x1f, 0x1d, 0xfa,
+	0x11, 0xf6, 0x36, 0xfd, 0x3c, 0x8f, 0x73, 0x76, 0x7e, 0xf3, 0x26, 0xcb, 0xcf, 0xb6, 0xc2, 0x97,
+	0x2d, 0xfa, 0x40, 0x73, 0x56, 0x5b, 0xcb, 0x4f, 0xb6, 0x4e, 0xca, 0x4f, 0x8a, 0x92, 0xcb, 0x8a,
+	0x08, 0xf7, 0xf3, 0x76, 0xe1, 0x61, 0x7e, 0xa2, 0x1c, 0x54, 0xee, 0x14, 0x46, 0x17, 0x45, 0xe5,
+	0xda, 0xa7, 0xeb, 0xcb, 0x43, 0xd2, 0x7b, 0xfe, 0x7d, 0xa6, 0x90, 0xab, 0x99, 0x42, 0x7e, 0xcd,
+	0x14, 0xf2, 0x75, 0xae, 0x94, 0xae, 0xe6, 0x4a, 0xe9, 0xe7, 0x5c, 0x29, 0xbd, 0xdd, 0x4f, 0x52,
+	0xe2, 0xbb, 0xa1, 0xee, 0xf2, 0xf6, 0x64, 0x79, 0xf0, 0xe2, 0x6b, 0x77, 0x5a, 0x8f, 0xcf, 0xdd,
+	0xd3, 0x3f, 0x03, 0x00, 0x85, 0x01, 0xbe, 0xd9, 0x57, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -403,7 +479,8 @@ type MsgClient interface {
 	// AuthorizeCircuitBreaker allows a super-admin to grant (or revoke) another
 	// account's circuit breaker permissions.
 	AuthorizeCircuitBreaker(ctx context.Context, in *MsgAuthorizeCircuitBreaker, opts ...grpc.CallOption) (*MsgAuthorizeCircuitBreakerResponse, error)
-	// TripCircuitBreaker pauses processing of Msg's in the state machine.
+	// TripCircuitBreaker pauses processing of Msg's in the state machine, or of
+	// gRPC queries.
 	TripCircuitBreaker(ctx context.Context, in *MsgTripCircuitBreaker, opts ...grpc.CallOption) (*MsgTripCircuitBreakerResponse, error)
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been paused using TripCircuitBreaker.
@@ -450,7 +527,8 @@ type MsgServer interface {
 	// AuthorizeCircuitBreaker allows a super-admin to grant (or revoke) another
 	// account's circuit breaker permissions.
 	AuthorizeCircuitBreaker(context.Context, *MsgAuthorizeCircuitBreaker) (*MsgAuthorizeCircuitBreakerResponse, error)
-	// TripCircuitBreaker pauses processing of Msg's in the state machine.
+	// TripCircuitBreaker pauses processing of Msg's in the state machine, or of
+	// gRPC queries.
 	TripCircuitBreaker(context.Context, *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error)
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been paused using TripCircuitBreaker.
@@ -653,6 +731,36 @@ func (m *MsgTripCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ResetAfterBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ResetAfterBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.QueryPaths) > 0 {
+		for iNdEx := len(m.QueryPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryPaths[iNdEx])
+			copy(dAtA[i:], m.QueryPaths[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.QueryPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MsgTypeUrlPrefixes) > 0 {
+		for iNdEx := len(m.MsgTypeUrlPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrlPrefixes[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrlPrefixes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrlPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MsgTypeUrls) > 0 {
 		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MsgTypeUrls[iNdEx])
@@ -725,6 +833,31 @@ func (m *MsgResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.QueryPaths) > 0 {
+		for iNdEx := len(m.QueryPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryPaths[iNdEx])
+			copy(dAtA[i:], m.QueryPaths[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.QueryPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MsgTypeUrlPrefixes) > 0 {
+		for iNdEx := len(m.MsgTypeUrlPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrlPrefixes[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrlPrefixes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrlPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MsgTypeUrls) > 0 {
 		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MsgTypeUrls[iNdEx])
@@ -837,6 +970,25 @@ func (m *MsgTripCircuitBreaker) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.MsgTypeUrlPrefixes) > 0 {
+		for _, s := range m.MsgTypeUrlPrefixes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.QueryPaths) > 0 {
+		for _, s := range m.QueryPaths {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ResetAfterBlocks != 0 {
+		n += 1 + sovTx(uint64(m.ResetAfterBlocks))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -868,6 +1020,22 @@ func (m *MsgResetCircuitBreaker) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.MsgTypeUrlPrefixes) > 0 {
+		for _, s := range m.MsgTypeUrlPrefixes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.QueryPaths) > 0 {
+		for _, s := range m.QueryPaths {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1202,6 +1370,121 @@ func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 			}
 			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrlPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrlPrefixes = append(m.MsgTypeUrlPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryPaths = append(m.QueryPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetAfterBlocks", wireType)
+			}
+			m.ResetAfterBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetAfterBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1386,6 +1669,102 @@ func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 			}
 			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrlPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrlPrefixes = append(m.MsgTypeUrlPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryPaths = append(m.QueryPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
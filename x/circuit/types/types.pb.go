@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,6 +27,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CircuitType defines what a tripped circuit breaker disables.
+type CircuitType int32
+
+const (
+	// CIRCUIT_TYPE_UNSPECIFIED defines an unknown circuit type.
+	CircuitType_CIRCUIT_TYPE_UNSPECIFIED CircuitType = 0
+	// CIRCUIT_TYPE_MSG_TYPE_URL defines a circuit disabling a single Msg type URL.
+	CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL CircuitType = 1
+	// CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX defines a circuit disabling all the Msg
+	// type URLs starting with a given prefix, e.g. all the Msg's of a module.
+	CircuitType_CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX CircuitType = 2
+	// CIRCUIT_TYPE_QUERY_PATH defines a circuit disabling a gRPC query path.
+	CircuitType_CIRCUIT_TYPE_QUERY_PATH CircuitType = 3
+)
+
+var CircuitType_name = map[int32]string{
+	0: "CIRCUIT_TYPE_UNSPECIFIED",
+	1: "CIRCUIT_TYPE_MSG_TYPE_URL",
+	2: "CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX",
+	3: "CIRCUIT_TYPE_QUERY_PATH",
+}
+
+var CircuitType_value = map[string]int32{
+	"CIRCUIT_TYPE_UNSPECIFIED":         0,
+	"CIRCUIT_TYPE_MSG_TYPE_URL":        1,
+	"CIRCUIT_TYPE_MSG_TYPE_URL_PREFIX": 2,
+	"CIRCUIT_TYPE_QUERY_PATH":          3,
+}
+
+func (x CircuitType) String() string {
+	return proto.EnumName(CircuitType_name, int32(x))
+}
+
+func (CircuitType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{0}
+}
+
 // Level is the permission level.
 type Permissions_Level int32
 
@@ -35,7 +77,7 @@ const (
 	// limit_type_urls.
 	Permissions_LEVEL_SOME_MSGS Permissions_Level = 1
 	// LEVEL_ALL_MSGS indicates that the account can trip or reset the circuit
-	// breaker for Msg's of all type URLs.
+	// breaker for Msg's of all type URLs, but not for gRPC query paths.
 	Permissions_LEVEL_ALL_MSGS Permissions_Level = 2
 	// LEVEL_SUPER_ADMIN indicates that the account can take all circuit breaker
 	// actions and can grant permissions to other accounts.
@@ -64,14 +106,53 @@ func (Permissions_Level) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1f5fe523f8a09dbc, []int{0, 0}
 }
 
+// Action is a circuit breaker action.
+type CircuitBreakerRecord_Action int32
+
+const (
+	// ACTION_UNSPECIFIED defines an unknown action.
+	CircuitBreakerRecord_ACTION_UNSPECIFIED CircuitBreakerRecord_Action = 0
+	// ACTION_TRIP defines a trip of the circuit breaker.
+	CircuitBreakerRecord_ACTION_TRIP CircuitBreakerRecord_Action = 1
+	// ACTION_RESET defines a reset of the circuit breaker by an authority.
+	CircuitBreakerRecord_ACTION_RESET CircuitBreakerRecord_Action = 2
+	// ACTION_SCHEDULED_RESET defines an automatic reset of the circuit breaker
+	// at the end of a time-bounded trip.
+	CircuitBreakerRecord_ACTION_SCHEDULED_RESET CircuitBreakerRecord_Action = 3
+)
+
+var CircuitBreakerRecord_Action_name = map[int32]string{
+	0: "ACTION_UNSPECIFIED",
+	1: "ACTION_TRIP",
+	2: "ACTION_RESET",
+	3: "ACTION_SCHEDULED_RESET",
+}
+
+var CircuitBreakerRecord_Action_value = map[string]int32{
+	"ACTION_UNSPECIFIED":     0,
+	"ACTION_TRIP":            1,
+	"ACTION_RESET":           2,
+	"ACTION_SCHEDULED_RESET": 3,
+}
+
+func (x CircuitBreakerRecord_Action) String() string {
+	return proto.EnumName(CircuitBreakerRecord_Action_name, int32(x))
+}
+
+func (CircuitBreakerRecord_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{2, 0}
+}
+
 // Permissions are the permissions that an account has to trip
 // or reset the circuit breaker.
 type Permissions struct {
 	// level is the level of permissions granted to this account.
 	Level Permissions_Level `protobuf:"varint,1,opt,name=level,proto3,enum=cosmos.circuit.v1.Permissions_Level" json:"level,omitempty"`
 	// limit_type_urls is used with LEVEL_SOME_MSGS to limit the lists of Msg type
-	// URLs that the account can trip. It is an error to use limit_type_urls with
-	// a level other than LEVEL_SOME_MSGS.
+	// URLs that the account can trip. Msg type URL prefixes and query paths can
+	// be listed as well, in which case they must match exactly the ones tripped.
+	// It is an error to use limit_type_urls with a level other than
+	// LEVEL_SOME_MSGS.
 	LimitTypeUrls []string `protobuf:"bytes,2,rep,name=limit_type_urls,json=limitTypeUrls,proto3" json:"limit_type_urls,omitempty"`
 }
 
@@ -122,6 +203,202 @@ func (m *Permissions) GetLimitTypeUrls() []string {
 	return nil
 }
 
+// ScheduledReset defines a tripped circuit breaker which is automatically reset
+// at a given block height.
+type ScheduledReset struct {
+	// circuit_type is the type of the tripped circuit.
+	CircuitType CircuitType `protobuf:"varint,1,opt,name=circuit_type,json=circuitType,proto3,enum=cosmos.circuit.v1.CircuitType" json:"circuit_type,omitempty"`
+	// value is the Msg type URL, Msg type URL prefix or query path of the
+	// tripped circuit.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// height is the block height at the beginning of which the circuit breaker
+	// is reset.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ScheduledReset) Reset()         { *m = ScheduledReset{} }
+func (m *ScheduledReset) String() string { return proto.CompactTextString(m) }
+func (*ScheduledReset) ProtoMessage()    {}
+func (*ScheduledReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{1}
+}
+func (m *ScheduledReset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledReset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledReset.Merge(m, src)
+}
+func (m *ScheduledReset) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledReset proto.InternalMessageInfo
+
+func (m *ScheduledReset) GetCircuitType() CircuitType {
+	if m != nil {
+		return m.CircuitType
+	}
+	return CircuitType_CIRCUIT_TYPE_UNSPECIFIED
+}
+
+func (m *ScheduledReset) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ScheduledReset) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// CircuitBreakerRecord is the record of a circuit breaker trip or reset, kept
+// as an audit trail of the emergency actions taken.
+type CircuitBreakerRecord struct {
+	// id is the unique and increasing identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// action is the action taken.
+	Action CircuitBreakerRecord_Action `protobuf:"varint,2,opt,name=action,proto3,enum=cosmos.circuit.v1.CircuitBreakerRecord_Action" json:"action,omitempty"`
+	// authority is the account which tripped or reset the circuit breaker. It is
+	// empty for scheduled resets.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// reason is the reason given by the authority for the action.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// msg_type_urls are the Msg type URLs tripped or reset.
+	MsgTypeUrls []string `protobuf:"bytes,5,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// msg_type_url_prefixes are the Msg type URL prefixes tripped or reset.
+	MsgTypeUrlPrefixes []string `protobuf:"bytes,6,rep,name=msg_type_url_prefixes,json=msgTypeUrlPrefixes,proto3" json:"msg_type_url_prefixes,omitempty"`
+	// query_paths are the gRPC query paths tripped or reset.
+	QueryPaths []string `protobuf:"bytes,7,rep,name=query_paths,json=queryPaths,proto3" json:"query_paths,omitempty"`
+	// reset_height is the height at which a trip is scheduled to be reset, if any.
+	ResetHeight uint64 `protobuf:"varint,8,opt,name=reset_height,json=resetHeight,proto3" json:"reset_height,omitempty"`
+	// height is the block height of the action.
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the action.
+	Time time.Time `protobuf:"bytes,10,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *CircuitBreakerRecord) Reset()         { *m = CircuitBreakerRecord{} }
+func (m *CircuitBreakerRecord) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerRecord) ProtoMessage()    {}
+func (*CircuitBreakerRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+}
+func (m *CircuitBreakerRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerRecord.Merge(m, src)
+}
+func (m *CircuitBreakerRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerRecord proto.InternalMessageInfo
+
+func (m *CircuitBreakerRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CircuitBreakerRecord) GetAction() CircuitBreakerRecord_Action {
+	if m != nil {
+		return m.Action
+	}
+	return CircuitBreakerRecord_ACTION_UNSPECIFIED
+}
+
+func (m *CircuitBreakerRecord) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *CircuitBreakerRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CircuitBreakerRecord) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *CircuitBreakerRecord) GetMsgTypeUrlPrefixes() []string {
+	if m != nil {
+		return m.MsgTypeUrlPrefixes
+	}
+	return nil
+}
+
+func (m *CircuitBreakerRecord) GetQueryPaths() []string {
+	if m != nil {
+		return m.QueryPaths
+	}
+	return nil
+}
+
+func (m *CircuitBreakerRecord) GetResetHeight() uint64 {
+	if m != nil {
+		return m.ResetHeight
+	}
+	return 0
+}
+
+func (m *CircuitBreakerRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CircuitBreakerRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // GenesisAccountPermissions is the account permissions for the circuit breaker in genesis
 type GenesisAccountPermissions struct {
 	Address     string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -132,7 +409,7 @@ func (m *GenesisAccountPermissions) Reset()         { *m = GenesisAccountPermiss
 func (m *GenesisAccountPermissions) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccountPermissions) ProtoMessage()    {}
 func (*GenesisAccountPermissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f5fe523f8a09dbc, []int{1}
+	return fileDescriptor_1f5fe523f8a09dbc, []int{3}
 }
 func (m *GenesisAccountPermissions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,13 +456,22 @@ func (m *GenesisAccountPermissions) GetPermissions() *Permissions {
 type GenesisState struct {
 	AccountPermissions []*GenesisAccountPermissions `protobuf:"bytes,1,rep,name=account_permissions,json=accountPermissions,proto3" json:"account_permissions,omitempty"`
 	DisabledTypeUrls   []string                     `protobuf:"bytes,2,rep,name=disabled_type_urls,json=disabledTypeUrls,proto3" json:"disabled_type_urls,omitempty"`
+	// disabled_msg_type_url_prefixes are the disabled Msg type URL prefixes.
+	DisabledMsgTypeUrlPrefixes []string `protobuf:"bytes,3,rep,name=disabled_msg_type_url_prefixes,json=disabledMsgTypeUrlPrefixes,proto3" json:"disabled_msg_type_url_prefixes,omitempty"`
+	// disabled_query_paths are the disabled gRPC query paths.
+	DisabledQueryPaths []string `protobuf:"bytes,4,rep,name=disabled_query_paths,json=disabledQueryPaths,proto3" json:"disabled_query_paths,omitempty"`
+	// scheduled_resets are the tripped circuit breakers automatically reset at a
+	// given height.
+	ScheduledResets []*ScheduledReset `protobuf:"bytes,5,rep,name=scheduled_resets,json=scheduledResets,proto3" json:"scheduled_resets,omitempty"`
+	// records are the circuit breaker trip and reset records.
+	Records []*CircuitBreakerRecord `protobuf:"bytes,6,rep,name=records,proto3" json:"records,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+	return fileDescriptor_1f5fe523f8a09dbc, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,9 +514,41 @@ func (m *GenesisState) GetDisabledTypeUrls() []string {
 	return nil
 }
 
+func (m *GenesisState) GetDisabledMsgTypeUrlPrefixes() []string {
+	if m != nil {
+		return m.DisabledMsgTypeUrlPrefixes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisabledQueryPaths() []string {
+	if m != nil {
+		return m.DisabledQueryPaths
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledResets() []*ScheduledReset {
+	if m != nil {
+		return m.ScheduledResets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecords() []*CircuitBreakerRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.circuit.v1.CircuitType", CircuitType_name, CircuitType_value)
 	proto.RegisterEnum("cosmos.circuit.v1.Permissions_Level", Permissions_Level_name, Permissions_Level_value)
+	proto.RegisterEnum("cosmos.circuit.v1.CircuitBreakerRecord_Action", CircuitBreakerRecord_Action_name, CircuitBreakerRecord_Action_value)
 	proto.RegisterType((*Permissions)(nil), "cosmos.circuit.v1.Permissions")
+	proto.RegisterType((*ScheduledReset)(nil), "cosmos.circuit.v1.ScheduledReset")
+	proto.RegisterType((*CircuitBreakerRecord)(nil), "cosmos.circuit.v1.CircuitBreakerRecord")
 	proto.RegisterType((*GenesisAccountPermissions)(nil), "cosmos.circuit.v1.GenesisAccountPermissions")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.circuit.v1.GenesisState")
 }
@@ -238,32 +556,62 @@ func init() {
 func init() { proto.RegisterFile("cosmos/circuit/v1/types.proto", fileDescriptor_1f5fe523f8a09dbc) }
 
 var fileDescriptor_1f5fe523f8a09dbc = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x4f, 0xea, 0x40,
-	0x18, 0xec, 0x42, 0x78, 0x2f, 0x6c, 0xdf, 0x83, 0xb2, 0x44, 0x53, 0x8d, 0x36, 0x4d, 0x63, 0x4c,
-	0x0f, 0xa4, 0x0d, 0x98, 0x78, 0xf0, 0x24, 0x4a, 0x25, 0x24, 0xa5, 0x90, 0x56, 0x3c, 0x98, 0x98,
-	0xa6, 0xb4, 0x7b, 0xd8, 0x58, 0x68, 0xd3, 0x2d, 0x28, 0xff, 0xc2, 0xbb, 0x7f, 0xc8, 0x23, 0x27,
-	0xe3, 0xd1, 0xc0, 0x1f, 0x31, 0xb4, 0xa0, 0x4d, 0x50, 0x8f, 0xfb, 0xcd, 0xcc, 0x7e, 0xdf, 0x4c,
-	0x06, 0x1e, 0xba, 0x01, 0x1d, 0x05, 0x54, 0x75, 0x49, 0xe4, 0x4e, 0x48, 0xac, 0x4e, 0xeb, 0x6a,
-	0x3c, 0x0b, 0x31, 0x55, 0xc2, 0x28, 0x88, 0x03, 0x54, 0x49, 0x61, 0x65, 0x0d, 0x2b, 0xd3, 0xba,
-	0xf4, 0x0a, 0x20, 0xdb, 0xc7, 0xd1, 0x88, 0x50, 0x4a, 0x82, 0x31, 0x45, 0x67, 0xb0, 0xe0, 0xe3,
-	0x29, 0xf6, 0x79, 0x20, 0x02, 0xb9, 0xd4, 0x38, 0x52, 0xb6, 0x24, 0x4a, 0x86, 0xae, 0xe8, 0x2b,
-	0xae, 0x99, 0x4a, 0xd0, 0x31, 0x2c, 0xfb, 0x64, 0x44, 0x62, 0x7b, 0xb5, 0xd3, 0x9e, 0x44, 0x3e,
-	0xe5, 0x73, 0x62, 0x5e, 0x2e, 0x9a, 0xff, 0x93, 0xf1, 0xf5, 0x2c, 0xc4, 0x83, 0xc8, 0xa7, 0x92,
-	0x0b, 0x0b, 0x89, 0x0e, 0xed, 0xc3, 0x5d, 0x5d, 0xbb, 0xd1, 0x74, 0xdb, 0xe8, 0x19, 0x9a, 0x3d,
-	0x30, 0xac, 0xbe, 0x76, 0xd9, 0xb9, 0xea, 0x68, 0x2d, 0x8e, 0x41, 0x55, 0x58, 0x4e, 0x31, 0xab,
-	0xd7, 0xd5, 0xec, 0xae, 0xd5, 0xb6, 0x38, 0x80, 0x10, 0x2c, 0xa5, 0xc3, 0xa6, 0xae, 0xa7, 0xb3,
-	0x1c, 0xda, 0x81, 0x95, 0x35, 0x71, 0xd0, 0xd7, 0x4c, 0xbb, 0xd9, 0xea, 0x76, 0x0c, 0x2e, 0x2f,
-	0x3d, 0xc0, 0xbd, 0x36, 0x1e, 0x63, 0x4a, 0x68, 0xd3, 0x75, 0x83, 0xc9, 0x38, 0xce, 0xba, 0xe4,
-	0xe1, 0x5f, 0xc7, 0xf3, 0x22, 0x4c, 0x69, 0xe2, 0xb3, 0x68, 0x6e, 0x9e, 0xe8, 0x1c, 0xb2, 0xe1,
-	0x17, 0x91, 0xcf, 0x89, 0x40, 0x66, 0x1b, 0xc2, 0xef, 0x29, 0x98, 0x59, 0x89, 0xf4, 0x0c, 0xe0,
-	0xbf, 0xf5, 0x66, 0x2b, 0x76, 0x62, 0x8c, 0xee, 0x60, 0xd5, 0x49, 0x4f, 0xb0, 0xb3, 0x5f, 0x03,
-	0x31, 0x2f, 0xb3, 0x8d, 0xda, 0x37, 0x5f, 0xff, 0x78, 0xb7, 0x89, 0x9c, 0x6d, 0x2f, 0x35, 0x88,
-	0x3c, 0x42, 0x9d, 0xa1, 0x8f, 0xbd, 0xad, 0xe0, 0xb9, 0x0d, 0xb2, 0xc9, 0xfe, 0xe2, 0xf4, 0x65,
-	0x21, 0x80, 0xf9, 0x42, 0x00, 0xef, 0x0b, 0x01, 0x3c, 0x2d, 0x05, 0x66, 0xbe, 0x14, 0x98, 0xb7,
-	0xa5, 0xc0, 0xdc, 0x1e, 0xa4, 0x87, 0x50, 0xef, 0x5e, 0x21, 0x81, 0xfa, 0xf8, 0xd9, 0xa1, 0xa4,
-	0x40, 0xc3, 0x3f, 0x49, 0x83, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xff, 0xdc, 0xa4,
-	0x62, 0x02, 0x00, 0x00,
+	// 874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xd1, 0x6e, 0xe2, 0x46,
+	0x14, 0x65, 0x30, 0x21, 0xcb, 0x75, 0x96, 0x78, 0x67, 0xb3, 0xa9, 0x97, 0x66, 0x09, 0x8b, 0x56,
+	0x2d, 0xaa, 0x56, 0xa6, 0xa1, 0x52, 0x55, 0xf5, 0xa9, 0x84, 0x38, 0x09, 0x12, 0x10, 0xef, 0x18,
+	0xaa, 0x6e, 0xa5, 0xca, 0x72, 0xec, 0x59, 0xb0, 0xd6, 0x60, 0xea, 0x31, 0xe9, 0xe6, 0xb1, 0x1f,
+	0x50, 0x69, 0xbf, 0xa1, 0x5f, 0xb3, 0x8f, 0xfb, 0x54, 0xf5, 0xa9, 0xad, 0x92, 0x3f, 0xe8, 0x17,
+	0x54, 0x1e, 0x8f, 0x83, 0x49, 0x88, 0xd4, 0x37, 0xdf, 0x73, 0xce, 0xbd, 0x73, 0xef, 0xcc, 0x99,
+	0x31, 0x3c, 0x73, 0x02, 0x36, 0x0d, 0x58, 0xd3, 0xf1, 0x42, 0x67, 0xe1, 0x45, 0xcd, 0x8b, 0x83,
+	0x66, 0x74, 0x39, 0xa7, 0x4c, 0x9b, 0x87, 0x41, 0x14, 0xe0, 0x47, 0x09, 0xad, 0x09, 0x5a, 0xbb,
+	0x38, 0xa8, 0xec, 0x8c, 0x83, 0x71, 0xc0, 0xd9, 0x66, 0xfc, 0x95, 0x08, 0x2b, 0xfb, 0xe3, 0x20,
+	0x18, 0xfb, 0xb4, 0xc9, 0xa3, 0xf3, 0xc5, 0x9b, 0x66, 0xe4, 0x4d, 0x29, 0x8b, 0xec, 0xe9, 0x3c,
+	0x11, 0xd4, 0xff, 0x40, 0x20, 0x1b, 0x34, 0x9c, 0x7a, 0x8c, 0x79, 0xc1, 0x8c, 0xe1, 0x6f, 0x61,
+	0xc3, 0xa7, 0x17, 0xd4, 0x57, 0x51, 0x0d, 0x35, 0xca, 0xad, 0x17, 0xda, 0x9d, 0x95, 0xb4, 0x8c,
+	0x5c, 0xeb, 0xc5, 0x5a, 0x92, 0xa4, 0xe0, 0xcf, 0x60, 0xdb, 0xf7, 0xa6, 0x5e, 0x64, 0xc5, 0xad,
+	0x5a, 0x8b, 0xd0, 0x67, 0x6a, 0xbe, 0x26, 0x35, 0x4a, 0xe4, 0x21, 0x87, 0x87, 0x97, 0x73, 0x3a,
+	0x0a, 0x7d, 0x56, 0x77, 0x60, 0x83, 0xe7, 0xe1, 0x0a, 0xec, 0xf6, 0xf4, 0xef, 0xf5, 0x9e, 0x35,
+	0x38, 0x1b, 0xe8, 0xd6, 0x68, 0x60, 0x1a, 0x7a, 0xa7, 0x7b, 0xdc, 0xd5, 0x8f, 0x94, 0x1c, 0x7e,
+	0x0c, 0xdb, 0x09, 0x67, 0x9e, 0xf5, 0x75, 0xab, 0x6f, 0x9e, 0x98, 0x0a, 0xc2, 0x18, 0xca, 0x09,
+	0xd8, 0xee, 0xf5, 0x12, 0x2c, 0x8f, 0x9f, 0xc0, 0x23, 0x21, 0x1c, 0x19, 0x3a, 0xb1, 0xda, 0x47,
+	0xfd, 0xee, 0x40, 0x91, 0xea, 0xbf, 0x22, 0x28, 0x9b, 0xce, 0x84, 0xba, 0x0b, 0x9f, 0xba, 0x84,
+	0x32, 0x1a, 0xe1, 0x36, 0x6c, 0x89, 0x31, 0x78, 0x87, 0x62, 0xc4, 0xea, 0x9a, 0x11, 0x3b, 0xc9,
+	0x67, 0xdc, 0x31, 0x91, 0x9d, 0x65, 0x80, 0x77, 0x60, 0xe3, 0xc2, 0xf6, 0x17, 0x54, 0xcd, 0xd7,
+	0x50, 0xa3, 0x44, 0x92, 0x00, 0xef, 0x42, 0x71, 0x42, 0xbd, 0xf1, 0x24, 0x52, 0xa5, 0x1a, 0x6a,
+	0x14, 0x88, 0x88, 0xea, 0xff, 0x4a, 0xb0, 0x23, 0x4a, 0x1d, 0x86, 0xd4, 0x7e, 0x4b, 0x43, 0x42,
+	0x9d, 0x20, 0x74, 0x71, 0x19, 0xf2, 0x9e, 0xcb, 0xd7, 0x2f, 0x90, 0xbc, 0xe7, 0xe2, 0x63, 0x28,
+	0xda, 0x4e, 0xe4, 0x05, 0x33, 0x5e, 0xb7, 0xdc, 0xd2, 0xee, 0xef, 0x69, 0xa5, 0x90, 0xd6, 0xe6,
+	0x59, 0x44, 0x64, 0xe3, 0x3d, 0x28, 0xd9, 0x8b, 0x68, 0x12, 0x84, 0x5e, 0x74, 0xc9, 0x7b, 0x29,
+	0x91, 0x25, 0x10, 0xb7, 0x19, 0x52, 0x9b, 0x05, 0x33, 0xb5, 0xc0, 0x29, 0x11, 0xe1, 0x3a, 0x3c,
+	0x9c, 0xb2, 0x71, 0xe6, 0xd4, 0x36, 0xf8, 0xa9, 0xc9, 0x53, 0x36, 0x4e, 0xcf, 0x0c, 0x1f, 0xc0,
+	0x93, 0xac, 0xc6, 0x9a, 0x87, 0xf4, 0x8d, 0xf7, 0x8e, 0x32, 0xb5, 0xc8, 0xb5, 0x78, 0xa9, 0x35,
+	0x04, 0x83, 0xf7, 0x41, 0xfe, 0x79, 0x41, 0xc3, 0x4b, 0x6b, 0x6e, 0x47, 0x13, 0xa6, 0x6e, 0x72,
+	0x21, 0x70, 0xc8, 0x88, 0x11, 0xfc, 0x1c, 0xb6, 0xc2, 0xf8, 0x60, 0x2c, 0xb1, 0x79, 0x0f, 0xf8,
+	0x7e, 0xc8, 0x1c, 0x3b, 0xe5, 0x50, 0x66, 0x67, 0x4b, 0x35, 0xd4, 0x90, 0xd2, 0x9d, 0xc5, 0xdf,
+	0x40, 0x21, 0x76, 0xb2, 0x0a, 0x35, 0xd4, 0x90, 0x5b, 0x15, 0x2d, 0xb1, 0xb9, 0x96, 0xda, 0x5c,
+	0x1b, 0xa6, 0x36, 0x3f, 0x7c, 0xf0, 0xe1, 0xaf, 0xfd, 0xdc, 0xfb, 0xbf, 0xf7, 0x11, 0xe1, 0x19,
+	0x75, 0x0b, 0x8a, 0xc9, 0xa6, 0xe1, 0x5d, 0xc0, 0xed, 0xce, 0xb0, 0x7b, 0x36, 0xb8, 0xe5, 0xbc,
+	0x6d, 0x90, 0x05, 0x3e, 0x24, 0x5d, 0x43, 0x41, 0x58, 0x81, 0x2d, 0x01, 0x10, 0xdd, 0xd4, 0x87,
+	0x4a, 0x3e, 0x36, 0xae, 0x40, 0xcc, 0xce, 0xa9, 0x7e, 0x34, 0xea, 0xe9, 0x47, 0x82, 0x93, 0xea,
+	0xbf, 0xc0, 0xd3, 0x13, 0x3a, 0xa3, 0xcc, 0x63, 0x6d, 0xc7, 0x09, 0x16, 0xb3, 0x28, 0x7b, 0xbd,
+	0x54, 0xd8, 0xb4, 0x5d, 0x37, 0xa4, 0x8c, 0xf1, 0xd3, 0x2f, 0x91, 0x34, 0xc4, 0xdf, 0x81, 0x3c,
+	0x5f, 0x0a, 0xb9, 0x0f, 0xe4, 0xb5, 0xde, 0xcc, 0x94, 0x23, 0xd9, 0x94, 0xfa, 0xef, 0x12, 0x6c,
+	0x89, 0x95, 0xcd, 0xc8, 0x8e, 0x28, 0xfe, 0x09, 0x1e, 0xdb, 0x49, 0x0b, 0x56, 0xb6, 0x34, 0xaa,
+	0x49, 0x0d, 0xb9, 0xf5, 0x72, 0x4d, 0xe9, 0x7b, 0xfb, 0x26, 0xd8, 0xbe, 0x3b, 0xcb, 0x4b, 0xc0,
+	0xae, 0xc7, 0xec, 0x73, 0x9f, 0xba, 0x77, 0x6e, 0xbc, 0x92, 0x32, 0x37, 0x06, 0x3a, 0x84, 0xea,
+	0x8d, 0x7a, 0xbd, 0x93, 0x24, 0x9e, 0x59, 0x49, 0x55, 0xfd, 0xbb, 0x8e, 0xfa, 0x12, 0x76, 0x6e,
+	0x6a, 0x64, 0xad, 0x55, 0x48, 0x3c, 0x98, 0x72, 0xaf, 0x96, 0x16, 0xeb, 0x81, 0xc2, 0xd2, 0x47,
+	0xc0, 0xe2, 0xc6, 0x4a, 0xdc, 0x2d, 0xb7, 0x9e, 0xaf, 0x99, 0x7f, 0xf5, 0xbd, 0x20, 0xdb, 0x6c,
+	0x25, 0x66, 0xb8, 0x0d, 0x9b, 0x21, 0xbf, 0x77, 0x89, 0xed, 0xe5, 0xd6, 0xe7, 0xff, 0xf3, 0x9e,
+	0x92, 0x34, 0xef, 0x8b, 0xdf, 0x10, 0xc8, 0x99, 0xd7, 0x05, 0xef, 0x81, 0xda, 0xe9, 0x92, 0xce,
+	0xa8, 0x3b, 0xb4, 0x86, 0xaf, 0x8d, 0xdb, 0x8f, 0xe0, 0x33, 0x78, 0xba, 0xc2, 0xf6, 0xcd, 0x13,
+	0x21, 0x23, 0x3d, 0x05, 0xe1, 0x17, 0x50, 0xbb, 0x97, 0xb6, 0x0c, 0xa2, 0x1f, 0x77, 0x7f, 0x50,
+	0xf2, 0xf8, 0x53, 0xf8, 0x64, 0x45, 0xf5, 0x6a, 0xa4, 0x93, 0xd7, 0x96, 0xd1, 0x1e, 0x9e, 0x2a,
+	0xd2, 0xe1, 0xd7, 0x1f, 0xae, 0xaa, 0xe8, 0xe3, 0x55, 0x15, 0xfd, 0x73, 0x55, 0x45, 0xef, 0xaf,
+	0xab, 0xb9, 0x8f, 0xd7, 0xd5, 0xdc, 0x9f, 0xd7, 0xd5, 0xdc, 0x8f, 0x7b, 0xc9, 0x68, 0xcc, 0x7d,
+	0xab, 0x79, 0x41, 0xf3, 0xdd, 0xcd, 0xaf, 0x88, 0xff, 0x87, 0xce, 0x8b, 0xfc, 0xaa, 0x7d, 0xf5,
+	0xdf, 0x00, 0x8b, 0x17, 0x23, 0x99, 0xa9, 0x06, 0x00, 0x00,
 }
 
 func (m *Permissions) Marshal() (dAtA []byte, err error) {
@@ -303,6 +651,138 @@ func (m *Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledReset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledReset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CircuitType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CircuitType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ResetHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResetHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.QueryPaths) > 0 {
+		for iNdEx := len(m.QueryPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryPaths[iNdEx])
+			copy(dAtA[i:], m.QueryPaths[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.QueryPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MsgTypeUrlPrefixes) > 0 {
+		for iNdEx := len(m.MsgTypeUrlPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrlPrefixes[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrlPrefixes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrlPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisAccountPermissions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -365,6 +845,52 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ScheduledResets) > 0 {
+		for iNdEx := len(m.ScheduledResets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledResets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DisabledQueryPaths) > 0 {
+		for iNdEx := len(m.DisabledQueryPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledQueryPaths[iNdEx])
+			copy(dAtA[i:], m.DisabledQueryPaths[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledQueryPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DisabledMsgTypeUrlPrefixes) > 0 {
+		for iNdEx := len(m.DisabledMsgTypeUrlPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypeUrlPrefixes[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypeUrlPrefixes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledMsgTypeUrlPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DisabledTypeUrls) > 0 {
 		for iNdEx := len(m.DisabledTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DisabledTypeUrls[iNdEx])
@@ -420,32 +946,100 @@ func (m *Permissions) Size() (n int) {
 	return n
 }
 
-func (m *GenesisAccountPermissions) Size() (n int) {
+func (m *ScheduledReset) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	if m.CircuitType != 0 {
+		n += 1 + sovTypes(uint64(m.CircuitType))
+	}
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
 	}
 	return n
 }
 
-func (m *GenesisState) Size() (n int) {
+func (m *CircuitBreakerRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AccountPermissions) > 0 {
-		for _, e := range m.AccountPermissions {
-			l = e.Size()
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTypes(uint64(m.Action))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.MsgTypeUrlPrefixes) > 0 {
+		for _, s := range m.MsgTypeUrlPrefixes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.QueryPaths) > 0 {
+		for _, s := range m.QueryPaths {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ResetHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ResetHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GenesisAccountPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountPermissions) > 0 {
+		for _, e := range m.AccountPermissions {
+			l = e.Size()
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
@@ -455,6 +1049,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.DisabledMsgTypeUrlPrefixes) > 0 {
+		for _, s := range m.DisabledMsgTypeUrlPrefixes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DisabledQueryPaths) > 0 {
+		for _, s := range m.DisabledQueryPaths {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ScheduledResets) > 0 {
+		for _, e := range m.ScheduledResets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -565,7 +1183,7 @@ func (m *Permissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
+func (m *ScheduledReset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -588,15 +1206,34 @@ func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisAccountPermissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledReset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisAccountPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledReset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitType", wireType)
+			}
+			m.CircuitType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitType |= CircuitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -624,13 +1261,13 @@ func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -640,28 +1277,11 @@ func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Permissions == nil {
-				m.Permissions = &Permissions{}
-			}
-			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -683,7 +1303,7 @@ func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreakerRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -706,17 +1326,55 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreakerRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreakerRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= CircuitBreakerRecord_Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPermissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -726,29 +1384,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountPermissions = append(m.AccountPermissions, &GenesisAccountPermissions{})
-			if err := m.AccountPermissions[len(m.AccountPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisabledTypeUrls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -776,7 +1464,508 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisabledTypeUrls = append(m.DisabledTypeUrls, string(dAtA[iNdEx:postIndex]))
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrlPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrlPrefixes = append(m.MsgTypeUrlPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryPaths = append(m.QueryPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHeight", wireType)
+			}
+			m.ResetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccountPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccountPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &Permissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPermissions = append(m.AccountPermissions, &GenesisAccountPermissions{})
+			if err := m.AccountPermissions[len(m.AccountPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledTypeUrls = append(m.DisabledTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypeUrlPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypeUrlPrefixes = append(m.DisabledMsgTypeUrlPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledQueryPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledQueryPaths = append(m.DisabledQueryPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledResets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledResets = append(m.ScheduledResets, &ScheduledReset{})
+			if err := m.ScheduledResets[len(m.ScheduledResets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &CircuitBreakerRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
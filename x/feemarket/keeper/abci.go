@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+)
+
+// EndBlocker updates the base fee of the next block from the gas consumed by
+// the transactions of the current block.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	gasUsed, ok := k.blockGasUsed(ctx)
+	if !ok {
+		k.Logger.Debug("block gas meter unavailable, base fee left unchanged")
+		return nil
+	}
+
+	return k.UpdateBaseFee(ctx, gasUsed)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// CheckTxFee implements ante.TxFeeChecker. It requires the transaction fee to
+// cover its gas limit at the current base fee, and returns the effective tip
+// per unit of gas (truncated) as the transaction priority.
+//
+// The check is performed in every execution mode but simulation (which is
+// skipped by the DeductFeeDecorator), so that a proposer cannot include
+// transactions paying less than the base fee. Genesis transactions are exempt.
+func (k Keeper) CheckTxFee(ctx context.Context, tx transaction.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	if k.HeaderService.HeaderInfo(ctx).Height == 0 {
+		return fee, 0, nil
+	}
+
+	baseFee, err := k.GetBaseFee(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := mempool.CheckBaseFee(feeTx, baseFee); err != nil {
+		return nil, 0, err
+	}
+
+	gasPrice, err := mempool.TxGasPrice(feeTx, baseFee.Denom)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	priority := int64(math.MaxInt64)
+	if tip := gasPrice.Sub(baseFee.Amount).TruncateInt(); tip.IsInt64() {
+		priority = tip.Int64()
+	}
+
+	return fee, priority, nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"math"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// feeTx is a minimal sdk.FeeTx used to exercise the fee checker. Only the
+// FeeTx methods are implemented.
+type feeTx struct {
+	sdk.Tx
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx feeTx) GetGas() uint64 { return tx.gas }
+
+func (tx feeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx feeTx) FeePayer() []byte { return nil }
+
+func (tx feeTx) FeeGranter() []byte { return nil }
+
+func (s *KeeperTestSuite) TestCheckTxFee() {
+
+	testCases := []struct {
+		name     string
+		tx       feeTx
+		height   int64
+		expErr   error
+		priority int64
+	}{
+		{
+			name:   "fee below base fee",
+			tx:     feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 99)), gas: 100},
+			height: 1,
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:   "fee in another denom",
+			tx:     feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), gas: 100},
+			height: 1,
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:     "fee equal to base fee",
+			tx:       feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), gas: 100},
+			height:   1,
+			priority: 0,
+		},
+		{
+			name:     "effective tip is the priority",
+			tx:       feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 400)), gas: 100},
+			height:   1,
+			priority: 3,
+		},
+		{
+			name:     "huge tip saturates",
+			tx:       feeTx{fee: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewIntFromUint64(math.MaxUint64).MulRaw(4))), gas: 1},
+			height:   1,
+			priority: math.MaxInt64,
+		},
+		{
+			name:   "genesis transactions are exempt",
+			tx:     feeTx{gas: 100},
+			height: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithHeaderInfo(header.Info{Height: tc.height})
+			fee, priority, err := s.keeper.CheckTxFee(ctx, tc.tx)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.tx.fee, fee)
+			s.Require().Equal(tc.priority, priority)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/gas"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blockGasMeterService is implemented by gas services exposing the block gas
+// meter, such as runtime.GasService.
+type blockGasMeterService interface {
+	BlockGasMeter(ctx context.Context) gas.Meter
+}
+
+// Keeper of the feemarket store
+type Keeper struct {
+	appmodule.Environment
+
+	cdc codec.BinaryCodec
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema  collections.Schema
+	Params  collections.Item[types.Params]
+	BaseFee collections.Item[math.LegacyDec]
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		Environment: env,
+		cdc:         cdc,
+		authority:   authority,
+		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BaseFee:     collections.NewItem(sb, types.BaseFeeKey, "base_fee", sdk.LegacyDecValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetBaseFee returns the current base fee per unit of gas, denominated in the
+// fee denom. A zero base fee is returned when the fee market is disabled.
+// Its signature matches mempool.BaseFeeFn, so it can be used to back a
+// mempool.FeeMarketMempool.
+func (k Keeper) GetBaseFee(ctx context.Context) (sdk.DecCoin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if !params.Enabled {
+		return sdk.NewDecCoinFromDec(params.FeeDenom, math.LegacyZeroDec()), nil
+	}
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		baseFee = params.MinBaseFee
+	} else if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(params.FeeDenom, baseFee), nil
+}
+
+// UpdateBaseFee computes and stores the base fee of the next block given the
+// amount of gas consumed by the current block.
+func (k Keeper) UpdateBaseFee(ctx context.Context, gasUsed uint64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		baseFee = params.MinBaseFee
+	} else if err != nil {
+		return err
+	}
+
+	next := params.NextBaseFee(baseFee, gasUsed)
+	if err := k.BaseFee.Set(ctx, next); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBaseFee,
+		event.NewAttribute(types.AttributeKeyBaseFee, sdk.NewDecCoinFromDec(params.FeeDenom, next).String()),
+		event.NewAttribute(types.AttributeKeyGasUsed, math.NewIntFromUint64(gasUsed).String()),
+	)
+}
+
+// blockGasUsed returns the gas consumed so far by the block being executed, or
+// false if the gas service does not expose a block gas meter.
+func (k Keeper) blockGasUsed(ctx context.Context) (uint64, bool) {
+	gs, ok := k.GasService.(blockGasMeterService)
+	if !ok {
+		return 0, false
+	}
+
+	return gs.BlockGasMeter(ctx).Consumed(), true
+}
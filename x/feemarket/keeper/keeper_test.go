@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/keeper"
+	"cosmossdk.io/x/feemarket/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, feemarket.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, govModuleNameStr)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	params := types.NewParams(true, "stake", math.LegacyNewDecWithPrec(1, 2), 8, 1_000_000)
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.NewGenesisState(params, math.LegacyNewDec(1))))
+}
+
+func (s *KeeperTestSuite) TestGetBaseFee() {
+	baseFee, err := s.keeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(1)), baseFee)
+
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.Enabled = false
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	baseFee, err = s.keeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(baseFee.Amount.IsZero())
+	s.Require().Equal("stake", baseFee.Denom)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	// a block using twice the target raises the base fee by 12.5%
+	meter := storetypes.NewGasMeter(10_000_000)
+	meter.ConsumeGas(2_000_000, "txs")
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockGasMeter(meter)))
+
+	baseFee, err := s.keeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecWithPrec(1125, 3).String(), baseFee.String())
+
+	// an empty block lowers it by 12.5%
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())))
+
+	baseFee, err = s.keeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecWithPrec(984375, 6).String(), baseFee.String())
+
+	// the base fee is frozen while the fee market is disabled
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.Enabled = false
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())))
+
+	frozen, err := s.keeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(baseFee, frozen)
+}
+
+func (s *KeeperTestSuite) TestExportGenesis() {
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Equal(math.LegacyNewDec(1), gs.BaseFee)
+	s.Require().Equal("stake", gs.Params.FeeDenom)
+}
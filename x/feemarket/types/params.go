@@ -0,0 +1,90 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBaseFeeChangeDenominator is the EIP-1559 base fee change
+	// denominator, allowing the base fee to move by at most 12.5% per block.
+	DefaultBaseFeeChangeDenominator uint64 = 8
+
+	// DefaultTargetBlockGas is the default amount of gas a block is expected to
+	// consume.
+	DefaultTargetBlockGas uint64 = 15_000_000
+)
+
+// DefaultMinBaseFee is the default lower bound of the base fee.
+var DefaultMinBaseFee = math.LegacyNewDecWithPrec(25, 4)
+
+// NewParams returns Params instance with the given values.
+func NewParams(enabled bool, feeDenom string, minBaseFee math.LegacyDec, changeDenominator, targetBlockGas uint64) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		BaseFeeChangeDenominator: changeDenominator,
+		TargetBlockGas:           targetBlockGas,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters.
+func DefaultParams() Params {
+	return NewParams(
+		true,
+		sdk.DefaultBondDenom,
+		DefaultMinBaseFee,
+		DefaultBaseFeeChangeDenominator,
+		DefaultTargetBlockGas,
+	)
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee must be non-negative: %s", p.MinBaseFee)
+	}
+	if p.Enabled && !p.MinBaseFee.IsPositive() {
+		// NextBaseFee scales the base fee, which could never leave zero
+		return fmt.Errorf("min base fee must be positive when the fee market is enabled: %s", p.MinBaseFee)
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+	if p.TargetBlockGas == 0 {
+		return errors.New("target block gas must be positive")
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the next block given the base fee and the
+// gas consumed by the current block, following EIP-1559: the base fee moves by
+// baseFee * (gasUsed - target) / target / changeDenominator, and never goes
+// below the minimum base fee, which is positive when the fee market is enabled.
+func (p Params) NextBaseFee(baseFee math.LegacyDec, gasUsed uint64) math.LegacyDec {
+	target := math.NewIntFromUint64(p.TargetBlockGas)
+	used := math.NewIntFromUint64(gasUsed)
+
+	delta := baseFee.MulInt(used.Sub(target).Abs()).
+		QuoInt(target).
+		QuoInt(math.NewIntFromUint64(p.BaseFeeChangeDenominator))
+
+	next := baseFee
+	switch {
+	case gasUsed > p.TargetBlockGas:
+		next = baseFee.Add(delta)
+	case gasUsed < p.TargetBlockGas:
+		next = baseFee.Sub(delta)
+	}
+
+	return math.LegacyMaxDec(next, p.MinBaseFee)
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.FeeDenom = ""
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinBaseFee = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinBaseFee = math.LegacyZeroDec()
+	require.Error(t, params.Validate())
+	params.Enabled = false
+	require.NoError(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.BaseFeeChangeDenominator = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.TargetBlockGas = 0
+	require.Error(t, params.Validate())
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams(true, "stake", math.LegacyNewDecWithPrec(1, 2), 8, 1_000_000)
+	baseFee := math.LegacyNewDec(1)
+
+	testCases := []struct {
+		name     string
+		baseFee  math.LegacyDec
+		gasUsed  uint64
+		expected math.LegacyDec
+	}{
+		{"at target", baseFee, 1_000_000, baseFee},
+		{"full block (2x target)", baseFee, 2_000_000, math.LegacyNewDecWithPrec(1125, 3)},
+		{"empty block", baseFee, 0, math.LegacyNewDecWithPrec(875, 3)},
+		{"half of target", baseFee, 500_000, math.LegacyNewDecWithPrec(9375, 4)},
+		{"floored at min base fee", math.LegacyNewDecWithPrec(1, 2), 0, math.LegacyNewDecWithPrec(1, 2)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected.String(), params.NextBaseFee(tc.baseFee, tc.gasUsed).String())
+		})
+	}
+}
+
+func TestGenesisValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	gs := types.DefaultGenesisState()
+	gs.BaseFee = gs.Params.MinBaseFee.QuoInt64(2)
+	require.Error(t, gs.Validate())
+}
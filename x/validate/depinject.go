@@ -135,6 +135,7 @@ func newAnteHandler(in ModuleInputs) (sdk.AnteHandler, error) {
 			FeegrantKeeper:     in.FeeGrantKeeper,
 			SigGasConsumer:     ante.DefaultSigVerificationGasConsumer,
 			UnorderedTxManager: in.UnorderedTxManager,
+			TxFeeChecker:       in.TxFeeChecker,
 		},
 	)
 	if err != nil {